@@ -0,0 +1,242 @@
+package buscas
+
+import (
+	"math"
+
+	"dca3503/instrument"
+	"dca3503/list"
+)
+
+// ============================================================================
+// BUSCAS EM LISTA ORDENADA
+// ============================================================================
+
+// Todas as funções desta seção recebem a lista em ordem crescente e retornam
+// o índice de uma ocorrência do elemento, ou -1 se ele não existir. Com
+// elementos repetidos, exponencial e por saltos retornam a primeira
+// ocorrência; as demais, uma ocorrência qualquer (use LowerBound para a
+// primeira)
+
+// BuscaInterpolacao estima a posição pelo valor, como quem procura um nome
+// na lista telefônica: se o elemento está a 30% do caminho entre o primeiro
+// e o último valor, olha primeiro a 30% do caminho entre os índices
+// Complexidade: O(log log n) em média com valores uniformemente
+// distribuídos; O(n) no pior caso (valores muito desiguais)
+// Pseudocódigo:
+// 1. Enquanto elemento estiver entre lista[esquerda] e lista[direita]:
+// 2. pos = esquerda + (elemento - lista[esquerda]) · (direita - esquerda) / (lista[direita] - lista[esquerda])
+//    (em float64, limitado a [esquerda, direita]; o meio se a fração não for finita)
+// 3. Igual: achou; menor: esquerda = pos + 1; maior: direita = pos - 1
+func BuscaInterpolacao[T list.Number](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	esquerda, direita := 0, len(lista)-1
+	for esquerda <= direita {
+		if compara(c, elemento, lista[esquerda]) < 0 || compara(c, elemento, lista[direita]) > 0 {
+			return -1
+		}
+		pos := esquerda
+		if lista[direita] != lista[esquerda] {
+			// Diferenças em float64: em T estourariam (int8, extremos de int64)
+			fracao := (float64(elemento) - float64(lista[esquerda])) / (float64(lista[direita]) - float64(lista[esquerda]))
+			if math.IsNaN(fracao) || math.IsInf(fracao, 0) {
+				fracao = 0.5 // Infinitos nos extremos: sem estimativa, usa o meio
+			}
+			pos = esquerda + int(fracao*float64(direita-esquerda))
+			pos = min(max(pos, esquerda), direita) // Arredondamento em valores enormes
+		}
+		switch compara(c, lista[pos], elemento) {
+		case 0:
+			return pos
+		case -1:
+			esquerda = pos + 1
+		default:
+			direita = pos - 1
+		}
+	}
+	return -1
+}
+
+// BuscaExponencial (galopante) dobra o passo até passar do elemento e depois
+// faz busca binária só no último trecho
+// Boa quando o elemento está perto do início ou a lista é muito grande ou
+// ilimitada: custa O(log i), com i a posição do elemento, e não O(log n)
+// Complexidade: O(log i)
+// Pseudocódigo:
+// 1. limite = 1; enquanto limite < n e lista[limite] < elemento: limite *= 2
+// 2. O elemento, se existir, está em [limite/2, min(limite+1, n))
+// 3. LowerBound nesse trecho
+func BuscaExponencial[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	if len(lista) == 0 {
+		return -1
+	}
+	limite := 1
+	for limite < len(lista) && compara(c, lista[limite], elemento) < 0 {
+		limite *= 2
+	}
+	i := lowerBound(lista, elemento, limite/2, min(limite+1, len(lista)), c)
+	if i < len(lista) && compara(c, lista[i], elemento) == 0 {
+		return i
+	}
+	return -1
+}
+
+// BuscaSaltos pula blocos de √n elementos até achar o bloco que pode conter
+// o elemento e então o percorre sequencialmente
+// Útil quando voltar atrás é caro (fita, lista ligada com ponteiro de salto),
+// pois só volta uma vez
+// Complexidade: O(√n)
+func BuscaSaltos[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	n := len(lista)
+	if n == 0 {
+		return -1
+	}
+	passo := int(math.Sqrt(float64(n)))
+	inicio := 0
+	// Procura o primeiro bloco cujo último elemento não é menor que o procurado
+	for fim := min(passo, n); compara(c, lista[fim-1], elemento) < 0; fim = min(fim+passo, n) {
+		inicio = fim
+		if inicio >= n {
+			return -1
+		}
+	}
+	for i := inicio; i < min(inicio+passo, n); i++ {
+		switch compara(c, lista[i], elemento) {
+		case 0:
+			return i
+		case 1:
+			return -1
+		}
+	}
+	return -1
+}
+
+// BuscaTernaria divide o intervalo em três partes com dois pontos de corte
+// Faz menos iterações que a binária (log₃ n), mas até duas comparações por
+// iteração: no pior caso 2·log₃ n ≈ 1,26·log₂ n comparações
+// Complexidade: O(log n)
+func BuscaTernaria[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	esquerda, direita := 0, len(lista)-1
+	for esquerda <= direita {
+		terco := (direita - esquerda) / 3
+		meio1, meio2 := esquerda+terco, direita-terco
+		switch compara(c, elemento, lista[meio1]) {
+		case 0:
+			return meio1
+		case -1:
+			direita = meio1 - 1
+			continue
+		}
+		switch compara(c, elemento, lista[meio2]) {
+		case 0:
+			return meio2
+		case 1:
+			esquerda = meio2 + 1
+		default:
+			esquerda, direita = meio1+1, meio2-1
+		}
+	}
+	return -1
+}
+
+// BuscaFibonacci divide o intervalo nas proporções de números de Fibonacci
+// consecutivos em vez de ao meio
+// Só usa soma e subtração para calcular as posições (nada de divisão), e os
+// acessos tendem a ficar próximos entre si
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Achar o menor Fibonacci F(k) >= n
+// 2. Comparar com a posição deslocamento + F(k-2)
+// 3. Menor: descartar o início (deslocamento avança) e descer um Fibonacci
+// 4. Maior: descartar o fim e descer dois Fibonacci
+func BuscaFibonacci[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	n := len(lista)
+	fib2, fib1 := 0, 1 // F(k-2), F(k-1)
+	fib := fib2 + fib1 // F(k)
+	for fib < n {
+		fib2, fib1 = fib1, fib
+		fib = fib2 + fib1
+	}
+	
+	deslocamento := -1 // Tudo até aqui já foi descartado
+	for fib > 1 {
+		i := min(deslocamento+fib2, n-1)
+		switch compara(c, lista[i], elemento) {
+		case -1:
+			fib, fib1 = fib1, fib2
+			fib2 = fib - fib1
+			deslocamento = i
+		case 1:
+			fib, fib1 = fib2, fib1-fib2
+			fib2 = fib - fib1
+		default:
+			return i
+		}
+	}
+	if fib1 == 1 && deslocamento+1 < n && compara(c, lista[deslocamento+1], elemento) == 0 {
+		return deslocamento + 1
+	}
+	return -1
+}
+
+// ============================================================================
+// LISTA ORDENADA ROTACIONADA
+// ============================================================================
+
+// BuscaRotacionada procura numa lista ordenada que foi rotacionada, como
+// [40 50 10 20 30] (a ordem crescente recomeça em algum ponto)
+// Em cada passo, pelo menos uma das metades em volta do meio está em ordem;
+// se o elemento cabe nela, a busca continua ali, senão na outra
+// Complexidade: O(log n) sem repetidos; O(n) no pior caso com repetidos
+// (com lista[esquerda] == lista[meio] == lista[direita] não dá para saber
+// qual metade está em ordem, e só se descartam as pontas)
+func BuscaRotacionada[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	esquerda, direita := 0, len(lista)-1
+	for esquerda <= direita {
+		meio := esquerda + (direita-esquerda)/2
+		if compara(c, lista[meio], elemento) == 0 {
+			return meio
+		}
+		inicioMeio := compara(c, lista[esquerda], lista[meio])
+		meioFim := compara(c, lista[meio], lista[direita])
+		switch {
+		case inicioMeio == 0 && meioFim == 0:
+			// Não dá para decidir: descarta as duas pontas (nenhuma é o elemento)
+			esquerda++
+			direita--
+		case inicioMeio <= 0:
+			// [esquerda, meio] está em ordem
+			if compara(c, lista[esquerda], elemento) <= 0 && compara(c, elemento, lista[meio]) < 0 {
+				direita = meio - 1
+			} else {
+				esquerda = meio + 1
+			}
+		default:
+			// [meio, direita] está em ordem
+			if compara(c, lista[meio], elemento) < 0 && compara(c, elemento, lista[direita]) <= 0 {
+				esquerda = meio + 1
+			} else {
+				direita = meio - 1
+			}
+		}
+	}
+	return -1
+}
+
+// PontoDeRotacao retorna o índice do menor elemento de uma lista ordenada
+// rotacionada sem repetidos (quantas posições ela foi rotacionada)
+// Complexidade: O(log n)
+func PontoDeRotacao[T list.Ordered](lista []T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	if len(lista) == 0 {
+		return 0
+	}
+	ultimo := lista[len(lista)-1]
+	return SearchFunc(len(lista), func(i int) bool {
+		return compara(c, lista[i], ultimo) <= 0
+	})
+}
@@ -1,14 +1,21 @@
 package buscas
 
+// BuscaBinaria retorna o índice da primeira ocorrência do elemento na lista
+// ordenada, ou -1 se ele não existir
+// Com repetidos, parar no primeiro meio igual devolveria uma ocorrência
+// qualquer; por isso a busca continua à esquerda até restar a primeira
+// (ver LowerBound e BuscaBinariaGenerica para outros tipos e contagem)
 func BuscaBinaria(lista []int, elemento int) int {
 	esquerda := 0
 	direita := len(lista) - 1
+	resultado := -1
 
 	for esquerda <= direita {
 		meio := (esquerda + direita) / 2
 
 		if lista[meio] == elemento {
-			return meio // Elemento encontrado
+			resultado = meio // Candidato: pode haver outra ocorrência antes
+			direita = meio - 1
 		} else if lista[meio] < elemento {
 			esquerda = meio + 1 // Busca na metade direita
 		} else {
@@ -16,5 +23,5 @@ func BuscaBinaria(lista []int, elemento int) int {
 		}
 	}
 
-	return -1 // Elemento não encontrado
-}
\ No newline at end of file
+	return resultado // -1 se o elemento não foi encontrado
+}
@@ -0,0 +1,354 @@
+package buscas_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"dca3503/buscas"
+	"dca3503/deque"
+	"dca3503/instrument"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+// algoritmo é uma busca que retorna o índice de uma ocorrência ou -1
+type algoritmo struct {
+	nome     string
+	busca    func(lista []int, elemento int, contadores ...*instrument.Counters) int
+	primeira bool // Garante a primeira ocorrência com repetidos
+}
+
+var algoritmos = []algoritmo{
+	{"binária", buscas.BuscaBinariaGenerica[int], true},
+	{"interpolação", buscas.BuscaInterpolacao[int], false},
+	{"exponencial", buscas.BuscaExponencial[int], true},
+	{"saltos", buscas.BuscaSaltos[int], true},
+	{"ternária", buscas.BuscaTernaria[int], false},
+	{"fibonacci", buscas.BuscaFibonacci[int], false},
+	{"rotacionada", buscas.BuscaRotacionada[int], false},
+}
+
+func TestAlgoritmosContraBuscaSequencial(t *testing.T) {
+	rng := rand.New(rand.NewPCG(2, 3))
+	for n := 0; n <= 40; n++ {
+		lista := make([]int, n)
+		for i := range lista {
+			lista[i] = rng.IntN(30) // Com repetidos
+		}
+		sort.Ints(lista)
+		for elemento := -1; elemento <= 31; elemento++ {
+			esperado := buscas.BuscaSequencial(lista, elemento)
+			if got := buscas.BuscaBinaria(lista, elemento); got != esperado {
+				t.Fatalf("BuscaBinaria(%v, %d) = %d, esperado %d", lista, elemento, got, esperado)
+			}
+			for _, a := range algoritmos {
+				got := a.busca(lista, elemento)
+				if esperado == -1 && got != -1 || esperado != -1 && (got < 0 || lista[got] != elemento) {
+					t.Fatalf("%s(%v, %d) = %d", a.nome, lista, elemento, got)
+				}
+				if a.primeira && got != esperado {
+					t.Fatalf("%s(%v, %d) = %d, esperada a primeira ocorrência %d", a.nome, lista, elemento, got, esperado)
+				}
+			}
+		}
+	}
+}
+
+func TestLimites(t *testing.T) {
+	lista := []string{"a", "b", "b", "b", "d", "e"}
+	testes := []struct {
+		elemento           string
+		inferior, superior int
+	}{
+		{"", 0, 0},
+		{"a", 0, 1},
+		{"b", 1, 4},
+		{"c", 4, 4},
+		{"e", 5, 6},
+		{"f", 6, 6},
+	}
+	for _, teste := range testes {
+		inicio, fim := buscas.EqualRange(lista, teste.elemento)
+		if inicio != teste.inferior || fim != teste.superior {
+			t.Errorf("EqualRange(%q) = [%d, %d), esperado [%d, %d)", teste.elemento, inicio, fim, teste.inferior, teste.superior)
+		}
+		if got := buscas.LowerBound(lista, teste.elemento); got != sort.SearchStrings(lista, teste.elemento) {
+			t.Errorf("LowerBound(%q) = %d", teste.elemento, got)
+		}
+	}
+	
+	// Primeira potência de 2 maior que 1000, sem lista nenhuma
+	if got := buscas.SearchFunc(64, func(i int) bool { return 1<<i > 1000 }); got != 10 {
+		t.Errorf("SearchFunc = %d, esperado 10", got)
+	}
+	if got := buscas.SearchFunc(5, func(int) bool { return false }); got != 5 {
+		t.Errorf("SearchFunc sem nenhum verdadeiro = %d, esperado 5", got)
+	}
+}
+
+func TestBuscaInterpolacaoSemEstouro(t *testing.T) {
+	// As diferenças não cabem no tipo: int8 vai de -128 a 127
+	pequenos := []int8{-100, 0, 100}
+	for i, elemento := range pequenos {
+		if got := buscas.BuscaInterpolacao(pequenos, elemento); got != i {
+			t.Errorf("BuscaInterpolacao(%v, %d) = %d, esperado %d", pequenos, elemento, got, i)
+		}
+	}
+	extremos := []int64{math.MinInt64, -1, 0, 1, math.MaxInt64}
+	for i, elemento := range extremos {
+		if got := buscas.BuscaInterpolacao(extremos, elemento); got != i {
+			t.Errorf("BuscaInterpolacao(%v, %d) = %d, esperado %d", extremos, elemento, got, i)
+		}
+	}
+	if got := buscas.BuscaInterpolacao(extremos, 2); got != -1 {
+		t.Errorf("BuscaInterpolacao(%v, 2) = %d, esperado -1", extremos, got)
+	}
+	
+	// Inf/Inf é NaN: a posição estimada cai no meio
+	infinitos := []float64{math.Inf(-1), 0, 1, math.Inf(1)}
+	for i, elemento := range infinitos {
+		if got := buscas.BuscaInterpolacao(infinitos, elemento); got != i {
+			t.Errorf("BuscaInterpolacao(%v, %g) = %d, esperado %d", infinitos, elemento, got, i)
+		}
+	}
+	if got := buscas.BuscaInterpolacao([]float64{0, 1, math.Inf(1)}, math.Inf(1)); got != 2 {
+		t.Errorf("BuscaInterpolacao([0 1 +Inf], +Inf) = %d, esperado 2", got)
+	}
+}
+
+func TestBuscaRotacionada(t *testing.T) {
+	base := []int{2, 5, 8, 13, 21, 34, 55}
+	for rotacao := 0; rotacao < len(base); rotacao++ {
+		lista := append(slices.Clone(base[rotacao:]), base[:rotacao]...)
+		if got := buscas.PontoDeRotacao(lista); got != (len(base)-rotacao)%len(base) {
+			t.Errorf("PontoDeRotacao(%v) = %d", lista, got)
+		}
+		for i, elemento := range lista {
+			if got := buscas.BuscaRotacionada(lista, elemento); got != i {
+				t.Errorf("BuscaRotacionada(%v, %d) = %d, esperado %d", lista, elemento, got, i)
+			}
+		}
+		for _, ausente := range []int{0, 3, 60} {
+			if got := buscas.BuscaRotacionada(lista, ausente); got != -1 {
+				t.Errorf("BuscaRotacionada(%v, %d) = %d", lista, ausente, got)
+			}
+		}
+	}
+	// Com repetidos as metades ambíguas são resolvidas descartando as pontas
+	if got := buscas.BuscaRotacionada([]int{3, 3, 3, 1, 3}, 1); got != 3 {
+		t.Errorf("BuscaRotacionada com repetidos = %d, esperado 3", got)
+	}
+}
+
+func TestContagemDeComparacoes(t *testing.T) {
+	lista := make([]int, 1<<20)
+	for i := range lista {
+		lista[i] = 2 * i
+	}
+	comparacoes := func(busca func([]int, int, ...*instrument.Counters) int, elemento int) int64 {
+		var contadores instrument.Counters
+		busca(lista, elemento, &contadores)
+		return contadores.Comparisons
+	}
+	
+	// Com n potência de 2, LowerBound faz sempre log₂ n comparações; com o
+	// elemento no início, a exponencial para bem antes
+	if got := comparacoes(buscas.LowerBound[int], 4); got != 20 {
+		t.Errorf("LowerBound: %d comparações, esperado 20", got)
+	}
+	if got := comparacoes(buscas.BuscaExponencial[int], 4); got > 6 {
+		t.Errorf("BuscaExponencial perto do início: %d comparações", got)
+	}
+	// Valores uniformes: a interpolação acerta de primeira
+	if got := comparacoes(buscas.BuscaInterpolacao[int], 777_776); got != 3 {
+		t.Errorf("BuscaInterpolacao: %d comparações, esperado 3", got)
+	}
+	if got := comparacoes(buscas.BuscaSaltos[int], 2*(1<<20-1)); got > 2*1024 {
+		t.Errorf("BuscaSaltos: %d comparações, esperado no máximo 2√n", got)
+	}
+	// Sem contador a busca funciona igual
+	if buscas.BuscaFibonacci(lista, 1000) != 500 {
+		t.Error("BuscaFibonacci sem contador")
+	}
+}
+
+// listas cria uma lista de cada implementação com os valores dados
+func listas(valores ...int) map[string]list.List[int] {
+	array := list.NewArrayList[int](len(valores)) // Cheio: sentinela no último
+	linked := list.NewLinkedList[int]()
+	doubly := list.NewDoublyLinkedList[int]()
+	for _, v := range valores {
+		array.Add(v)
+		linked.Add(v)
+		doubly.Add(v)
+	}
+	return map[string]list.List[int]{"ArrayList": array, "LinkedList": linked, "DoublyLinkedList": doubly}
+}
+
+func TestBuscaSentinela(t *testing.T) {
+	valores := []int{4, 8, 15, 16, 23, 42, 8}
+	for _, elemento := range []int{4, 8, 23, 42, 7} {
+		esperado := slices.Index(valores, elemento)
+		if got := buscas.BuscaSequencialSentinela(valores, elemento); got != esperado {
+			t.Errorf("BuscaSequencialSentinela(%d) = %d, esperado %d", elemento, got, esperado)
+		}
+		for nome, l := range listas(valores...) {
+			if got := buscas.BuscaSentinela(l, elemento); got != esperado {
+				t.Errorf("%s: BuscaSentinela(%d) = %d, esperado %d", nome, elemento, got, esperado)
+			}
+			if !slices.Equal(l.ToSlice(), valores) {
+				t.Errorf("%s: a sentinela alterou a lista: %v", nome, l.ToSlice())
+			}
+		}
+	}
+	if !slices.Equal(valores, []int{4, 8, 15, 16, 23, 42, 8}) {
+		t.Errorf("a sentinela alterou a fatia: %v", valores)
+	}
+	
+	// Com capacidade sobrando a sentinela vai depois do último elemento
+	array := list.NewArrayList[int](10)
+	array.AddAll([]int{1, 2, 3})
+	if buscas.BuscaSentinela[int](array, 3) != 2 || buscas.BuscaSentinela[int](array, 9) != -1 {
+		t.Error("BuscaSentinela com capacidade sobrando")
+	}
+}
+
+func TestBuscasAutoOrganizaveis(t *testing.T) {
+	for nome, l := range listas(1, 2, 3, 4, 5) {
+		if got := buscas.BuscaMoverParaFrente(l, 4); got != 3 {
+			t.Errorf("%s: BuscaMoverParaFrente(4) = %d, esperado 3", nome, got)
+		}
+		if got := buscas.BuscaMoverParaFrente(l, 5); got != 4 {
+			t.Errorf("%s: BuscaMoverParaFrente(5) = %d, esperado 4", nome, got)
+		}
+		if !slices.Equal(l.ToSlice(), []int{5, 4, 1, 2, 3}) {
+			t.Errorf("%s: após mover 4 e 5 para a frente: %v", nome, l.ToSlice())
+		}
+		
+		// Transpor o último, o segundo e o primeiro (que não sai do lugar)
+		for _, elemento := range []int{3, 4, 4} {
+			buscas.BuscaTransposicao(l, elemento)
+		}
+		if !slices.Equal(l.ToSlice(), []int{4, 5, 1, 3, 2}) {
+			t.Errorf("%s: após transposições: %v", nome, l.ToSlice())
+		}
+		if buscas.BuscaMoverParaFrente(l, 9) != -1 || buscas.BuscaTransposicao(l, 9) != -1 {
+			t.Errorf("%s: elemento ausente", nome)
+		}
+		// O último elemento continua acessível pelo fim (tail correto)
+		if got, _ := l.Get(l.Size() - 1); got != 2 || l.Size() != 5 {
+			t.Errorf("%s: último elemento %d, tamanho %d", nome, got, l.Size())
+		}
+	}
+}
+
+func TestMoverParaFrenteComAcessosConcentrados(t *testing.T) {
+	// 90% das buscas vão para 5 de 200 valores: depois de organizada, a
+	// lista encontra esses valores logo no início
+	rng := rand.New(rand.NewPCG(4, 5))
+	var contadores instrument.Counters
+	l := list.NewLinkedList[int]()
+	l.SetCounters(&contadores)
+	for i := 0; i < 200; i++ {
+		l.Add(i)
+	}
+	procura := func() int {
+		if rng.IntN(10) < 9 {
+			return 190 + rng.IntN(5)
+		}
+		return rng.IntN(200)
+	}
+	for i := 0; i < 1000; i++ {
+		buscas.BuscaMoverParaFrente[int](l, procura())
+	}
+	contadores.Reset()
+	for i := 0; i < 1000; i++ {
+		buscas.BuscaMoverParaFrente[int](l, procura())
+	}
+	if media := float64(contadores.Comparisons) / 1000; media > 30 {
+		t.Errorf("%.1f comparações por busca após organizar", media)
+	}
+	if err := l.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuscaBinariaLista(t *testing.T) {
+	valores := []int{1, 3, 3, 3, 7, 9, 12}
+	for nome, l := range listas(valores...) {
+		for elemento := 0; elemento <= 13; elemento++ {
+			if got, esperado := buscas.BuscaBinariaLista(l, elemento), buscas.BuscaBinaria(valores, elemento); got != esperado {
+				t.Errorf("%s: BuscaBinariaLista(%d) = %d, esperado %d", nome, elemento, got, esperado)
+			}
+		}
+	}
+	
+	// ArrayList: log₂ n comparações, lendo direto do array interno
+	var contadores instrument.Counters
+	array := list.NewArrayList[int](1 << 16)
+	for i := 0; i < 1<<16; i++ {
+		array.Add(i)
+	}
+	if buscas.BuscaBinariaArrayList(array, 40000, &contadores) != 40000 || contadores.Comparisons != 16 {
+		t.Errorf("BuscaBinariaArrayList: %d comparações", contadores.Comparisons)
+	}
+}
+
+func TestBuscaEmFilasPilhasEDeques(t *testing.T) {
+	filas := map[string]queue.Queue{"ArrayQueue": queue.NewArrayQueue(2), "LinkedQueue": queue.NewLinkedQueue()}
+	for nome, f := range filas {
+		for _, v := range []int{10, 20, 30, 20} {
+			f.Enqueue(v)
+		}
+		if buscas.BuscaFila(f, 20) != 1 || buscas.BuscaFila(f, 99) != -1 {
+			t.Errorf("%s: BuscaFila", nome)
+		}
+		if got := f.ToSlice(); !slices.Equal(got, []int{10, 20, 30, 20}) {
+			t.Errorf("%s: fila alterada: %v", nome, got)
+		}
+	}
+	prioridades := queue.NewMinPriorityQueue(4)
+	for _, v := range []int{30, 10, 20} {
+		prioridades.Enqueue(v)
+	}
+	if buscas.BuscaFila(prioridades, 30) != 2 || prioridades.Size() != 3 {
+		t.Error("BuscaFila na PriorityQueue")
+	}
+	
+	pilhas := map[string]stack.Stack{"ArrayStack": stack.NewArrayStack(2), "LinkedStack": stack.NewLinkedStack()}
+	for nome, p := range pilhas {
+		for _, v := range []int{1, 2, 3, 4} {
+			p.Push(v)
+		}
+		var contadores instrument.Counters
+		if got := buscas.BuscaPilha(p, 3, &contadores); got != 1 || contadores.Comparisons != 2 {
+			t.Errorf("%s: BuscaPilha(3) = %d com %d comparações", nome, got, contadores.Comparisons)
+		}
+		if buscas.BuscaPilha(p, 5) != -1 || !slices.Equal(p.ToSlice(), []int{4, 3, 2, 1}) {
+			t.Errorf("%s: pilha alterada: %v", nome, p.ToSlice())
+		}
+	}
+	
+	deques := map[string]deque.IDeque{
+		"ArrayDeque": deque.NewArrayDeque(2), "LinkedListDeque": deque.NewLinkedListDeque(), "Deque": deque.NewDeque(),
+	}
+	for nome, d := range deques {
+		for _, v := range []int{5, 6, 7, 8} {
+			d.EnqueueRear(v)
+		}
+		var contadores instrument.Counters
+		if got := buscas.BuscaDeque(d, 6, &contadores); got != 1 || contadores.Comparisons != 2 {
+			t.Errorf("%s: BuscaDeque(6) = %d com %d comparações", nome, got, contadores.Comparisons)
+		}
+		if buscas.BuscaDeque(d, 8) != 3 || buscas.BuscaDeque(d, 9) != -1 {
+			t.Errorf("%s: BuscaDeque", nome)
+		}
+		if got := d.ToSlice(); !slices.Equal(got, []int{5, 6, 7, 8}) {
+			t.Errorf("%s: deque alterado: %v", nome, got)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package buscas
+
+import (
+	"iter"
+
+	"dca3503/deque"
+	"dca3503/instrument"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+// ============================================================================
+// BUSCA COM SENTINELA
+// ============================================================================
+
+// BuscaSequencialSentinela é a busca sequencial com sentinela numa fatia: o
+// último elemento é trocado pelo procurado, de modo que o laço sempre para
+// e só testa o valor (sem "i < n" a cada passo), e depois é restaurado
+// A fatia é modificada durante a busca: não use com outras goroutines lendo
+// Complexidade: O(n), com um teste por elemento em vez de dois
+func BuscaSequencialSentinela[T comparable](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	n := len(lista)
+	if n == 0 {
+		return -1
+	}
+	
+	ultimo := lista[n-1]
+	lista[n-1] = elemento
+	i := 0
+	for {
+		c.AddComparisons(1)
+		if lista[i] == elemento {
+			break
+		}
+		i++
+	}
+	lista[n-1] = ultimo
+	
+	if i < n-1 {
+		return i
+	}
+	c.AddComparisons(1)
+	if ultimo == elemento {
+		return n - 1
+	}
+	return -1
+}
+
+// BuscaSentinela faz a busca com sentinela diretamente na lista, sem ToSlice
+// ArrayList usa a posição livre depois do último elemento e DoublyLinkedList
+// pendura um nó no tail; as demais listas (como LinkedList, que não guarda o
+// último nó) usam IndexOf
+// As comparações são contadas pelos contadores da própria lista (SetCounters)
+// Complexidade: O(n)
+func BuscaSentinela[T comparable](lista list.List[T], elemento T) int {
+	if l, ok := lista.(interface{ SentinelIndexOf(T) int }); ok {
+		return l.SentinelIndexOf(elemento)
+	}
+	return lista.IndexOf(elemento)
+}
+
+// ============================================================================
+// BUSCA AUTO-ORGANIZÁVEL
+// ============================================================================
+
+// As buscas auto-organizáveis reordenam a lista a cada acerto, para que os
+// valores mais procurados fiquem perto do início e as próximas buscas por
+// eles saiam mais baratas. Retornam o índice em que o elemento estava antes
+// da reorganização, ou -1
+// LinkedList e DoublyLinkedList religam o nó encontrado no lugar e ArrayList
+// desloca o próprio array; outras implementações de list.List usam Remove e
+// AddOnIndex. As comparações são contadas pelos contadores da própria lista
+
+// BuscaMoverParaFrente procura o elemento e move a primeira ocorrência para
+// o início da lista
+// Complexidade: O(i), com i a posição do elemento
+func BuscaMoverParaFrente[T comparable](lista list.List[T], elemento T) int {
+	if l, ok := lista.(interface{ FindAndMoveToFront(T) int }); ok {
+		return l.FindAndMoveToFront(elemento)
+	}
+	i := lista.IndexOf(elemento)
+	if i > 0 {
+		lista.Remove(i)
+		lista.AddOnIndex(elemento, 0)
+	}
+	return i
+}
+
+// BuscaTransposicao procura o elemento e troca a primeira ocorrência de
+// lugar com a anterior
+// Complexidade: O(i), com i a posição do elemento
+func BuscaTransposicao[T comparable](lista list.List[T], elemento T) int {
+	if l, ok := lista.(interface{ FindAndTranspose(T) int }); ok {
+		return l.FindAndTranspose(elemento)
+	}
+	i := lista.IndexOf(elemento)
+	if i > 0 {
+		lista.Remove(i)
+		lista.AddOnIndex(elemento, i-1)
+	}
+	return i
+}
+
+// ============================================================================
+// BUSCA BINÁRIA EM list.List
+// ============================================================================
+
+// BuscaBinariaArrayList retorna o índice da primeira ocorrência do elemento
+// no ArrayList ordenado, ou -1
+// Lê as posições com Get, que é Θ(1) no ArrayList: nada é copiado
+// Complexidade: O(log n)
+func BuscaBinariaArrayList[T list.Ordered](lista *list.ArrayList[T], elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	valor := func(i int) T {
+		v, _ := lista.Get(i)
+		return v
+	}
+	i := SearchFunc(lista.Size(), func(i int) bool {
+		return compara(c, valor(i), elemento) >= 0
+	})
+	if i < lista.Size() && valor(i) == elemento {
+		return i
+	}
+	return -1
+}
+
+// BuscaBinariaLista retorna o índice da primeira ocorrência do elemento na
+// lista ordenada, ou -1, escolhendo a estratégia pelo tipo de lista:
+// - ArrayList: busca binária sem cópia (BuscaBinariaArrayList)
+// - Listas ligadas: busca binária com Get custaria O(n) por acesso, então
+// percorre em ordem e para no primeiro elemento >= procurado
+// - Outras listas: busca binária com Get
+// Complexidade: O(log n) com acesso aleatório; O(n) nas listas ligadas
+func BuscaBinariaLista[T list.Ordered](lista list.List[T], elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	switch l := lista.(type) {
+	case *list.ArrayList[T]:
+		return BuscaBinariaArrayList(l, elemento, contadores...)
+	case interface{ Values() iter.Seq[T] }:
+		i := 0
+		for v := range l.Values() {
+			switch compara(c, v, elemento) {
+			case 0:
+				return i
+			case 1:
+				return -1
+			}
+			i++
+		}
+		return -1
+	}
+	
+	i := SearchFunc(lista.Size(), func(i int) bool {
+		v, _ := lista.Get(i)
+		return compara(c, v, elemento) >= 0
+	})
+	if v, err := lista.Get(i); err == nil && v == elemento {
+		return i
+	}
+	return -1
+}
+
+// ============================================================================
+// BUSCA EM FILAS, PILHAS E DEQUES
+// ============================================================================
+
+// Estas buscas usam só as operações do TAD (enfileirar, desempilhar etc.) e
+// devolvem a estrutura exatamente como estava. Servem para qualquer
+// implementação das interfaces, sem acesso ao armazenamento interno
+
+// BuscaFila retorna a posição do elemento a partir do início da fila
+// (0 = Front), ou -1
+// Todos os elementos passam por uma fila auxiliar e voltam na mesma ordem;
+// por isso funciona também com a PriorityQueue, em que a posição é a ordem
+// de saída (uma rotação Dequeue → Enqueue nela retiraria sempre o mesmo)
+// Complexidade: Θ(n) tempo e memória (a fila precisa ser percorrida inteira
+// para voltar ao estado original)
+func BuscaFila(fila queue.Queue, elemento int, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	aux := queue.NewArrayQueue(fila.Size())
+	posicao := -1
+	
+	for i := 0; !fila.IsEmpty(); i++ {
+		valor, _ := fila.Dequeue()
+		if posicao == -1 && compara(c, valor, elemento) == 0 {
+			posicao = i
+		}
+		aux.Enqueue(valor)
+	}
+	for !aux.IsEmpty() {
+		valor, _ := aux.Dequeue()
+		fila.Enqueue(valor)
+	}
+	return posicao
+}
+
+// BuscaPilha retorna a distância do elemento ao topo da pilha (0 = Peek),
+// ou -1
+// Desempilha numa pilha auxiliar só até encontrar o elemento e depois
+// devolve o que foi retirado
+// Complexidade: O(i) tempo e memória, com i a distância até o topo
+func BuscaPilha(pilha stack.Stack, elemento int, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	aux := stack.NewArrayStack(0) // Capacidade padrão; cresce se preciso
+	posicao := -1
+	
+	for i := 0; !pilha.IsEmpty(); i++ {
+		valor, _ := pilha.Peek()
+		if compara(c, valor, elemento) == 0 {
+			posicao = i
+			break
+		}
+		pilha.Pop()
+		aux.Push(valor)
+	}
+	for !aux.IsEmpty() {
+		valor, _ := aux.Pop()
+		pilha.Push(valor)
+	}
+	return posicao
+}
+
+// BuscaDeque retorna a posição do elemento a partir do início do deque
+// (0 = Front), ou -1
+// Gira o deque (DequeueFront → EnqueueRear) até passar pelo elemento e
+// depois desfaz o giro pelo outro lado (DequeueRear → EnqueueFront): como o
+// deque mexe nas duas pontas, não precisa de estrutura auxiliar nem de
+// percorrer o resto
+// Complexidade: O(i) tempo e O(1) memória, com i a posição do elemento
+func BuscaDeque(d deque.IDeque, elemento int, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	posicao := -1
+	girados := 0
+	
+	for girados < d.Size() {
+		valor, _ := d.DequeueFront()
+		d.EnqueueRear(valor)
+		girados++
+		if compara(c, valor, elemento) == 0 {
+			posicao = girados - 1
+			break
+		}
+	}
+	for ; girados > 0; girados-- {
+		valor, _ := d.DequeueRear()
+		d.EnqueueFront(valor)
+	}
+	return posicao
+}
@@ -0,0 +1,130 @@
+package buscas
+
+import (
+	"cmp"
+
+	"dca3503/instrument"
+	"dca3503/list"
+)
+
+// ============================================================================
+// CONTAGEM DE COMPARAÇÕES
+// ============================================================================
+
+// Todas as buscas genéricas aceitam, como último argumento opcional, um
+// *instrument.Counters que acumula as comparações feitas:
+//
+//	var contadores instrument.Counters
+//	i := buscas.LowerBound(lista, 42, &contadores)
+//	fmt.Println(contadores.Comparisons)
+//
+// Cada comparação de três vias entre um elemento e o valor procurado conta 1
+// (o resultado diz menor, igual ou maior de uma só vez), assim como cada
+// chamada ao predicado de SearchFunc
+
+// contador retorna o contador opcional (nil = contagem desligada)
+func contador(contadores []*instrument.Counters) *instrument.Counters {
+	if len(contadores) == 0 {
+		return nil
+	}
+	return contadores[0]
+}
+
+// compara faz a comparação de três vias contabilizando-a
+func compara[T list.Ordered](c *instrument.Counters, a, b T) int {
+	c.AddComparisons(1)
+	return cmp.Compare(a, b)
+}
+
+// ============================================================================
+// LIMITES INFERIOR E SUPERIOR
+// ============================================================================
+
+// LowerBound retorna o primeiro índice i com lista[i] >= elemento
+// (len(lista) se todos forem menores). A lista deve estar em ordem crescente
+// Com elementos repetidos, é a posição da primeira ocorrência; sem o elemento,
+// é a posição em que ele entraria mantendo a ordem
+// Complexidade: O(log n), sempre ⌈log₂(n+1)⌉ comparações no máximo
+// Pseudocódigo:
+// 1. Intervalo [esquerda, direita) = [0, n): a resposta está nele
+// 2. Se lista[meio] < elemento, a resposta está depois do meio
+// 3. Senão o meio é candidato: direita = meio
+// 4. Quando o intervalo ficar vazio, esquerda é a resposta
+func LowerBound[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	return lowerBound(lista, elemento, 0, len(lista), contador(contadores))
+}
+
+// lowerBound é o LowerBound restrito ao intervalo [esquerda, direita)
+func lowerBound[T list.Ordered](lista []T, elemento T, esquerda, direita int, c *instrument.Counters) int {
+	for esquerda < direita {
+		meio := esquerda + (direita-esquerda)/2
+		if compara(c, lista[meio], elemento) < 0 {
+			esquerda = meio + 1
+		} else {
+			direita = meio
+		}
+	}
+	return esquerda
+}
+
+// UpperBound retorna o primeiro índice i com lista[i] > elemento
+// (len(lista) se nenhum for maior). A lista deve estar em ordem crescente
+// Complexidade: O(log n)
+func UpperBound[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	esquerda, direita := 0, len(lista)
+	for esquerda < direita {
+		meio := esquerda + (direita-esquerda)/2
+		if compara(c, lista[meio], elemento) <= 0 {
+			esquerda = meio + 1
+		} else {
+			direita = meio
+		}
+	}
+	return esquerda
+}
+
+// EqualRange retorna o intervalo [inicio, fim) das ocorrências do elemento
+// fim - inicio é o número de ocorrências; inicio == fim quando não há nenhuma
+// Complexidade: O(log n)
+func EqualRange[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) (inicio, fim int) {
+	return LowerBound(lista, elemento, contadores...), UpperBound(lista, elemento, contadores...)
+}
+
+// BuscaBinariaGenerica retorna o índice da primeira ocorrência do elemento
+// na lista ordenada, ou -1 se ele não existir
+// Complexidade: O(log n)
+func BuscaBinariaGenerica[T list.Ordered](lista []T, elemento T, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	i := lowerBound(lista, elemento, 0, len(lista), c)
+	if i < len(lista) && compara(c, lista[i], elemento) == 0 {
+		return i
+	}
+	return -1
+}
+
+// ============================================================================
+// BUSCA BINÁRIA SOBRE UM PREDICADO
+// ============================================================================
+
+// SearchFunc retorna o menor i em [0, n) para o qual predicado(i) é true,
+// ou n se não houver nenhum
+// O predicado precisa ser monótono: false, ..., false, true, ..., true.
+// Serve para qualquer pergunta do tipo "qual o primeiro que satisfaz",
+// inclusive sem uma lista: menor capacidade que comporta a carga, primeira
+// versão com defeito etc.
+// Complexidade: O(log n) chamadas ao predicado
+func SearchFunc(n int, predicado func(i int) bool, contadores ...*instrument.Counters) int {
+	c := contador(contadores)
+	esquerda, direita := 0, n
+	for esquerda < direita {
+		meio := esquerda + (direita-esquerda)/2
+		c.AddComparisons(1)
+		if predicado(meio) {
+			direita = meio
+		} else {
+			esquerda = meio + 1
+		}
+	}
+	return esquerda
+}
@@ -0,0 +1,137 @@
+// Package cache implementa caches de capacidade limitada sobre a
+// DoublyLinkedList do pacote list: LRUCache (descarta o usado há mais tempo)
+// e LFUCache (descarta o usado menos vezes).
+//
+// Os dois guardam em um map a chave e o nó da lista onde ela está; com o nó
+// em mãos, mover ou remover a chave da lista é O(1), sem percorrê-la.
+package cache
+
+import (
+	"fmt"
+	"time"
+)
+
+// ============================================================================
+// INTERFACE CACHE
+// ============================================================================
+
+// Cache define o contrato comum de LRUCache e LFUCache
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)  // Busca e conta como uso
+	Peek(key K) (V, bool) // Busca sem contar como uso
+	Put(key K, value V)   // Insere ou atualiza, descartando se cheio
+	Remove(key K) bool    // Remove a chave, se existir
+	Contains(key K) bool  // Verifica sem contar como uso
+	Size() int            // Número de entradas válidas ou ainda não expurgadas
+	Capacity() int        // Número máximo de entradas
+	Clear()               // Remove todas as entradas
+	GetStatistics() map[string]interface{}
+}
+
+// DefaultCapacity é usada quando a capacidade informada não é positiva
+const DefaultCapacity = 16
+
+// ============================================================================
+// DESCARTE
+// ============================================================================
+
+// EvictionReason indica por que uma entrada saiu do cache
+type EvictionReason int
+
+const (
+	EvictedCapacity EvictionReason = iota // Cache cheio: a política escolheu a vítima
+	EvictedExpired                        // O TTL da entrada venceu
+	EvictedRemoved                        // Remove ou Clear explícitos
+)
+
+// String retorna o nome do motivo em português
+func (r EvictionReason) String() string {
+	switch r {
+	case EvictedCapacity:
+		return "capacidade"
+	case EvictedExpired:
+		return "expirada"
+	case EvictedRemoved:
+		return "removida"
+	}
+	return fmt.Sprintf("EvictionReason(%d)", int(r))
+}
+
+// EvictionCallback é chamado para cada entrada que sai do cache
+// Não é chamado quando Put apenas substitui o valor de uma chave existente
+type EvictionCallback[K comparable, V any] func(key K, value V, reason EvictionReason)
+
+// ============================================================================
+// CONFIGURAÇÃO E ESTATÍSTICAS COMPARTILHADAS
+// ============================================================================
+
+// settings guarda o que LRUCache e LFUCache têm em comum
+type settings[K comparable, V any] struct {
+	capacity    int
+	ttl         time.Duration // 0 = entradas não expiram
+	onEvict     EvictionCallback[K, V]
+	now         func() time.Time
+	hits        int
+	misses      int
+	evictions   int
+	expirations int
+}
+
+// newSettings normaliza a capacidade e usa o relógio do sistema
+func newSettings[K comparable, V any](capacity int) settings[K, V] {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	return settings[K, V]{capacity: capacity, now: time.Now}
+}
+
+// deadline calcula o instante de expiração (zero = nunca expira)
+func (s *settings[K, V]) deadline(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return s.now().Add(ttl)
+}
+
+// expired verifica se um prazo já passou
+func (s *settings[K, V]) expired(expires time.Time) bool {
+	return !expires.IsZero() && !s.now().Before(expires)
+}
+
+// evicted atualiza os contadores e chama o callback
+func (s *settings[K, V]) evicted(key K, value V, reason EvictionReason) {
+	switch reason {
+	case EvictedCapacity:
+		s.evictions++
+	case EvictedExpired:
+		s.expirations++
+	}
+	if s.onEvict != nil {
+		s.onEvict(key, value, reason)
+	}
+}
+
+// statistics monta o mapa no formato de GetStatistics das outras estruturas
+func (s *settings[K, V]) statistics(size int) map[string]interface{} {
+	hitRate := 0.0
+	if lookups := s.hits + s.misses; lookups > 0 {
+		hitRate = float64(s.hits) / float64(lookups)
+	}
+	return map[string]interface{}{
+		"size":        size,
+		"capacity":    s.capacity,
+		"isEmpty":     size == 0,
+		"isFull":      size == s.capacity,
+		"hits":        s.hits,
+		"misses":      s.misses,
+		"hitRate":     hitRate,
+		"evictions":   s.evictions,
+		"expirations": s.expirations,
+		"ttl":         s.ttl,
+	}
+}
+
+// resetStatistics zera acertos, falhas e descartes
+func (s *settings[K, V]) resetStatistics() {
+	s.hits, s.misses, s.evictions, s.expirations = 0, 0, 0, 0
+}
@@ -0,0 +1,262 @@
+package cache_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"dca3503/cache"
+)
+
+var (
+	_ cache.Cache[string, int] = (*cache.LRUCache[string, int])(nil)
+	_ cache.Cache[string, int] = (*cache.LFUCache[string, int])(nil)
+)
+
+// fakeClock é um relógio controlado pelo teste
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestLRUEvictionOrder(t *testing.T) {
+	lru := cache.NewLRUCache[string, int](3)
+	evicted := []string{}
+	lru.SetOnEvict(func(key string, value int, reason cache.EvictionReason) {
+		evicted = append(evicted, fmt.Sprintf("%s=%d/%s", key, value, reason))
+	})
+	
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Get("a")     // a passa a ser o mais recente
+	lru.Put("d", 4)  // descarta b
+	lru.Put("c", 30) // atualizar não descarta e conta como uso
+	lru.Peek("a")    // Peek não muda a ordem
+	lru.Put("e", 5)  // descarta a
+	lru.Remove("d")
+	
+	if got := strings.Join(lru.Keys(), ","); got != "e,c" {
+		t.Errorf("Keys() = %s, esperado e,c", got)
+	}
+	want := []string{"b=2/capacidade", "a=1/capacidade", "d=4/removida"}
+	if !slices.Equal(evicted, want) {
+		t.Errorf("descartes %v, esperado %v", evicted, want)
+	}
+	if value, ok := lru.Get("c"); !ok || value != 30 {
+		t.Errorf("Get(c) = %d, %v", value, ok)
+	}
+	
+	stats := lru.GetStatistics()
+	if stats["hits"] != 2 || stats["misses"] != 0 || stats["evictions"] != 2 || stats["size"] != 2 {
+		t.Errorf("estatísticas %v", stats)
+	}
+}
+
+func TestLFUEvictionOrder(t *testing.T) {
+	lfu := cache.NewLFUCache[string, int](3)
+	evicted := []string{}
+	lfu.SetOnEvict(func(key string, value int, reason cache.EvictionReason) {
+		evicted = append(evicted, key)
+	})
+	
+	lfu.Put("a", 1)
+	lfu.Put("b", 2)
+	lfu.Put("c", 3)
+	lfu.Get("a")
+	lfu.Get("a")
+	lfu.Get("b")
+	lfu.Put("d", 4) // c tem a menor frequência (1)
+	lfu.Put("e", 5) // d é o único no balde de frequência 1
+	lfu.Get("e")
+	lfu.Get("e")
+	lfu.Put("f", 6) // a(3), b(2), e(3): b é o menos usado
+	
+	if !slices.Equal(evicted, []string{"c", "d", "b"}) {
+		t.Errorf("descartes %v, esperado [c d b]", evicted)
+	}
+	if lfu.Frequency("a") != 3 || lfu.Frequency("e") != 3 || lfu.Frequency("f") != 1 {
+		t.Errorf("frequências a=%d e=%d f=%d", lfu.Frequency("a"), lfu.Frequency("e"), lfu.Frequency("f"))
+	}
+	// Empate em frequência: e foi usado por último, então vem antes de a
+	if got := strings.Join(lfu.Keys(), ","); got != "e,a,f" {
+		t.Errorf("Keys() = %s, esperado e,a,f", got)
+	}
+	
+	// Remover o único da menor frequência obriga a procurar o novo mínimo
+	lfu.Remove("f")
+	lfu.Resize(1)
+	if !slices.Equal(evicted[3:], []string{"f", "a"}) || lfu.Validate() != nil {
+		t.Errorf("descartes %v, esperado f e a ao final", evicted)
+	}
+}
+
+func TestTTLExpiration(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	caches := []interface {
+		cache.Cache[string, int]
+		SetClock(func() time.Time)
+		SetTTL(time.Duration)
+		PutWithTTL(string, int, time.Duration)
+		PurgeExpired() int
+	}{cache.NewLRUCache[string, int](4), cache.NewLFUCache[string, int](4)}
+	
+	for _, c := range caches {
+		c.SetClock(clock.Now)
+		c.SetTTL(time.Minute)
+		c.Put("curto", 1)
+		c.PutWithTTL("longo", 2, time.Hour)
+		c.PutWithTTL("eterno", 3, 0)
+		c.Put("outro", 4)
+		
+		clock.Advance(59 * time.Second)
+		if !c.Contains("curto") {
+			t.Errorf("%T: curto expirou antes do TTL", c)
+		}
+		clock.Advance(time.Second)
+		if _, ok := c.Get("curto"); ok {
+			t.Errorf("%T: curto deveria ter expirado", c)
+		}
+		if removed := c.PurgeExpired(); removed != 1 || c.Size() != 2 {
+			t.Errorf("%T: PurgeExpired = %d, tamanho %d", c, removed, c.Size())
+		}
+		clock.Advance(24 * time.Hour)
+		if c.Contains("longo") || !c.Contains("eterno") {
+			t.Errorf("%T: longo deveria expirar e eterno não", c)
+		}
+		if stats := c.GetStatistics(); stats["expirations"] != 3 || stats["misses"] != 1 {
+			t.Errorf("%T: estatísticas %v", c, stats)
+		}
+	}
+}
+
+// modelCache é a referência ingênua: uma fatia com último uso e frequência
+type modelEntry struct {
+	key, value, uses, lastUse int
+}
+
+type modelCache struct {
+	lfu      bool
+	capacity int
+	clock    int
+	entries  []modelEntry
+}
+
+func (m *modelCache) find(key int) int {
+	return slices.IndexFunc(m.entries, func(e modelEntry) bool { return e.key == key })
+}
+
+func (m *modelCache) get(key int) (int, bool) {
+	m.clock++
+	i := m.find(key)
+	if i < 0 {
+		return 0, false
+	}
+	m.entries[i].uses++
+	m.entries[i].lastUse = m.clock
+	return m.entries[i].value, true
+}
+
+func (m *modelCache) put(key, value int) (evicted int, ok bool) {
+	m.clock++
+	if i := m.find(key); i >= 0 {
+		m.entries[i].value = value
+		m.entries[i].uses++
+		m.entries[i].lastUse = m.clock
+		return 0, false
+	}
+	if len(m.entries) == m.capacity {
+		victim := 0
+		for i, e := range m.entries {
+			v := m.entries[victim]
+			if (m.lfu && (e.uses < v.uses || e.uses == v.uses && e.lastUse < v.lastUse)) ||
+				(!m.lfu && e.lastUse < v.lastUse) {
+				victim = i
+			}
+		}
+		evicted, ok = m.entries[victim].key, true
+		m.entries = slices.Delete(m.entries, victim, victim+1)
+	}
+	m.entries = append(m.entries, modelEntry{key: key, value: value, uses: 1, lastUse: m.clock})
+	return evicted, ok
+}
+
+func TestCachesAgainstModel(t *testing.T) {
+	for _, lfu := range []bool{false, true} {
+		rng := rand.New(rand.NewPCG(3, 5))
+		var c cache.Cache[int, int]
+		if lfu {
+			c = cache.NewLFUCache[int, int](8)
+		} else {
+			c = cache.NewLRUCache[int, int](8)
+		}
+		evicted := []int{}
+		switch typed := c.(type) {
+		case *cache.LRUCache[int, int]:
+			typed.SetOnEvict(func(key, value int, reason cache.EvictionReason) { evicted = append(evicted, key) })
+		case *cache.LFUCache[int, int]:
+			typed.SetOnEvict(func(key, value int, reason cache.EvictionReason) { evicted = append(evicted, key) })
+		}
+		model := &modelCache{lfu: lfu, capacity: 8}
+		
+		for step := 0; step < 5000; step++ {
+			key := rng.IntN(20)
+			if rng.IntN(2) == 0 {
+				got, gotOK := c.Get(key)
+				want, wantOK := model.get(key)
+				if got != want || gotOK != wantOK {
+					t.Fatalf("lfu=%v passo %d: Get(%d) = %d, %v; modelo %d, %v", lfu, step, key, got, gotOK, want, wantOK)
+				}
+				continue
+			}
+			evicted = evicted[:0]
+			c.Put(key, step)
+			victim, ok := model.put(key, step)
+			if ok != (len(evicted) == 1) || (ok && evicted[0] != victim) {
+				t.Fatalf("lfu=%v passo %d: Put(%d) descartou %v, modelo %d (%v)", lfu, step, key, evicted, victim, ok)
+			}
+		}
+		if c.Size() != len(model.entries) {
+			t.Errorf("lfu=%v: tamanho %d, modelo %d", lfu, c.Size(), len(model.entries))
+		}
+	}
+}
+
+func TestResizeAndClear(t *testing.T) {
+	lru := cache.NewLRUCache[int, string](0)
+	if lru.Capacity() != cache.DefaultCapacity {
+		t.Errorf("capacidade padrão %d", lru.Capacity())
+	}
+	for i := 0; i < 10; i++ {
+		lru.Put(i, fmt.Sprint(i))
+	}
+	lru.Resize(3)
+	if got := fmt.Sprint(lru.Keys()); got != "[9 8 7]" {
+		t.Errorf("após Resize(3): %s", got)
+	}
+	if got := lru.String(); got != "LRU [9:9, 8:8, 7:7] (3/3)" {
+		t.Errorf("String() = %q", got)
+	}
+	
+	removed := 0
+	lru.SetOnEvict(func(int, string, cache.EvictionReason) { removed++ })
+	lru.Clear()
+	if removed != 3 || lru.Size() != 0 || lru.Validate() != nil {
+		t.Errorf("Clear: %d callbacks, tamanho %d", removed, lru.Size())
+	}
+	
+	lfu := cache.NewLFUCache[int, string](4)
+	for i := 0; i < 4; i++ {
+		lfu.Put(i, fmt.Sprint(i))
+		for j := 0; j < i; j++ {
+			lfu.Get(i)
+		}
+	}
+	lfu.Resize(2)
+	if got := lfu.String(); got != "LFU [3:3(×4), 2:2(×3)] (2/2)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,372 @@
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+	"time"
+
+	"dca3503/instrument"
+	"dca3503/list"
+)
+
+// ============================================================================
+// LFUCACHE - DESCARTA O USADO MENOS VEZES
+// ============================================================================
+
+// lfuEntry é o valor guardado no map: o dado, a frequência e o nó no balde
+type lfuEntry[K comparable, V any] struct {
+	value     V
+	frequency int                 // Quantas vezes foi usada (Put conta 1)
+	node      *list.DoublyNode[K] // Nó da chave no balde da sua frequência
+	expires   time.Time           // Zero = não expira
+}
+
+// LFUCache implementa um cache Least Frequently Used com baldes de frequência
+// Características:
+// - um balde (DoublyLinkedList de chaves) para cada frequência em uso
+// - dentro do balde, o início é o mais recente: empates saem por LRU
+// - minFrequency aponta o balde da próxima vítima
+// - Get e Put são O(1): usar uma chave move seu nó do balde f para o f+1
+type LFUCache[K comparable, V any] struct {
+	settings[K, V]
+	entries      map[K]*lfuEntry[K, V]
+	buckets      map[int]*list.DoublyLinkedList[K] // Frequência → chaves
+	minFrequency int                               // Nenhum balde tem frequência menor
+	counters     *instrument.Counters
+}
+
+// NewLFUCache cria um cache LFU com a capacidade informada
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		settings: newSettings[K, V](capacity),
+		entries:  make(map[K]*lfuEntry[K, V]),
+		buckets:  make(map[int]*list.DoublyLinkedList[K]),
+	}
+}
+
+// SetTTL define o tempo de vida padrão das entradas inseridas por Put (0 = não expiram)
+func (c *LFUCache[K, V]) SetTTL(ttl time.Duration) {
+	c.ttl = ttl
+}
+
+// SetOnEvict registra a função chamada quando uma entrada sai do cache
+func (c *LFUCache[K, V]) SetOnEvict(callback EvictionCallback[K, V]) {
+	c.onEvict = callback
+}
+
+// SetClock troca o relógio usado para o TTL (útil em testes)
+func (c *LFUCache[K, V]) SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	c.now = now
+}
+
+// SetCounters associa contadores de operações aos baldes (nil desliga a contagem)
+func (c *LFUCache[K, V]) SetCounters(counters *instrument.Counters) {
+	c.counters = counters
+	for _, bucket := range c.buckets {
+		bucket.SetCounters(counters)
+	}
+}
+
+// ============================================================================
+// OPERAÇÕES PRINCIPAIS
+// ============================================================================
+
+// Get retorna o valor da chave e incrementa sua frequência
+// Complexidade: O(1)
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	defer checkInvariants(c)
+	entry, ok := c.lookup(key)
+	if !ok {
+		c.misses++
+		var zero V
+		return zero, false
+	}
+	c.hits++
+	c.touch(key, entry)
+	return entry.value, true
+}
+
+// Peek retorna o valor sem alterar a frequência nem as estatísticas
+// Complexidade: O(1)
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	entry, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Contains verifica se a chave está no cache (e não expirou)
+// Complexidade: O(1)
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+	return ok
+}
+
+// Frequency retorna quantas vezes a chave foi usada (0 se ausente)
+func (c *LFUCache[K, V]) Frequency(key K) int {
+	if entry, ok := c.lookup(key); ok {
+		return entry.frequency
+	}
+	return 0
+}
+
+// Put insere ou atualiza a chave com o TTL padrão
+// Complexidade: O(1) amortizado
+func (c *LFUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL insere ou atualiza a chave com um TTL específico (0 = não expira)
+// Atualizar uma chave existente conta como uso
+// Complexidade: O(1) amortizado
+// Pseudocódigo:
+// 1. Chave existente: trocar valor e prazo e subir de balde (touch)
+// 2. Cache cheio: remover o último nó do balde minFrequency
+// 3. Inserir no início do balde 1; minFrequency = 1
+func (c *LFUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	defer checkInvariants(c)
+	if entry, ok := c.entries[key]; ok {
+		entry.value = value
+		entry.expires = c.deadline(ttl)
+		c.touch(key, entry)
+		return
+	}
+	
+	if len(c.entries) >= c.capacity {
+		c.evictLeastFrequent()
+	}
+	c.entries[key] = &lfuEntry[K, V]{
+		value:     value,
+		frequency: 1,
+		node:      c.bucket(1).AddFirstNode(key),
+		expires:   c.deadline(ttl),
+	}
+	c.minFrequency = 1
+}
+
+// Remove retira a chave do cache
+// Complexidade: O(1)
+func (c *LFUCache[K, V]) Remove(key K) bool {
+	defer checkInvariants(c)
+	entry, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.unlink(key, entry, EvictedRemoved)
+	return true
+}
+
+// lookup encontra a entrada, descartando-a se o TTL venceu
+func (c *LFUCache[K, V]) lookup(key K) (*lfuEntry[K, V], bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.expired(entry.expires) {
+		c.unlink(key, entry, EvictedExpired)
+		return nil, false
+	}
+	return entry, true
+}
+
+// bucket retorna o balde da frequência, criando-o se necessário
+func (c *LFUCache[K, V]) bucket(frequency int) *list.DoublyLinkedList[K] {
+	bucket, ok := c.buckets[frequency]
+	if !ok {
+		bucket = list.NewDoublyLinkedList[K]()
+		bucket.SetCounters(c.counters)
+		c.buckets[frequency] = bucket
+	}
+	return bucket
+}
+
+// detach tira o nó do balde atual, apagando o balde se ele esvaziar
+func (c *LFUCache[K, V]) detach(entry *lfuEntry[K, V]) {
+	bucket := c.buckets[entry.frequency]
+	bucket.RemoveNode(entry.node)
+	if bucket.IsEmpty() {
+		delete(c.buckets, entry.frequency)
+	}
+}
+
+// touch move a chave do balde f para o início do balde f+1
+func (c *LFUCache[K, V]) touch(key K, entry *lfuEntry[K, V]) {
+	c.detach(entry)
+	if entry.frequency == c.minFrequency && c.buckets[entry.frequency] == nil {
+		c.minFrequency++
+	}
+	entry.frequency++
+	entry.node = c.bucket(entry.frequency).AddFirstNode(key)
+}
+
+// evictLeastFrequent remove o menos recente entre os menos usados
+// Remove e expirações podem esvaziar o balde de minFrequency; nesse caso o
+// menor balde é procurado entre os existentes (O(f) para f frequências)
+func (c *LFUCache[K, V]) evictLeastFrequent() {
+	if len(c.entries) == 0 {
+		return
+	}
+	if c.buckets[c.minFrequency] == nil {
+		c.minFrequency = c.lowestFrequency()
+	}
+	
+	bucket := c.buckets[c.minFrequency]
+	key, _ := bucket.RemoveLast()
+	if bucket.IsEmpty() {
+		delete(c.buckets, c.minFrequency)
+	}
+	entry := c.entries[key]
+	delete(c.entries, key)
+	
+	reason := EvictedCapacity
+	if c.expired(entry.expires) {
+		reason = EvictedExpired
+	}
+	c.evicted(key, entry.value, reason)
+}
+
+// lowestFrequency procura a menor frequência com balde não vazio
+func (c *LFUCache[K, V]) lowestFrequency() int {
+	lowest := 0
+	for frequency := range c.buckets {
+		if lowest == 0 || frequency < lowest {
+			lowest = frequency
+		}
+	}
+	return lowest
+}
+
+// unlink remove a entrada do map e seu nó do balde
+func (c *LFUCache[K, V]) unlink(key K, entry *lfuEntry[K, V], reason EvictionReason) {
+	c.detach(entry)
+	delete(c.entries, key)
+	c.evicted(key, entry.value, reason)
+}
+
+// ============================================================================
+// CAPACIDADE E MANUTENÇÃO
+// ============================================================================
+
+// Size retorna o número de entradas (as expiradas saem ao serem acessadas)
+// Complexidade: O(1)
+func (c *LFUCache[K, V]) Size() int {
+	return len(c.entries)
+}
+
+// Capacity retorna o número máximo de entradas
+func (c *LFUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize muda a capacidade, descartando os menos usados se sobrar entrada
+func (c *LFUCache[K, V]) Resize(capacity int) {
+	defer checkInvariants(c)
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	c.capacity = capacity
+	for len(c.entries) > c.capacity {
+		c.evictLeastFrequent()
+	}
+}
+
+// PurgeExpired remove todas as entradas cujo TTL venceu e retorna quantas
+// Complexidade: O(n)
+func (c *LFUCache[K, V]) PurgeExpired() int {
+	defer checkInvariants(c)
+	removed := 0
+	for _, key := range c.Keys() {
+		if entry := c.entries[key]; c.expired(entry.expires) {
+			c.unlink(key, entry, EvictedExpired)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Clear remove todas as entradas, chamando o callback para cada uma
+// Complexidade: O(n)
+func (c *LFUCache[K, V]) Clear() {
+	defer checkInvariants(c)
+	keys := c.Keys()
+	for i := len(keys) - 1; i >= 0; i-- {
+		c.unlink(keys[i], c.entries[keys[i]], EvictedRemoved)
+	}
+	c.minFrequency = 0
+}
+
+// ============================================================================
+// CONSULTA E ESTATÍSTICAS
+// ============================================================================
+
+// frequencies retorna as frequências em uso, da maior para a menor
+func (c *LFUCache[K, V]) frequencies() []int {
+	frequencies := make([]int, 0, len(c.buckets))
+	for frequency := range c.buckets {
+		frequencies = append(frequencies, frequency)
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(frequencies)))
+	return frequencies
+}
+
+// Keys retorna as chaves da mais usada à menos usada (próxima vítima por último)
+// Complexidade: O(n + f log f) para f frequências distintas
+func (c *LFUCache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(c.entries))
+	for _, frequency := range c.frequencies() {
+		keys = append(keys, c.buckets[frequency].ToSlice()...)
+	}
+	return keys
+}
+
+// All percorre as entradas na ordem de Keys sem alterar frequências
+func (c *LFUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, key := range c.Keys() {
+			if !yield(key, c.entries[key].value) {
+				return
+			}
+		}
+	}
+}
+
+// GetStatistics retorna tamanho, capacidade, acertos, falhas, descartes e
+// a quantidade de chaves em cada frequência
+// Complexidade: O(f)
+func (c *LFUCache[K, V]) GetStatistics() map[string]interface{} {
+	stats := c.statistics(len(c.entries))
+	stats["policy"] = "LFU"
+	histogram := make(map[int]int, len(c.buckets))
+	for frequency, bucket := range c.buckets {
+		histogram[frequency] = bucket.Size()
+	}
+	stats["frequencies"] = histogram
+	return stats
+}
+
+// ResetStatistics zera acertos, falhas e descartes (as frequências continuam)
+func (c *LFUCache[K, V]) ResetStatistics() {
+	c.resetStatistics()
+}
+
+// String retorna as entradas da mais usada à menos usada com a frequência
+func (c *LFUCache[K, V]) String() string {
+	var builder strings.Builder
+	builder.WriteString("LFU [")
+	for i, key := range c.Keys() {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		entry := c.entries[key]
+		builder.WriteString(fmt.Sprintf("%v:%v(×%d)", key, entry.value, entry.frequency))
+	}
+	builder.WriteString(fmt.Sprintf("] (%d/%d)", len(c.entries), c.capacity))
+	return builder.String()
+}
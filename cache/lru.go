@@ -0,0 +1,283 @@
+package cache
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+	"time"
+
+	"dca3503/instrument"
+	"dca3503/list"
+)
+
+// ============================================================================
+// LRUCACHE - DESCARTA O USADO HÁ MAIS TEMPO
+// ============================================================================
+
+// lruEntry é o valor guardado no map: o dado e o nó da chave na lista
+type lruEntry[K comparable, V any] struct {
+	value   V
+	node    *list.DoublyNode[K] // Nó da chave em order (remoção/movimento O(1))
+	expires time.Time           // Zero = não expira
+}
+
+// LRUCache implementa um cache Least Recently Used
+// Características:
+// - map de chave para entrada + DoublyLinkedList de chaves em ordem de uso
+// - o início da lista é o mais recente, o final é a próxima vítima
+// - Get e Put são O(1): o nó guardado na entrada evita percorrer a lista
+// - entradas com TTL expiram preguiçosamente (ao serem acessadas) ou em PurgeExpired
+type LRUCache[K comparable, V any] struct {
+	settings[K, V]
+	entries map[K]*lruEntry[K, V]
+	order   *list.DoublyLinkedList[K] // Mais recente → menos recente
+}
+
+// NewLRUCache cria um cache LRU com a capacidade informada
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		settings: newSettings[K, V](capacity),
+		entries:  make(map[K]*lruEntry[K, V]),
+		order:    list.NewDoublyLinkedList[K](),
+	}
+}
+
+// SetTTL define o tempo de vida padrão das entradas inseridas por Put (0 = não expiram)
+func (c *LRUCache[K, V]) SetTTL(ttl time.Duration) {
+	c.ttl = ttl
+}
+
+// SetOnEvict registra a função chamada quando uma entrada sai do cache
+func (c *LRUCache[K, V]) SetOnEvict(callback EvictionCallback[K, V]) {
+	c.onEvict = callback
+}
+
+// SetClock troca o relógio usado para o TTL (útil em testes)
+func (c *LRUCache[K, V]) SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	c.now = now
+}
+
+// SetCounters associa contadores de operações à lista interna (nil desliga a contagem)
+func (c *LRUCache[K, V]) SetCounters(counters *instrument.Counters) {
+	c.order.SetCounters(counters)
+}
+
+// ============================================================================
+// OPERAÇÕES PRINCIPAIS
+// ============================================================================
+
+// Get retorna o valor da chave e a marca como a mais recente
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	defer checkInvariants(c)
+	entry, ok := c.lookup(key)
+	if !ok {
+		c.misses++
+		var zero V
+		return zero, false
+	}
+	c.hits++
+	c.order.MoveToFront(entry.node)
+	return entry.value, true
+}
+
+// Peek retorna o valor sem alterar a ordem de uso nem as estatísticas
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	entry, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// Contains verifica se a chave está no cache (e não expirou)
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+	return ok
+}
+
+// Put insere ou atualiza a chave com o TTL padrão
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL insere ou atualiza a chave com um TTL específico (0 = não expira)
+// Complexidade: O(1)
+// Pseudocódigo:
+// 1. Chave existente: trocar valor e prazo e mover o nó para o início
+// 2. Cache cheio: remover o último nó da lista (menos recente) e sua entrada
+// 3. Inserir a chave no início da lista e guardar o nó na entrada
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	defer checkInvariants(c)
+	if entry, ok := c.entries[key]; ok {
+		entry.value = value
+		entry.expires = c.deadline(ttl)
+		c.order.MoveToFront(entry.node)
+		return
+	}
+	
+	if len(c.entries) >= c.capacity {
+		c.evictOldest()
+	}
+	c.entries[key] = &lruEntry[K, V]{
+		value:   value,
+		node:    c.order.AddFirstNode(key),
+		expires: c.deadline(ttl),
+	}
+}
+
+// Remove retira a chave do cache
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) Remove(key K) bool {
+	defer checkInvariants(c)
+	entry, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.unlink(key, entry, EvictedRemoved)
+	return true
+}
+
+// lookup encontra a entrada, descartando-a se o TTL venceu
+func (c *LRUCache[K, V]) lookup(key K) (*lruEntry[K, V], bool) {
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.expired(entry.expires) {
+		c.unlink(key, entry, EvictedExpired)
+		return nil, false
+	}
+	return entry, true
+}
+
+// evictOldest remove o menos recente (expirada, se o TTL já venceu)
+func (c *LRUCache[K, V]) evictOldest() {
+	key, err := c.order.RemoveLast()
+	if err != nil {
+		return
+	}
+	entry := c.entries[key]
+	delete(c.entries, key)
+	
+	reason := EvictedCapacity
+	if c.expired(entry.expires) {
+		reason = EvictedExpired
+	}
+	c.evicted(key, entry.value, reason)
+}
+
+// unlink remove a entrada do map e seu nó da lista
+func (c *LRUCache[K, V]) unlink(key K, entry *lruEntry[K, V], reason EvictionReason) {
+	c.order.RemoveNode(entry.node)
+	delete(c.entries, key)
+	c.evicted(key, entry.value, reason)
+}
+
+// ============================================================================
+// CAPACIDADE E MANUTENÇÃO
+// ============================================================================
+
+// Size retorna o número de entradas (as expiradas saem ao serem acessadas)
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) Size() int {
+	return len(c.entries)
+}
+
+// Capacity retorna o número máximo de entradas
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Resize muda a capacidade, descartando os menos recentes se sobrar entrada
+// Complexidade: O(k) para k descartes
+func (c *LRUCache[K, V]) Resize(capacity int) {
+	defer checkInvariants(c)
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	c.capacity = capacity
+	for len(c.entries) > c.capacity {
+		c.evictOldest()
+	}
+}
+
+// PurgeExpired remove todas as entradas cujo TTL venceu e retorna quantas
+// Complexidade: O(n)
+func (c *LRUCache[K, V]) PurgeExpired() int {
+	defer checkInvariants(c)
+	removed := 0
+	for _, key := range c.order.ToSlice() {
+		if entry := c.entries[key]; c.expired(entry.expires) {
+			c.unlink(key, entry, EvictedExpired)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Clear remove todas as entradas, chamando o callback para cada uma
+// Complexidade: O(n)
+func (c *LRUCache[K, V]) Clear() {
+	defer checkInvariants(c)
+	for _, key := range c.order.ToSliceReverse() {
+		c.unlink(key, c.entries[key], EvictedRemoved)
+	}
+}
+
+// ============================================================================
+// CONSULTA E ESTATÍSTICAS
+// ============================================================================
+
+// Keys retorna as chaves do mais recente ao menos recente (próxima vítima por último)
+// Complexidade: O(n)
+func (c *LRUCache[K, V]) Keys() []K {
+	return c.order.ToSlice()
+}
+
+// All percorre as entradas do mais recente ao menos recente sem alterar a ordem
+func (c *LRUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key := range c.order.Values() {
+			if !yield(key, c.entries[key].value) {
+				return
+			}
+		}
+	}
+}
+
+// GetStatistics retorna tamanho, capacidade, acertos, falhas e descartes
+// Complexidade: O(1)
+func (c *LRUCache[K, V]) GetStatistics() map[string]interface{} {
+	stats := c.statistics(len(c.entries))
+	stats["policy"] = "LRU"
+	return stats
+}
+
+// ResetStatistics zera acertos, falhas e descartes
+func (c *LRUCache[K, V]) ResetStatistics() {
+	c.resetStatistics()
+}
+
+// String retorna as entradas do mais recente ao menos recente
+func (c *LRUCache[K, V]) String() string {
+	var builder strings.Builder
+	builder.WriteString("LRU [")
+	first := true
+	for key, value := range c.All() {
+		if !first {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprintf("%v:%v", key, value))
+		first = false
+	}
+	builder.WriteString(fmt.Sprintf("] (%d/%d)", len(c.entries), c.capacity))
+	return builder.String()
+}
@@ -0,0 +1,84 @@
+package cache
+
+import "fmt"
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// Validate verifica as invariantes internas do LRUCache
+// - a lista de ordem é válida e tem exatamente as chaves do map, sem repetição
+// - o nó guardado em cada entrada contém a própria chave
+// - o número de entradas não passa da capacidade
+// Complexidade: O(n)
+func (c *LRUCache[K, V]) Validate() error {
+	if err := c.order.Validate(); err != nil {
+		return fmt.Errorf("LRUCache: %v", err)
+	}
+	if c.order.Size() != len(c.entries) {
+		return fmt.Errorf("LRUCache: lista com %d chaves, map com %d", c.order.Size(), len(c.entries))
+	}
+	if len(c.entries) > c.capacity {
+		return fmt.Errorf("LRUCache: %d entradas acima da capacidade %d", len(c.entries), c.capacity)
+	}
+	seen := make(map[K]bool, len(c.entries))
+	for key := range c.order.Values() {
+		entry, ok := c.entries[key]
+		if !ok || seen[key] {
+			return fmt.Errorf("LRUCache: chave %v ausente do map ou repetida na lista", key)
+		}
+		if entry.node.Value() != key {
+			return fmt.Errorf("LRUCache: entrada de %v aponta para o nó de %v", key, entry.node.Value())
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas do LFUCache
+// - cada balde é uma lista válida, não vazia, com chaves daquela frequência
+// - a soma dos baldes é o número de entradas e nenhuma chave se repete
+// - nenhum balde tem frequência menor que minFrequency
+// Complexidade: O(n)
+func (c *LFUCache[K, V]) Validate() error {
+	if len(c.entries) > c.capacity {
+		return fmt.Errorf("LFUCache: %d entradas acima da capacidade %d", len(c.entries), c.capacity)
+	}
+	seen := make(map[K]bool, len(c.entries))
+	for frequency, bucket := range c.buckets {
+		if err := bucket.Validate(); err != nil {
+			return fmt.Errorf("LFUCache: balde %d: %v", frequency, err)
+		}
+		if bucket.IsEmpty() {
+			return fmt.Errorf("LFUCache: balde %d vazio não foi removido", frequency)
+		}
+		if frequency < c.minFrequency {
+			return fmt.Errorf("LFUCache: balde %d abaixo de minFrequency %d", frequency, c.minFrequency)
+		}
+		for key := range bucket.Values() {
+			entry, ok := c.entries[key]
+			if !ok || seen[key] {
+				return fmt.Errorf("LFUCache: chave %v ausente do map ou repetida nos baldes", key)
+			}
+			if entry.frequency != frequency || entry.node.Value() != key {
+				return fmt.Errorf("LFUCache: chave %v com frequência %d está no balde %d", key, entry.frequency, frequency)
+			}
+			seen[key] = true
+		}
+	}
+	if len(seen) != len(c.entries) {
+		return fmt.Errorf("LFUCache: baldes com %d chaves, map com %d", len(seen), len(c.entries))
+	}
+	return nil
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug)
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
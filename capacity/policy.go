@@ -0,0 +1,288 @@
+// Package capacity define como as estruturas baseadas em array (ArrayList,
+// ArrayStack, ArrayQueue e ArrayDeque) mudam de capacidade.
+//
+// Uma Policy reúne três decisões:
+//   - crescimento: para quanto ir quando o array enche (GrowthPolicy)
+//   - redução: quando devolver memória, com histerese para não oscilar
+//   - limite: a capacidade máxima e o que fazer com uma inserção além dela
+//
+// O valor zero de Policy é o comportamento clássico: dobra ao encher, nunca
+// reduz sozinha e não tem limite. Cada estrutura recebe a sua com
+// SetGrowthPolicy:
+//
+//	q := queue.NewArrayQueue(16)
+//	q.SetGrowthPolicy(capacity.Policy{
+//		Growth:      capacity.Geometric{Factor: 1.5},
+//		ShrinkAt:    0.25,
+//		MaxCapacity: 1024,
+//		Overflow:    capacity.OverflowOverwrite,
+//	})
+package capacity
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// POLÍTICAS DE CRESCIMENTO
+// ============================================================================
+
+// GrowthPolicy escolhe a próxima capacidade quando o array está cheio
+type GrowthPolicy interface {
+	Next(current int) int // Próxima capacidade a partir da atual (que pode ser 0)
+	String() string       // Notação curta, ex.: "×2"
+}
+
+// Geometric multiplica a capacidade por Factor (> 1) a cada crescimento
+// Com fator constante a inserção custa O(1) amortizado: as cópias somam uma
+// série geométrica. Fatores menores desperdiçam menos memória e copiam mais
+// vezes (1.5 é comum; 2 é o padrão)
+type Geometric struct {
+	Factor float64
+}
+
+// Next arredonda para cima e cresce pelo menos uma posição
+func (g Geometric) Next(current int) int {
+	return max(int(math.Ceil(float64(current)*g.Factor)), current+1)
+}
+
+// String retorna o fator, ex.: "×1.5"
+func (g Geometric) String() string { return fmt.Sprintf("×%g", g.Factor) }
+
+// Increment soma Step posições a cada crescimento
+// Desperdiça no máximo Step posições, mas n inserções copiam O(n²/Step)
+// elementos: cada inserção passa a custar O(n/Step) amortizado
+type Increment struct {
+	Step int
+}
+
+// Next soma Step à capacidade atual
+func (i Increment) Next(current int) int { return current + i.Step }
+
+// String retorna o incremento, ex.: "+64"
+func (i Increment) String() string { return fmt.Sprintf("+%d", i.Step) }
+
+// GrowthFunc adapta uma função comum como GrowthPolicy
+// Resultados que não crescem são tratados como current+1
+type GrowthFunc func(current int) int
+
+// Next chama a função
+func (f GrowthFunc) Next(current int) int { return f(current) }
+
+// String identifica a política como personalizada
+func (f GrowthFunc) String() string { return "personalizada" }
+
+// Doubling é o crescimento padrão: dobra a capacidade
+var Doubling = Geometric{Factor: 2}
+
+// ============================================================================
+// TRANSBORDAMENTO
+// ============================================================================
+
+// Overflow define o que acontece com uma inserção quando a estrutura já tem
+// MaxCapacity elementos
+type Overflow int
+
+const (
+	OverflowError     Overflow = iota // Recusa: as variantes Try* retornam errs.ErrFull
+	OverflowBlock                     // Espera outra goroutine remover um elemento
+	OverflowOverwrite                 // Descarta o elemento mais antigo para abrir espaço
+)
+
+// String retorna o nome do comportamento
+func (o Overflow) String() string {
+	switch o {
+	case OverflowBlock:
+		return "bloqueia"
+	case OverflowOverwrite:
+		return "sobrescreve"
+	default:
+		return "erro"
+	}
+}
+
+// ============================================================================
+// POLÍTICA COMPLETA
+// ============================================================================
+
+// Policy descreve crescimento, redução e limite de uma estrutura com array
+// Todos os campos são opcionais; o valor zero dobra e nunca reduz
+type Policy struct {
+	Growth      GrowthPolicy // Crescimento ao encher (padrão Doubling)
+	ShrinkAt    float64      // Ocupação em (0, 1) que dispara a redução (0 = não reduz sozinha)
+	ShrinkTo    float64      // Fração da capacidade mantida ao reduzir (padrão 0.5)
+	MinCapacity int          // A redução não desce abaixo disto (padrão 1)
+	MaxCapacity int          // Limite de elementos (0 = sem limite)
+	Overflow    Overflow     // Inserção com MaxCapacity atingida (padrão OverflowError)
+}
+
+// Shrinking dobra ao encher e reduz à metade com 1/4 de ocupação
+// É a política padrão de ArrayStack e ArrayQueue
+var Shrinking = Policy{ShrinkAt: 0.25, ShrinkTo: 0.5}
+
+// DefaultShrinkTo é a fração mantida quando ShrinkAt é usado sem ShrinkTo
+const DefaultShrinkTo = 0.5
+
+// Validate confere os parâmetros da política e se size elementos cabem nela
+// Para haver histerese a redução exige ShrinkAt < ShrinkTo < 1, e com
+// crescimento geométrico também ShrinkAt < 1/Factor: logo depois de crescer
+// a ocupação é 1/Factor e não pode disparar uma redução
+func (p Policy) Validate(size int) error {
+	switch growth := p.growth().(type) {
+	case Geometric:
+		if !(growth.Factor > 1) || math.IsInf(growth.Factor, 0) {
+			return errs.New(nil, "fator geométrico deve ser maior que 1, recebido %g", "geometric factor must be greater than 1, got %g", growth.Factor)
+		}
+		if p.ShrinkAt*growth.Factor >= 1 {
+			return errs.New(nil, "redução com %g de ocupação oscilaria com crescimento %s", "shrinking at %g occupancy would oscillate with growth %s", p.ShrinkAt, growth)
+		}
+	case Increment:
+		if growth.Step <= 0 {
+			return errs.New(nil, "incremento deve ser positivo, recebido %d", "increment must be positive, got %d", growth.Step)
+		}
+	case GrowthFunc:
+		if growth == nil {
+			return errs.New(nil, "função de crescimento nil", "nil growth function")
+		}
+	}
+	if p.ShrinkAt < 0 || p.ShrinkAt >= p.shrinkTo() || p.shrinkTo() >= 1 {
+		return errs.New(nil, "redução inválida: exige 0 <= ShrinkAt < ShrinkTo < 1, recebido %g e %g",
+			"invalid shrinking: requires 0 <= ShrinkAt < ShrinkTo < 1, got %g and %g", p.ShrinkAt, p.shrinkTo())
+	}
+	if p.MinCapacity < 0 || p.MaxCapacity < 0 || (p.MaxCapacity > 0 && p.MinCapacity > p.MaxCapacity) {
+		return errs.New(nil, "capacidades inválidas: mínima %d, máxima %d", "invalid capacities: minimum %d, maximum %d", p.MinCapacity, p.MaxCapacity)
+	}
+	if p.Overflow < OverflowError || p.Overflow > OverflowOverwrite {
+		return errs.New(nil, "comportamento de transbordamento inválido: %d", "invalid overflow behavior: %d", p.Overflow)
+	}
+	if p.MaxCapacity > 0 && size > p.MaxCapacity {
+		return errs.New(errs.ErrFull, "%d elementos não cabem na capacidade máxima %d",
+			"%d elements do not fit in maximum capacity %d", size, p.MaxCapacity)
+	}
+	return nil
+}
+
+// growth retorna Growth com o padrão aplicado
+func (p Policy) growth() GrowthPolicy {
+	if p.Growth == nil {
+		return Doubling
+	}
+	return p.Growth
+}
+
+// shrinkTo retorna ShrinkTo com o padrão aplicado
+func (p Policy) shrinkTo() float64 {
+	if p.ShrinkTo == 0 {
+		return DefaultShrinkTo
+	}
+	return p.ShrinkTo
+}
+
+// Full informa se size elementos já atingem MaxCapacity
+func (p Policy) Full(size int) bool {
+	return p.MaxCapacity > 0 && size >= p.MaxCapacity
+}
+
+// Clamp limita uma capacidade pedida (EnsureCapacity) a MaxCapacity
+func (p Policy) Clamp(capacity int) int {
+	if p.MaxCapacity > 0 {
+		return min(capacity, p.MaxCapacity)
+	}
+	return capacity
+}
+
+// Grow retorna a capacidade para guardar pelo menos needed elementos a
+// partir de current, aplicando Growth quantas vezes for preciso, sem passar
+// de MaxCapacity
+// Complexidade: O(1) por aplicação de Growth
+func (p Policy) Grow(current, needed int) int {
+	growth := p.growth()
+	next := current
+	for next < needed {
+		next = max(growth.Next(next), next+1) // Função personalizada que não cresce
+	}
+	return p.Clamp(next)
+}
+
+// Shrink retorna a capacidade depois de uma remoção que deixou size
+// elementos, ou current se ainda não é hora de reduzir
+// Reduz quando a ocupação cai para ShrinkAt ou menos e mantém a fração
+// ShrinkTo da capacidade. Com ShrinkAt < ShrinkTo há histerese: logo depois
+// da redução a ocupação é ShrinkAt/ShrinkTo (1/2 com 1/4 e 1/2), longe de
+// crescer ou reduzir de novo, e o custo continua O(1) amortizado
+// Uma estrutura vazia não é reduzida: Clear e TrimToSize cuidam desse caso
+func (p Policy) Shrink(current, size int) int {
+	if p.ShrinkAt == 0 || size == 0 || float64(size) > p.ShrinkAt*float64(current) {
+		return current
+	}
+	next := max(int(float64(current)*p.shrinkTo()), size, p.MinCapacity, 1)
+	return min(next, current)
+}
+
+// String resume a política, ex.: "×2, reduz com 25% para 50%, máximo 100 (sobrescreve)"
+func (p Policy) String() string {
+	result := p.growth().String()
+	if p.ShrinkAt > 0 {
+		result += fmt.Sprintf(", reduz com %g%% para %g%%", p.ShrinkAt*100, p.shrinkTo()*100)
+	}
+	if p.MaxCapacity > 0 {
+		result += fmt.Sprintf(", máximo %d (%s)", p.MaxCapacity, p.Overflow)
+	}
+	return result
+}
+
+// ============================================================================
+// SINCRONIZAÇÃO DO OVERFLOWBLOCK
+// ============================================================================
+
+// Gate faz uma inserção esperar por espaço no modo OverflowBlock
+// As estruturas protegem inserções e remoções com Lock/Unlock, esperam com
+// Wait enquanto estão cheias e chamam Signal depois de cada remoção
+// Como os *instrument.Counters, um *Gate nil não faz nada: fora do modo
+// OverflowBlock cada ponto de sincronização custa uma verificação de nil
+type Gate struct {
+	mu    sync.Mutex
+	space sync.Cond
+}
+
+// NewGate retorna um Gate se a política bloqueia, ou nil
+func (p Policy) NewGate() *Gate {
+	if p.Overflow != OverflowBlock || p.MaxCapacity == 0 {
+		return nil
+	}
+	gate := &Gate{}
+	gate.space.L = &gate.mu
+	return gate
+}
+
+// Lock entra na seção protegida
+func (g *Gate) Lock() {
+	if g != nil {
+		g.mu.Lock()
+	}
+}
+
+// Unlock sai da seção protegida
+func (g *Gate) Unlock() {
+	if g != nil {
+		g.mu.Unlock()
+	}
+}
+
+// Wait solta o lock até a próxima remoção e o retoma; exige Lock
+func (g *Gate) Wait() {
+	if g != nil {
+		g.space.Wait()
+	}
+}
+
+// Signal acorda as inserções que esperam por espaço
+func (g *Gate) Signal() {
+	if g != nil {
+		g.space.Broadcast()
+	}
+}
@@ -0,0 +1,301 @@
+package capacity_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+	
+	"dca3503/capacity"
+	"dca3503/deque"
+	"dca3503/errs"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+func TestGrow(t *testing.T) {
+	never := capacity.GrowthFunc(func(current int) int { return current })
+	tests := []struct {
+		policy                    capacity.Policy
+		current, needed, expected int
+	}{
+		{capacity.Policy{}, 0, 1, 1},
+		{capacity.Policy{}, 8, 9, 16},
+		{capacity.Policy{}, 8, 40, 64},
+		{capacity.Policy{Growth: capacity.Geometric{Factor: 1.5}}, 10, 11, 15},
+		{capacity.Policy{Growth: capacity.Geometric{Factor: 1.5}}, 1, 2, 2},
+		{capacity.Policy{Growth: capacity.Increment{Step: 3}}, 4, 12, 13},
+		{capacity.Policy{Growth: never}, 5, 7, 7},
+		{capacity.Policy{MaxCapacity: 12}, 8, 9, 12},
+	}
+	for _, test := range tests {
+		if got := test.policy.Grow(test.current, test.needed); got != test.expected {
+			t.Errorf("%s: Grow(%d, %d) = %d, esperado %d", test.policy, test.current, test.needed, got, test.expected)
+		}
+	}
+}
+
+func TestShrinkHysteresis(t *testing.T) {
+	policy := capacity.Policy{ShrinkAt: 0.25, MinCapacity: 8}
+	tests := []struct{ current, size, expected int }{
+		{100, 26, 100}, // Acima de 1/4: mantém
+		{100, 25, 50},  // 1/4: reduz à metade
+		{50, 25, 50},   // Logo depois de reduzir a ocupação é 1/2
+		{50, 12, 25},
+		{20, 1, 10},
+		{10, 1, 8},     // MinCapacity
+		{100, 0, 100},  // Vazia não reduz
+	}
+	for _, test := range tests {
+		if got := policy.Shrink(test.current, test.size); got != test.expected {
+			t.Errorf("Shrink(%d, %d) = %d, esperado %d", test.current, test.size, got, test.expected)
+		}
+	}
+	if got := (capacity.Policy{}).Shrink(100, 1); got != 100 {
+		t.Errorf("política sem ShrinkAt reduziu para %d", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []capacity.Policy{
+		{Growth: capacity.Geometric{Factor: 1}},
+		{Growth: capacity.Increment{Step: 0}},
+		{Growth: capacity.GrowthFunc(nil)},
+		{ShrinkAt: 0.5},                 // Igual a ShrinkTo: sem histerese
+		{ShrinkAt: 0.5, ShrinkTo: 0.75}, // Oscila ao dobrar: 1/2 logo depois de crescer
+		{ShrinkAt: -0.1},
+		{MinCapacity: 10, MaxCapacity: 5},
+		{Overflow: 7},
+	}
+	for _, policy := range invalid {
+		if err := policy.Validate(0); err == nil {
+			t.Errorf("%+v deveria ser rejeitada", policy)
+		}
+	}
+	if err := capacity.Shrinking.Validate(1000); err != nil {
+		t.Errorf("Shrinking: %v", err)
+	}
+	if err := (capacity.Policy{MaxCapacity: 3}).Validate(4); !errors.Is(err, errs.ErrFull) {
+		t.Errorf("4 elementos com máximo 3: %v", err)
+	}
+}
+
+func TestValidateMessagesFollowLanguage(t *testing.T) {
+	err := capacity.Policy{Growth: capacity.Increment{Step: -2}}.Validate(0)
+	if err == nil || err.Error() != "incremento deve ser positivo, recebido -2" {
+		t.Errorf("português: %v", err)
+	}
+	
+	previous := errs.CurrentLanguage()
+	errs.SetLanguage(errs.English)
+	defer errs.SetLanguage(previous)
+	cases := []struct {
+		policy capacity.Policy
+		want   string
+	}{
+		{capacity.Policy{Growth: capacity.Geometric{Factor: 1}}, "geometric factor must be greater than 1, got 1"},
+		{capacity.Policy{ShrinkAt: 0.5, ShrinkTo: 0.75}, "shrinking at 0.5 occupancy would oscillate with growth ×2"},
+		{capacity.Policy{Growth: capacity.GrowthFunc(nil)}, "nil growth function"},
+		{capacity.Policy{ShrinkAt: -0.1}, "invalid shrinking: requires 0 <= ShrinkAt < ShrinkTo < 1, got -0.1 and 0.5"},
+		{capacity.Policy{MinCapacity: 10, MaxCapacity: 5}, "invalid capacities: minimum 10, maximum 5"},
+		{capacity.Policy{Overflow: 7}, "invalid overflow behavior: 7"},
+	}
+	for _, tc := range cases {
+		if err := tc.policy.Validate(0); err == nil || err.Error() != tc.want {
+			t.Errorf("%+v: %v, esperado %q", tc.policy, err, tc.want)
+		}
+	}
+	if err.Error() != "increment must be positive, got -2" {
+		t.Errorf("erro criado em português: %v", err)
+	}
+}
+
+func TestOverflowError(t *testing.T) {
+	s := stack.NewArrayStack(10)
+	if err := s.SetGrowthPolicy(capacity.Policy{MaxCapacity: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if s.Capacity() != 3 {
+		t.Errorf("capacidade %d acima do máximo", s.Capacity())
+	}
+	for i := 1; i <= 4; i++ {
+		s.Push(i) // O quarto é descartado
+	}
+	if err := s.TryPush(5); !errors.Is(err, errs.ErrFull) || !s.IsFull() {
+		t.Errorf("TryPush na pilha cheia: %v", err)
+	}
+	if got := s.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("pilha %v", got)
+	}
+	
+	// Os elementos atuais precisam caber no novo máximo
+	if err := s.SetGrowthPolicy(capacity.Policy{MaxCapacity: 2}); !errors.Is(err, errs.ErrFull) {
+		t.Errorf("máximo menor que o tamanho: %v", err)
+	}
+	decoded := stack.NewArrayStack(1)
+	decoded.SetGrowthPolicy(capacity.Policy{MaxCapacity: 2})
+	if err := json.Unmarshal([]byte("[1, 2, 3]"), decoded); !errors.Is(err, errs.ErrFull) {
+		t.Errorf("Unmarshal além do máximo: %v", err)
+	}
+}
+
+// Push, Enqueue e Add não retornam erro: com OverflowError o elemento é
+// descartado e o primeiro erro fica em Err
+func TestOverflowErrorKeepsFirstError(t *testing.T) {
+	s := stack.NewArrayStack(1)
+	q := queue.NewArrayQueue(1)
+	d := deque.NewArrayDeque(1)
+	l := list.NewArrayList[int](1)
+	structures := []struct {
+		name   string
+		insert func(int)
+		policy func(capacity.Policy) error
+		err    func() error
+		slice  func() []int
+	}{
+		{"ArrayStack", s.Push, s.SetGrowthPolicy, s.Err, s.ToSlice},
+		{"ArrayQueue", q.Enqueue, q.SetGrowthPolicy, q.Err, q.ToSlice},
+		{"ArrayDeque.EnqueueRear", d.EnqueueRear, d.SetGrowthPolicy, d.Err, d.ToSlice},
+		{"ArrayList", l.Add, l.SetGrowthPolicy, l.Err, l.ToSlice},
+	}
+	for _, c := range structures {
+		if err := c.policy(capacity.Policy{MaxCapacity: 2}); err != nil {
+			t.Fatal(err)
+		}
+		c.insert(1)
+		c.insert(2)
+		if err := c.err(); err != nil {
+			t.Errorf("%s: Err antes de encher = %v", c.name, err)
+		}
+		c.insert(3)
+		first := c.err()
+		if !errors.Is(first, errs.ErrFull) || len(c.slice()) != 2 {
+			t.Errorf("%s: Err = %v com %v", c.name, first, c.slice())
+		}
+		
+		// Erros seguintes não substituem o primeiro
+		c.policy(capacity.Policy{MaxCapacity: 3})
+		c.insert(4)
+		c.insert(5)
+		if err := c.err(); err != first || len(c.slice()) != 3 {
+			t.Errorf("%s: Err = %v, esperado o primeiro erro %v", c.name, err, first)
+		}
+	}
+	
+	// No deque as duas pontas guardam o erro
+	front := deque.NewArrayDeque(1)
+	front.SetGrowthPolicy(capacity.Policy{MaxCapacity: 1})
+	front.EnqueueFront(1)
+	front.EnqueueFront(2)
+	if err := front.Err(); !errors.Is(err, errs.ErrFull) || !slices.Equal(front.ToSlice(), []int{1}) {
+		t.Errorf("ArrayDeque.EnqueueFront: Err = %v com %v", err, front.ToSlice())
+	}
+}
+
+func TestOverflowOverwrite(t *testing.T) {
+	policy := capacity.Policy{MaxCapacity: 3, Overflow: capacity.OverflowOverwrite}
+	
+	q := queue.NewArrayQueue(1)
+	s := stack.NewArrayStack(1)
+	d := deque.NewArrayDeque(1)
+	l := list.NewArrayList[int](1)
+	for _, err := range []error{q.SetGrowthPolicy(policy), s.SetGrowthPolicy(policy), d.SetGrowthPolicy(policy), l.SetGrowthPolicy(policy)} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(i)
+		s.Push(i)
+		d.EnqueueRear(i)
+		l.Add(i)
+	}
+	if got := q.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("ArrayQueue %v", got)
+	}
+	if got := s.ToSlice(); !slices.Equal(got, []int{5, 4, 3}) {
+		t.Errorf("ArrayStack %v", got)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("ArrayList %v", got)
+	}
+	
+	// No deque a inserção no início descarta o final
+	d.EnqueueFront(0)
+	if got := d.ToSlice(); !slices.Equal(got, []int{0, 3, 4}) {
+		t.Errorf("ArrayDeque %v", got)
+	}
+	// Na lista o índice se refere à lista antes de descartar o índice 0
+	if err := l.AddOnIndex(9, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := l.ToSlice(); !slices.Equal(got, []int{4, 9, 5}) {
+		t.Errorf("ArrayList depois de AddOnIndex %v", got)
+	}
+	if err := q.TryEnqueue(6); err != nil || q.Capacity() != 3 {
+		t.Errorf("TryEnqueue sobrescrevendo: %v, capacidade %d", err, q.Capacity())
+	}
+}
+
+func TestOverflowBlock(t *testing.T) {
+	q := queue.NewArrayQueue(2)
+	if err := q.SetGrowthPolicy(capacity.Policy{MaxCapacity: 2, Overflow: capacity.OverflowBlock}); err != nil {
+		t.Fatal(err)
+	}
+	q.Enqueue(1)
+	q.Enqueue(2)
+	if err := q.TryEnqueue(3); !errors.Is(err, errs.ErrFull) {
+		t.Errorf("TryEnqueue não deveria esperar: %v", err)
+	}
+	
+	done := make(chan struct{})
+	go func() {
+		q.Enqueue(3) // Espera o Dequeue abaixo
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("Enqueue não esperou a fila abrir espaço")
+	case <-time.After(20 * time.Millisecond):
+	}
+	if value, err := q.Dequeue(); value != 1 || err != nil {
+		t.Fatalf("Dequeue = (%d, %v)", value, err)
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Enqueue continuou bloqueado depois do Dequeue")
+	}
+	if got := q.ToSlice(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("fila %v", got)
+	}
+}
+
+func TestResizeStatistics(t *testing.T) {
+	s := stack.NewArrayStack(10) // capacity.Shrinking
+	for i := range 100 {
+		s.Push(i)
+	}
+	for range 99 {
+		s.Pop()
+	}
+	stats := s.GetStatistics()
+	if stats["grows"] != 4 || stats["shrinks"] != 6 || s.Capacity() != 2 {
+		t.Errorf("10 → 160 → 2: %v, capacidade %d", stats, s.Capacity())
+	}
+	
+	l := list.NewArrayList[int](0)
+	l.SetGrowthPolicy(capacity.Policy{Growth: capacity.Increment{Step: 10}})
+	l.AddAll(make([]int, 95))
+	if stats := l.GetStatistics(); stats["grows"] != 1 || stats["capacity"] != 100 {
+		t.Errorf("AddAll com incremento 10: %v", stats)
+	}
+	for range 50 {
+		l.Add(0)
+	}
+	if stats := l.GetStatistics(); stats["grows"] != 6 || stats["shrinks"] != 0 {
+		t.Errorf("mais 50 elementos: %v", stats)
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"dca3503/instrument"
+	"dca3503/list"
+	"dca3503/tree"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - ÁRVORES BALANCEADAS
+// ============================================================================
+
+func main() {
+	fmt.Println("=== ÁRVORES DE BUSCA BALANCEADAS: AVL E RUBRO-NEGRA ===")
+	fmt.Println()
+	
+	demonstrateShapes()
+	demonstrateOrderedQueries()
+	compareWithSortedSlice()
+}
+
+// ============================================================================
+// FORMA DAS ÁRVORES
+// ============================================================================
+
+func demonstrateShapes() {
+	fmt.Println("=== INSERINDO 1..10 EM ORDEM CRESCENTE ===")
+	
+	avl := tree.NewAVLTree[int, string]()
+	redBlack := tree.NewRedBlackTree[int, string]()
+	for i := 1; i <= 10; i++ {
+		avl.Insert(i, fmt.Sprint(i))
+		redBlack.Insert(i, fmt.Sprint(i))
+	}
+	fmt.Println("AVL (fator de balanceamento entre parênteses):")
+	fmt.Print(avl.Layout())
+	fmt.Println("Rubro-negra ([k] = link vermelho):")
+	fmt.Print(redBlack.Layout())
+	fmt.Printf("rotações: AVL %v, rubro-negra %v\n",
+		avl.GetStatistics()["rotations"], redBlack.GetStatistics()["rotations"])
+	fmt.Println()
+}
+
+// ============================================================================
+// CONSULTAS ORDENADAS
+// ============================================================================
+
+func demonstrateOrderedQueries() {
+	fmt.Println("=== CONSULTAS ORDENADAS: NOTAS DA TURMA ===")
+	
+	grades := tree.NewRedBlackTree[float64, string]()
+	for name, grade := range map[string]float64{
+		"Ana": 8.5, "Bruno": 6.0, "Carla": 9.7, "Diego": 4.5, "Elisa": 7.2, "Fábio": 5.9,
+	} {
+		grades.Insert(grade, name)
+	}
+	
+	if grade, name, ok := grades.Floor(7.0); ok {
+		fmt.Printf("Maior nota até 7.0 (Floor):     %.1f (%s)\n", grade, name)
+	}
+	if grade, name, ok := grades.Ceiling(6.0); ok {
+		fmt.Printf("Menor nota desde 6.0 (Ceiling): %.1f (%s)\n", grade, name)
+	}
+	fmt.Printf("Alunos abaixo de 6.0 (Rank):    %d\n", grades.Rank(6.0))
+	if grade, name, err := grades.Select(grades.Size() / 2); err == nil {
+		fmt.Printf("Mediana (Select(n/2)):          %.1f (%s)\n", grade, name)
+	}
+	fmt.Print("Notas entre 6.0 e 9.0 (Range):  ")
+	for grade, name := range grades.Range(6.0, 9.0) {
+		fmt.Printf("%s=%.1f ", name, grade)
+	}
+	fmt.Println()
+	
+	// O percurso em ordem alimenta qualquer list.List
+	sorted := list.NewArrayList[float64](grades.Size())
+	grades.InOrder(sorted)
+	fmt.Println("InOrder em ArrayList:          ", sorted)
+	fmt.Println()
+}
+
+// ============================================================================
+// COMPARAÇÃO COM FATIA ORDENADA
+// ============================================================================
+
+// sortedInsert insere na fatia ordenada: busca binária + deslocamento
+// Retorna a fatia e quantos elementos foram deslocados
+func sortedInsert(keys []int, key int) ([]int, int) {
+	i := sort.SearchInts(keys, key)
+	keys = append(keys, 0)
+	copy(keys[i+1:], keys[i:])
+	keys[i] = key
+	return keys, len(keys) - 1 - i
+}
+
+func compareWithSortedSlice() {
+	fmt.Println("=== INSERIR n CHAVES ALEATÓRIAS: FATIA ORDENADA × ÁRVORES ===")
+	fmt.Printf("%8s %18s %18s %18s\n", "n", "fatia: movimentos", "AVL: comparações", "LLRB: comparações")
+	
+	rng := rand.New(rand.NewPCG(3, 4))
+	for _, n := range []int{1000, 10000, 100000} {
+		var keys []int
+		moved := 0
+		var avlCounters, redBlackCounters instrument.Counters
+		avl := tree.NewAVLTree[int, struct{}]()
+		avl.SetCounters(&avlCounters)
+		redBlack := tree.NewRedBlackTree[int, struct{}]()
+		redBlack.SetCounters(&redBlackCounters)
+		
+		for i := 0; i < n; i++ {
+			key := rng.Int()
+			var shifted int
+			keys, shifted = sortedInsert(keys, key)
+			moved += shifted
+			avl.Insert(key, struct{}{})
+			redBlack.Insert(key, struct{}{})
+		}
+		fmt.Printf("%8d %18d %18d %18d\n", n, moved, avlCounters.Comparisons, redBlackCounters.Comparisons)
+	}
+	fmt.Println("A fatia desloca O(n) elementos por inserção (O(n²) no total);")
+	fmt.Println("as árvores fazem O(log n) comparações por inserção (O(n log n)).")
+}
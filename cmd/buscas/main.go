@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+
+	"dca3503/buscas"
+	"dca3503/deque"
+	"dca3503/instrument"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+func main() {
+	// Criando uma lista ordenada de exemplo
+	lista := []int{10, 20, 30, 40, 50}
+	
+	// Elemento a ser buscado
+	elemento := 30
+	
+	fmt.Println("=== DEMONSTRAÇÃO DE ALGORITMOS DE BUSCA ===")
+	
+	// Utilizando a função de busca sequencial do pacote buscas
+	fmt.Println("\nBusca Sequencial:")
+	indiceSeq := buscas.BuscaSequencial(lista, elemento)
+	
+	// Exibindo o resultado da busca sequencial
+	if indiceSeq != -1 {
+		fmt.Printf("Elemento %d encontrado na posição %d\n", elemento, indiceSeq)
+	} else {
+		fmt.Printf("Elemento %d não encontrado na lista\n", elemento)
+	}
+	
+	// Utilizando a função de busca binária do pacote buscas
+	fmt.Println("\nBusca Binária:")
+	indiceBin := buscas.BuscaBinaria(lista, elemento)
+	
+	// Exibindo o resultado da busca binária
+	if indiceBin != -1 {
+		fmt.Printf("Elemento %d encontrado na posição %d\n", elemento, indiceBin)
+	} else {
+		fmt.Printf("Elemento %d não encontrado na lista\n", elemento)
+	}
+	
+	// Demonstrando busca de elemento inexistente
+	elementoInexistente := 35
+	fmt.Printf("\nBuscando elemento inexistente %d:\n", elementoInexistente)
+	
+	indiceSeq = buscas.BuscaSequencial(lista, elementoInexistente)
+	indiceBin = buscas.BuscaBinaria(lista, elementoInexistente)
+	
+	fmt.Printf("Busca Sequencial: %d\n", indiceSeq)
+	fmt.Printf("Busca Binária: %d\n", indiceBin)
+	
+	demonstrarLimites()
+	compararAlgoritmos()
+	demonstrarEstruturas()
+	compararAutoOrganizacao()
+}
+
+// ============================================================================
+// LIMITES E REPETIDOS
+// ============================================================================
+
+func demonstrarLimites() {
+	notas := []int{5, 6, 6, 6, 7, 9, 10}
+	fmt.Println("\n=== LIMITES EM LISTA COM REPETIDOS ===")
+	fmt.Println("Lista:", notas)
+	inicio, fim := buscas.EqualRange(notas, 6)
+	fmt.Printf("LowerBound(6) = %d, UpperBound(6) = %d: %d ocorrências\n", inicio, fim, fim-inicio)
+	fmt.Printf("LowerBound(8) = %d: posição em que o 8 entraria\n", buscas.LowerBound(notas, 8))
+	
+	// SearchFunc não precisa de lista: menor número de caixas de 12 para 100 itens
+	caixas := buscas.SearchFunc(100, func(n int) bool { return 12*n >= 100 })
+	fmt.Printf("SearchFunc: %d caixas de 12 comportam 100 itens\n", caixas)
+	
+	rotacionada := []int{40, 50, 60, 10, 20, 30}
+	fmt.Printf("Rotacionada %v: 20 no índice %d, rotação em %d\n",
+		rotacionada, buscas.BuscaRotacionada(rotacionada, 20), buscas.PontoDeRotacao(rotacionada))
+}
+
+// ============================================================================
+// COMPARAÇÃO LADO A LADO
+// ============================================================================
+
+func compararAlgoritmos() {
+	const n = 1 << 20
+	const consultas = 1000
+	
+	rng := rand.New(rand.NewPCG(1, 2))
+	uniforme := make([]int, n)
+	for i := range uniforme {
+		uniforme[i] = rng.IntN(100 * n)
+	}
+	sort.Ints(uniforme)
+	// Valores quadráticos: distribuição bem desigual, ruim para a interpolação
+	quadratica := make([]int, n)
+	for i := range quadratica {
+		quadratica[i] = i * i
+	}
+	
+	algoritmos := []struct {
+		nome  string
+		busca func([]int, int, ...*instrument.Counters) int
+	}{
+		{"binária", buscas.BuscaBinariaGenerica[int]},
+		{"interpolação", buscas.BuscaInterpolacao[int]},
+		{"exponencial", buscas.BuscaExponencial[int]},
+		{"saltos", buscas.BuscaSaltos[int]},
+		{"ternária", buscas.BuscaTernaria[int]},
+		{"fibonacci", buscas.BuscaFibonacci[int]},
+	}
+	
+	fmt.Printf("\n=== COMPARAÇÕES MÉDIAS POR BUSCA (n = %d, %d consultas) ===\n", n, consultas)
+	fmt.Printf("%-14s %10s %12s %14s\n", "algoritmo", "uniforme", "quadrática", "10 primeiros")
+	for _, algoritmo := range algoritmos {
+		media := func(lista []int, posicoes func() int) float64 {
+			var contadores instrument.Counters
+			for i := 0; i < consultas; i++ {
+				algoritmo.busca(lista, lista[posicoes()], &contadores)
+			}
+			return float64(contadores.Comparisons) / consultas
+		}
+		qualquer := func() int { return rng.IntN(n) }
+		inicio := func() int { return rng.IntN(10) }
+		fmt.Printf("%-14s %10.1f %12.1f %14.1f\n", algoritmo.nome,
+			media(uniforme, qualquer), media(quadratica, qualquer), media(uniforme, inicio))
+	}
+	fmt.Println("Interpolação brilha com valores uniformes; exponencial, com o alvo perto do início.")
+}
+
+// ============================================================================
+// BUSCA DIRETO NAS ESTRUTURAS
+// ============================================================================
+
+func demonstrarEstruturas() {
+	fmt.Println("\n=== BUSCA DIRETO NAS ESTRUTURAS (SEM ToSlice) ===")
+	
+	notas := list.NewArrayList[int](8)
+	notas.AddAll([]int{3, 5, 5, 7, 8, 9, 10})
+	fmt.Printf("ArrayList %v: binária(8) = %d, sentinela(9) = %d\n",
+		notas, buscas.BuscaBinariaLista[int](notas, 8), buscas.BuscaSentinela[int](notas, 9))
+	
+	fila := queue.NewLinkedQueue()
+	fila.EnqueueAll([]int{10, 20, 30, 40})
+	pilha := stack.NewLinkedStack()
+	pilha.PushAll([]int{1, 2, 3, 4})
+	d := deque.NewArrayDeque(4)
+	d.EnqueueAll([]int{5, 6, 7, 8})
+	fmt.Printf("Fila  %v: 30 na posição %d a partir do início\n", fila, buscas.BuscaFila(fila, 30))
+	fmt.Printf("Pilha %v: 2 a %d posições do topo\n", pilha, buscas.BuscaPilha(pilha, 2))
+	fmt.Printf("Deque %v: 7 na posição %d (girado e desfeito pelas duas pontas)\n", d, buscas.BuscaDeque(d, 7))
+	
+	l := list.NewDoublyLinkedList[int]()
+	l.AddAll([]int{1, 2, 3, 4, 5})
+	buscas.BuscaMoverParaFrente[int](l, 4)
+	fmt.Println("Mover para a frente o 4:", l)
+	buscas.BuscaTransposicao[int](l, 2)
+	fmt.Println("Transpor o 2:           ", l)
+}
+
+// compararAutoOrganizacao mede o custo médio de busca numa LinkedList quando
+// poucos valores concentram quase todos os acessos
+func compararAutoOrganizacao() {
+	const n = 500
+	const consultas = 20000
+	
+	fmt.Printf("\n=== LISTA AUTO-ORGANIZÁVEL: 90%% DOS ACESSOS EM 10 DE %d VALORES ===\n", n)
+	estrategias := []struct {
+		nome  string
+		busca func(list.List[int], int) int
+	}{
+		{"sem reorganizar", func(l list.List[int], v int) int { return l.IndexOf(v) }},
+		{"mover para a frente", buscas.BuscaMoverParaFrente[int]},
+		{"transposição", buscas.BuscaTransposicao[int]},
+	}
+	for _, estrategia := range estrategias {
+		rng := rand.New(rand.NewPCG(7, 8))
+		var contadores instrument.Counters
+		l := list.NewLinkedList[int]()
+		for i := 0; i < n; i++ {
+			l.Add(i)
+		}
+		l.SetCounters(&contadores)
+		for i := 0; i < consultas; i++ {
+			alvo := rng.IntN(n)
+			if rng.IntN(10) < 9 {
+				alvo = n - 1 - rng.IntN(10) // Os populares começam no fim
+			}
+			estrategia.busca(l, alvo)
+		}
+		fmt.Printf("%-20s %8.1f comparações por busca\n", estrategia.nome, float64(contadores.Comparisons)/consultas)
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"dca3503/cache"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - CACHES LRU E LFU
+// ============================================================================
+
+func main() {
+	fmt.Println("=== CACHES SOBRE DOUBLYLINKEDLIST ===")
+	fmt.Println()
+	
+	demonstrateLRU()
+	demonstrateLFU()
+	demonstrateTTL()
+	compareHitRates()
+}
+
+// ============================================================================
+// LRU
+// ============================================================================
+
+func demonstrateLRU() {
+	fmt.Println("=== LRU: DESCARTA O USADO HÁ MAIS TEMPO ===")
+	
+	lru := cache.NewLRUCache[string, int](3)
+	lru.SetOnEvict(func(key string, value int, reason cache.EvictionReason) {
+		fmt.Printf("  descartado %s=%d (%s)\n", key, value, reason)
+	})
+	
+	for i, page := range []string{"home", "busca", "perfil"} {
+		lru.Put(page, i)
+	}
+	fmt.Println("Após inserir home, busca, perfil:", lru)
+	lru.Get("home")
+	fmt.Println("Após Get(home):                  ", lru)
+	lru.Put("config", 3)
+	fmt.Println("Após Put(config):                ", lru)
+	fmt.Println()
+}
+
+// ============================================================================
+// LFU
+// ============================================================================
+
+func demonstrateLFU() {
+	fmt.Println("=== LFU: DESCARTA O USADO MENOS VEZES ===")
+	
+	lfu := cache.NewLFUCache[string, int](3)
+	lfu.SetOnEvict(func(key string, value int, reason cache.EvictionReason) {
+		fmt.Printf("  descartado %s=%d (%s)\n", key, value, reason)
+	})
+	
+	lfu.Put("home", 0)
+	lfu.Put("busca", 1)
+	lfu.Put("perfil", 2)
+	for i := 0; i < 3; i++ {
+		lfu.Get("home")
+	}
+	lfu.Get("busca")
+	fmt.Println("Frequências:    ", lfu)
+	lfu.Put("config", 3)
+	fmt.Println("Após Put(config):", lfu)
+	fmt.Println("Estatísticas:", lfu.GetStatistics()["frequencies"])
+	fmt.Println()
+}
+
+// ============================================================================
+// TTL
+// ============================================================================
+
+func demonstrateTTL() {
+	fmt.Println("=== EXPIRAÇÃO POR TTL ===")
+	
+	// Relógio simulado para não precisar esperar de verdade
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	lru := cache.NewLRUCache[string, string](10)
+	lru.SetClock(func() time.Time { return now })
+	lru.SetTTL(30 * time.Second)
+	
+	lru.Put("sessão", "abc123")
+	lru.PutWithTTL("token", "xyz", 5*time.Minute)
+	fmt.Println("12:00:00", lru)
+	
+	now = now.Add(45 * time.Second)
+	_, ok := lru.Get("sessão")
+	fmt.Printf("12:00:45 Get(sessão) encontrou? %v  %v\n", ok, lru)
+	
+	stats := lru.GetStatistics()
+	fmt.Printf("acertos %v, falhas %v, expiradas %v\n", stats["hits"], stats["misses"], stats["expirations"])
+	fmt.Println()
+}
+
+// ============================================================================
+// TAXA DE ACERTO
+// ============================================================================
+
+func compareHitRates() {
+	fmt.Println("=== TAXA DE ACERTO EM ACESSOS COM POPULARIDADE DESIGUAL ===")
+	
+	// 80% dos acessos vão para 20 páginas populares, 20% para 1000 páginas raras
+	rng := rand.New(rand.NewPCG(1, 2))
+	accesses := make([]int, 100000)
+	for i := range accesses {
+		if rng.IntN(10) < 8 {
+			accesses[i] = rng.IntN(20)
+		} else {
+			accesses[i] = 20 + rng.IntN(1000)
+		}
+	}
+	
+	for _, capacity := range []int{10, 25, 50} {
+		caches := []cache.Cache[int, int]{cache.NewLRUCache[int, int](capacity), cache.NewLFUCache[int, int](capacity)}
+		fmt.Printf("capacidade %3d:", capacity)
+		for _, c := range caches {
+			for _, page := range accesses {
+				if _, ok := c.Get(page); !ok {
+					c.Put(page, page)
+				}
+			}
+			stats := c.GetStatistics()
+			fmt.Printf("  %s %.1f%%", stats["policy"], 100*stats["hitRate"].(float64))
+		}
+		fmt.Println()
+	}
+}
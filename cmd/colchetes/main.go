@@ -0,0 +1,117 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"dca3503/stack"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - VERIFICADOR DE COLCHETES
+// ============================================================================
+
+// Uso:
+//   go run ./cmd/colchetes [-lang auto|go|c|json|texto] [-fix] arquivo...
+//   go run ./cmd/colchetes -lang go < main.go
+//
+// Para cada arquivo imprime os problemas como "arquivo:linha:coluna: mensagem"
+// e a menor correção encontrada. Com -fix, imprime o texto corrigido.
+// Código de saída: 0 se tudo balanceado, 1 se houver problemas, 2 em erro de leitura
+
+func main() {
+	languageName := flag.String("lang", "auto", "linguagem: auto (pela extensão), go, c, json ou texto")
+	printFixed := flag.Bool("fix", false, "imprime o texto com a correção aplicada")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "uso: colchetes [opções] [arquivo...]\n")
+		fmt.Fprintf(flag.CommandLine.Output(), "sem arquivos (ou com \"-\"), lê a entrada padrão\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	
+	var forced *stack.Language
+	if *languageName != "auto" {
+		language, ok := stack.LanguageByName(*languageName)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "linguagem desconhecida: %s\n", *languageName)
+			os.Exit(2)
+		}
+		forced = &language
+	}
+	
+	paths := flag.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+	
+	status := 0
+	for _, path := range paths {
+		source, err := readSource(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			status = 2
+			continue
+		}
+		
+		language := stack.LanguageForFile(path)
+		if forced != nil {
+			language = *forced
+		}
+		
+		if !check(path, source, language, *printFixed) && status == 0 {
+			status = 1
+		}
+	}
+	os.Exit(status)
+}
+
+// readSource lê um arquivo, ou a entrada padrão quando path é "-"
+func readSource(path string) (string, error) {
+	if path == "-" {
+		data, err := io.ReadAll(os.Stdin)
+		return string(data), err
+	}
+	data, err := os.ReadFile(path)
+	return string(data), err
+}
+
+// check analisa um texto e imprime o relatório; retorna true se balanceado
+func check(path, source string, language stack.Language, printFixed bool) bool {
+	name := path
+	if path == "-" {
+		name = "<stdin>"
+	}
+	
+	report := stack.AnalyzeBrackets(source, language)
+	if report.Balanced() {
+		fmt.Printf("%s: balanceado (%s)\n", name, language.Name)
+		if printFixed {
+			fmt.Print(source)
+		}
+		return true
+	}
+	
+	for _, issue := range report.Issues {
+		fmt.Printf("%s:%s\n", name, issue)
+	}
+	
+	if len(report.Fix) > 0 {
+		kind := "correção mínima"
+		if !report.MinimalFix {
+			kind = "correção sugerida (não necessariamente mínima)"
+		}
+		fmt.Printf("%s: %s com %d edição(ões):\n", name, kind, len(report.Fix))
+		for _, edit := range report.Fix {
+			fmt.Printf("  %s\n", edit)
+		}
+	}
+	
+	if printFixed {
+		fmt.Println(strings.Repeat("-", 40))
+		fmt.Print(stack.ApplyBracketFix(source, report.Fix))
+	}
+	return false
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"dca3503/deque"
+	"dca3503/instrument"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - COMPLEXIDADE NA PRÁTICA
+// ============================================================================
+
+// Cada experimento conta operações elementares para vários tamanhos n e
+// ajusta a curva de crescimento (1, log n, n, n log n, n²) que melhor
+// explica o custo por operação
+
+func main() {
+	fmt.Println("=== COMPLEXIDADE MEDIDA COM CONTADORES ===")
+	fmt.Println()
+	
+	demonstrateCounters()
+	demonstrateAmortized()
+	demonstrateLinkedAccess()
+	demonstrateHeap()
+	demonstrateSorting()
+}
+
+// ============================================================================
+// CONTADORES EM UMA ÚNICA EXECUÇÃO
+// ============================================================================
+
+func demonstrateCounters() {
+	fmt.Println("=== CONTADORES DE UMA SEQUÊNCIA DE OPERAÇÕES ===")
+	
+	var counters instrument.Counters
+	al := list.NewArrayList[int](2)
+	al.SetCounters(&counters)
+	for i := 1; i <= 10; i++ {
+		al.Add(i)
+	}
+	fmt.Printf("ArrayList: 10 Add a partir da capacidade 2\n  %s\n", counters)
+	
+	counters.Reset()
+	al.AddOnIndex(0, 0)
+	fmt.Printf("ArrayList: AddOnIndex(0, 0) desloca todos\n  %s\n", counters)
+	
+	counters.Reset()
+	ll := list.NewLinkedList[int]()
+	ll.SetCounters(&counters)
+	for i := 1; i <= 10; i++ {
+		ll.AddFirst(i)
+	}
+	fmt.Printf("LinkedList: 10 AddFirst\n  %s\n", counters)
+	
+	before := counters
+	ll.Get(9)
+	fmt.Printf("LinkedList: Get(9)\n  %s\n", counters.Sub(before))
+	fmt.Println()
+}
+
+// ============================================================================
+// CUSTO AMORTIZADO
+// ============================================================================
+
+func demonstrateAmortized() {
+	fmt.Println("=== CUSTO AMORTIZADO DA DUPLICAÇÃO ===")
+	
+	pushes := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		s := stack.NewArrayStack(1)
+		s.SetCounters(c)
+		for i := 0; i < n; i++ {
+			s.Push(i)
+		}
+		return n
+	})
+	fmt.Print(instrument.Report("ArrayStack.Push (movimentos por Push)", pushes, instrument.Moves))
+	fmt.Print(instrument.Report("ArrayStack.Push (redimensionamentos no total)",
+		perRun(pushes), instrument.Resizes))
+	
+	enqueues := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		d := deque.NewArrayDeque(1)
+		d.SetCounters(c)
+		for i := 0; i < n; i++ {
+			d.EnqueueFront(i)
+		}
+		return n
+	})
+	fmt.Print(instrument.Report("ArrayDeque.EnqueueFront (movimentos por operação)", enqueues, instrument.Moves))
+	fmt.Println()
+}
+
+// perRun transforma amostras "por operação" em amostras "por execução"
+func perRun(samples []instrument.Sample) []instrument.Sample {
+	result := make([]instrument.Sample, len(samples))
+	for i, sample := range samples {
+		sample.Operations = 1
+		result[i] = sample
+	}
+	return result
+}
+
+// ============================================================================
+// ACESSO POR ÍNDICE EM LISTAS LIGADAS
+// ============================================================================
+
+func demonstrateLinkedAccess() {
+	fmt.Println("=== ACESSO POR ÍNDICE: ARRAY x NÓS LIGADOS ===")
+	
+	linked := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		l := list.NewLinkedList[int]()
+		for i := 0; i < n; i++ {
+			l.AddFirst(i)
+		}
+		l.SetCounters(c)
+		for i := 0; i < n; i++ {
+			l.Get(i)
+		}
+		return n
+	})
+	fmt.Print(instrument.Report("LinkedList.Get (saltos por Get)", linked, instrument.Traversals))
+	
+	doubly := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		l := list.NewDoublyLinkedList[int]()
+		for i := 0; i < n; i++ {
+			l.AddLast(i)
+		}
+		l.SetCounters(c)
+		for i := 0; i < n; i++ {
+			l.Get(i)
+		}
+		return n
+	})
+	fmt.Print(instrument.Report("DoublyLinkedList.Get (saltos por Get, metade mais próxima)", doubly, instrument.Traversals))
+	
+	rear := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		d := deque.NewLinkedListDeque()
+		for i := 0; i < n; i++ {
+			d.EnqueueRear(i)
+		}
+		d.SetCounters(c)
+		for i := 0; i < n; i++ {
+			d.DequeueRear()
+		}
+		return n
+	})
+	fmt.Print(instrument.Report("LinkedListDeque.DequeueRear (saltos por operação)", rear, instrument.Traversals))
+	fmt.Println()
+}
+
+// ============================================================================
+// HEAP BINÁRIO
+// ============================================================================
+
+func demonstrateHeap() {
+	fmt.Println("=== FILA DE PRIORIDADE (HEAP BINÁRIO) ===")
+	
+	enqueue := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		// Pior caso do min-heap: cada novo elemento sobe até a raiz
+		pq := queue.NewMinPriorityQueue(n)
+		pq.SetCounters(c)
+		for i := 0; i < n; i++ {
+			pq.Enqueue(n - i)
+		}
+		return n
+	})
+	fmt.Print(instrument.Report("PriorityQueue.Enqueue (comparações por operação)", enqueue, instrument.Comparisons))
+	
+	heapify := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		pq := queue.NewMinPriorityQueue(n)
+		pq.SetCounters(c)
+		pq.Heapify(randomInts(n))
+		return 1
+	})
+	fmt.Print(instrument.Report("PriorityQueue.Heapify (comparações no total)", heapify, instrument.Comparisons))
+	fmt.Println()
+}
+
+// ============================================================================
+// ORDENAÇÃO
+// ============================================================================
+
+func demonstrateSorting() {
+	fmt.Println("=== ORDENAÇÃO ===")
+	
+	arraySort := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		l := list.NewArrayList[int](n)
+		l.AddAll(randomInts(n))
+		l.SetCounters(c)
+		l.Sort(list.Less[int])
+		return 1
+	})
+	fmt.Print(instrument.Report("ArrayList.Sort - introsort (comparações)", arraySort, instrument.Comparisons))
+	
+	linkedSort := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		l := list.NewLinkedList[int]()
+		for _, value := range randomInts(n) {
+			l.AddFirst(value)
+		}
+		l.SetCounters(c)
+		l.Sort(list.Less[int])
+		return 1
+	})
+	fmt.Print(instrument.Report("LinkedList.Sort - merge sort (comparações)", linkedSort, instrument.Comparisons))
+	
+	insertFront := instrument.Measure(nil, func(n int, c *instrument.Counters) int {
+		l := list.NewArrayList[int](n)
+		l.SetCounters(c)
+		for i := 0; i < n; i++ {
+			l.AddOnIndex(i, 0)
+		}
+		return 1
+	})
+	fmt.Print(instrument.Report("ArrayList: n inserções no início (movimentos no total)", insertFront, instrument.Moves))
+}
+
+// randomInts gera n inteiros pseudoaleatórios com semente fixa
+func randomInts(n int) []int {
+	rng := rand.New(rand.NewPCG(uint64(n), 2024))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = rng.IntN(n)
+	}
+	return values
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+
+	"dca3503/deque"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - DEMONSTRAÇÃO DE DEQUE
+// ============================================================================
+
+func main() {
+	d := deque.NewDeque()
+	
+	// Teste de inserções
+	fmt.Println("=== Testando Deque ===")
+	d.EnqueueFront(10)
+	d.EnqueueRear(20)
+	d.EnqueueFront(5)
+	d.EnqueueRear(25)
+	
+	fmt.Printf("Deque: %s\n", d)
+	fmt.Printf("Tamanho: %d\n", d.Size())
+	
+	// Teste de acesso
+	if front, err := d.Front(); err == nil {
+		fmt.Printf("Primeiro: %d\n", front)
+	}
+	if rear, err := d.Rear(); err == nil {
+		fmt.Printf("Último: %d\n", rear)
+	}
+	
+	// Teste de remoções
+	fmt.Println("\nRemoções:")
+	if value, err := d.DequeueFront(); err == nil {
+		fmt.Printf("Removido do início: %d\n", value)
+	}
+	if value, err := d.DequeueRear(); err == nil {
+		fmt.Printf("Removido do final: %d\n", value)
+	}
+	
+	fmt.Printf("Deque após remoções: %s\n", d)
+	
+	// Teste de busca
+	fmt.Printf("Contém 10: %t\n", d.Contains(10))
+	fmt.Printf("Índice do 20: %d\n", d.IndexOf(20))
+	
+	// Estatísticas
+	stats := d.GetStatistics()
+	fmt.Printf("Estatísticas: %+v\n", stats)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"dca3503/deque"
+	"dca3503/export"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - DESENHOS DAS ESTRUTURAS
+// ============================================================================
+
+// Uso:
+//   go run ./cmd/desenhos                      (Mermaid na saída padrão)
+//   go run ./cmd/desenhos -formato dot
+//   go run ./cmd/desenhos -formato dot -dir desenhos && dot -Tsvg -O desenhos/*.dot
+//
+// Monta algumas estruturas num estado interessante (buffer circular que deu
+// a volta, nó encontrado por FindNode, meio da lista) e exporta cada uma
+
+func main() {
+	format := flag.String("formato", "mermaid", "formato: mermaid ou dot")
+	dir := flag.String("dir", "", "diretório onde gravar um arquivo por estrutura (vazio = saída padrão)")
+	flag.Parse()
+	if *format != "mermaid" && *format != "dot" {
+		fmt.Fprintf(os.Stderr, "formato desconhecido: %q (use mermaid ou dot)\n", *format)
+		os.Exit(2)
+	}
+	
+	for i, d := range diagrams() {
+		text, extension := d.Mermaid(), ".mmd"
+		if *format == "dot" {
+			text, extension = d.DOT(), ".dot"
+		}
+		if *dir == "" {
+			fmt.Println(text)
+			continue
+		}
+		if err := os.MkdirAll(*dir, 0o755); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		path := filepath.Join(*dir, fmt.Sprintf("estrutura%d%s", i+1, extension))
+		if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("gravado: %s (%s)\n", path, d.Title())
+	}
+}
+
+// diagrams monta as estruturas de exemplo
+func diagrams() []*export.Diagram {
+	// LinkedList com o meio destacado na posição em que GetMiddle para
+	linked := list.NewLinkedList[int]()
+	linked.AddAll([]int{3, 1, 4, 1, 5})
+	middle, _ := linked.GetMiddle()
+	
+	// DoublyLinkedList com o nó devolvido por FindNode destacado
+	doubly := list.NewDoublyLinkedList[string]()
+	doubly.AddAll([]string{"ana", "bia", "caio", "davi"})
+	node := doubly.FindNode("caio")
+	
+	// ArrayQueue cujo conteúdo deu a volta no fim do array
+	circular := queue.NewArrayQueue(6)
+	for i := 1; i <= 5; i++ {
+		circular.Enqueue(i * 10)
+	}
+	circular.Dequeue()
+	circular.Dequeue()
+	circular.Enqueue(60)
+	circular.Enqueue(70)
+	
+	// ArrayDeque com elementos inseridos pelas duas pontas
+	twoEnded := deque.NewArrayDeque(6)
+	twoEnded.EnqueueRear(1)
+	twoEnded.EnqueueRear(2)
+	twoEnded.EnqueueFront(0)
+	twoEnded.EnqueueFront(-1)
+	
+	linkedDeque := deque.NewDeque()
+	for _, v := range []int{7, 8, 9} {
+		linkedDeque.EnqueueRear(v)
+	}
+	
+	stacked := stack.NewArrayStack(5)
+	stacked.PushAll([]int{1, 2, 3})
+	
+	return []*export.Diagram{
+		export.FromLinkedList(linked).Highlight(linked.MiddleIndex()).
+			WithTitle(fmt.Sprintf("LinkedList - GetMiddle() = %d", middle)),
+		export.FromDoublyLinkedList(doubly).Highlight(doubly.IndexOfNode(node)).
+			WithTitle("DoublyLinkedList - FindNode(caio)"),
+		export.FromArrayQueue(circular),
+		export.FromArrayDeque(twoEnded),
+		export.FromDeque(linkedDeque),
+		export.FromArrayStack(stacked).Highlight(0),
+	}
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"dca3503/scheduler"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - ESCALONAMENTO DE PROCESSOS
+// ============================================================================
+
+// Uso:
+//   go run ./cmd/escalonador                               (carga de exemplo)
+//   go run ./cmd/escalonador -policy rr -quantum 3 carga.csv
+//   go run ./cmd/escalonador -policy mlfq -mlfq 2,4,0 -boost 20 < carga.csv
+//
+// O CSV tem as colunas pid,arrival,burst[,priority] (cabeçalho opcional).
+// Para cada política imprime o diagrama de Gantt e os tempos de retorno,
+// espera e resposta de cada processo; com -policy all, também um resumo
+
+// sampleWorkload é usada quando nenhum arquivo é informado
+const sampleWorkload = `pid,arrival,burst,priority
+P1,0,8,3
+P2,1,4,1
+P3,2,9,4
+P4,3,5,2
+P5,6,2,1
+`
+
+func main() {
+	policyName := flag.String("policy", "all", "política: all, fcfs, rr, sjf, srtf, prio, prio-p ou mlfq")
+	quantum := flag.Int("quantum", scheduler.DefaultQuantum, "quantum do Round Robin")
+	levels := flag.String("mlfq", "4,8,0", "quanta dos níveis da MLFQ (0 no último = até terminar)")
+	boost := flag.Int("boost", 0, "intervalo do boost da MLFQ (0 desliga)")
+	width := flag.Int("width", 2, "colunas por unidade de tempo no diagrama")
+	flag.Parse()
+	
+	workload, err := readWorkload(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	quanta, err := parseQuanta(*levels)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	
+	policies := map[string]scheduler.Policy{
+		"fcfs":   scheduler.NewFCFS(),
+		"rr":     scheduler.NewRoundRobin(*quantum),
+		"sjf":    scheduler.NewSJF(),
+		"srtf":   scheduler.NewSRTF(),
+		"prio":   scheduler.NewPriority(false),
+		"prio-p": scheduler.NewPriority(true),
+		"mlfq":   scheduler.NewMLFQ(quanta, *boost),
+	}
+	names := []string{"fcfs", "rr", "sjf", "srtf", "prio", "prio-p", "mlfq"}
+	if *policyName != "all" {
+		if _, ok := policies[*policyName]; !ok {
+			fmt.Fprintf(os.Stderr, "política desconhecida: %s\n", *policyName)
+			os.Exit(2)
+		}
+		names = []string{*policyName}
+	}
+	
+	results := []*scheduler.Result{}
+	for _, name := range names {
+		result, err := scheduler.Run(workload, policies[name])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		results = append(results, result)
+		fmt.Printf("=== %s ===\n%s\n%s\n", result.Policy, result.Gantt(*width), result.Table())
+	}
+	
+	if len(results) > 1 {
+		printSummary(results)
+	}
+}
+
+// readWorkload lê o CSV do arquivo, da entrada padrão ("-") ou usa o exemplo
+func readWorkload(path string) ([]scheduler.Process, error) {
+	switch path {
+	case "":
+		return scheduler.ParseWorkload(strings.NewReader(sampleWorkload))
+	case "-":
+		return scheduler.ParseWorkload(os.Stdin)
+	}
+	return scheduler.LoadWorkload(path)
+}
+
+// parseQuanta converte "4,8,0" em []int{4, 8, 0}
+func parseQuanta(text string) ([]int, error) {
+	quanta := []int{}
+	for _, field := range strings.Split(text, ",") {
+		quantum, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("quantum inválido na MLFQ: %q", field)
+		}
+		quanta = append(quanta, quantum)
+	}
+	return quanta, nil
+}
+
+// printSummary compara as médias de todas as políticas
+func printSummary(results []*scheduler.Result) {
+	fmt.Println("=== RESUMO ===")
+	fmt.Printf("%-24s %9s %8s %9s %7s\n", "política", "retorno", "espera", "resposta", "trocas")
+	for _, result := range results {
+		fmt.Printf("%-24s %9.2f %8.2f %9.2f %7d\n", result.Policy,
+			result.AverageTurnaround(), result.AverageWaiting(), result.AverageResponse(), result.ContextSwitches)
+	}
+}
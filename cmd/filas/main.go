@@ -0,0 +1,438 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"dca3503/queue"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - DEMONSTRAÇÃO DE FILAS
+// ============================================================================
+
+func main() {
+	fmt.Println("=== DEMONSTRAÇÃO DE FILAS ===")
+	fmt.Println()
+	
+	// Demonstrações básicas
+	demonstrateArrayQueue()
+	demonstrateLinkedQueue()
+	demonstratePriorityQueue()
+	demonstrateDurableQueue()
+	
+	// Comparação de performance
+	compareQueuePerformance()
+	
+	// Demonstração da interface
+	demonstrateQueueInterface()
+	
+	// Algoritmos usando a interface
+	demonstrateQueueAlgorithms()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO ARRAYQUEUE
+// ============================================================================
+
+func demonstrateArrayQueue() {
+	fmt.Println("=== DEMONSTRAÇÃO ARRAYQUEUE ===")
+	
+	// Criação e inicialização
+	aq := queue.NewArrayQueue(5)
+	fmt.Printf("ArrayQueue criado com capacidade: %d\n", aq.Capacity())
+	
+	// Adicionando elementos (Enqueue)
+	fmt.Println("\nAdicionando elementos de 1 a 8...")
+	for i := 1; i <= 8; i++ {
+		aq.Enqueue(i)
+		fmt.Printf("Enqueue %d: %s\n", i, aq.String())
+	}
+	fmt.Printf("Tamanho: %d, Capacidade: %d\n", aq.Size(), aq.Capacity())
+	
+	// Testando Front e Rear
+	fmt.Println("\nTestando Front e Rear:")
+	front, _ := aq.Front()
+	rear, _ := aq.Rear()
+	fmt.Printf("Elemento na frente: %d\n", front)
+	fmt.Printf("Elemento no final: %d\n", rear)
+	
+	// Removendo elementos (Dequeue)
+	fmt.Println("\nRemovendo 3 elementos:")
+	for i := 0; i < 3; i++ {
+		value, err := aq.Dequeue()
+		if err == nil {
+			fmt.Printf("Dequeue: %d, Fila: %s\n", value, aq.String())
+		}
+	}
+	
+	// Testando operações auxiliares
+	fmt.Println("\nOperações auxiliares:")
+	fmt.Printf("Contém 5? %t\n", aq.Contains(5))
+	fmt.Printf("Índice do 5: %d\n", aq.IndexOf(5))
+	fmt.Printf("ToSlice: %v\n", aq.ToSlice())
+	
+	// Testando rotação
+	fmt.Println("\nTestando rotação (2 posições):")
+	fmt.Printf("Antes: %s\n", aq.String())
+	aq.Rotate(2)
+	fmt.Printf("Depois: %s\n", aq.String())
+	
+	// Estatísticas
+	fmt.Println("\nEstatísticas:")
+	stats := aq.GetStatistics()
+	for key, value := range stats {
+		fmt.Printf("%s: %v\n", key, value)
+	}
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO LINKEDQUEUE
+// ============================================================================
+
+func demonstrateLinkedQueue() {
+	fmt.Println("=== DEMONSTRAÇÃO LINKEDQUEUE ===")
+	
+	// Criação
+	lq := queue.NewLinkedQueue()
+	fmt.Println("LinkedQueue criado")
+	
+	// Adicionando elementos
+	fmt.Println("\nAdicionando elementos de 10 a 16...")
+	for i := 10; i <= 16; i++ {
+		lq.Enqueue(i)
+		fmt.Printf("Enqueue %d: %s\n", i, lq.String())
+	}
+	
+	// Testando operações
+	fmt.Println("\nTestando operações:")
+	front, _ := lq.Front()
+	rear, _ := lq.Rear()
+	fmt.Printf("Frente: %d, Final: %d\n", front, rear)
+	fmt.Printf("Tamanho: %d\n", lq.Size())
+	fmt.Printf("Vazia? %t\n", lq.IsEmpty())
+	
+	// Clonando fila
+	fmt.Println("\nClonando fila:")
+	clone := lq.Clone()
+	fmt.Printf("Original: %s\n", lq.String())
+	fmt.Printf("Clone: %s\n", clone.String())
+	fmt.Printf("São iguais? %t\n", lq.Equals(clone))
+	
+	// Removendo alguns elementos
+	fmt.Println("\nRemovendo 4 elementos:")
+	for i := 0; i < 4; i++ {
+		value, _ := lq.Dequeue()
+		fmt.Printf("Dequeue: %d, Fila: %s\n", value, lq.String())
+	}
+	
+	// Testando métodos avançados
+	fmt.Println("\nMétodos avançados:")
+	filtered := lq.Filter(func(x int) bool { return x%2 == 0 })
+	fmt.Printf("Elementos pares: %s\n", filtered.String())
+	
+	mapped := lq.Map(func(x int) int { return x * 2 })
+	fmt.Printf("Elementos dobrados: %s\n", mapped.String())
+	
+	sum := lq.Reduce(func(acc, x int) int { return acc + x }, 0)
+	fmt.Printf("Soma dos elementos: %d\n", sum)
+	
+	// Testando split
+	fmt.Println("\nTestando split no índice 1:")
+	first, second := lq.Split(1)
+	fmt.Printf("Primeira parte: %s\n", first.String())
+	fmt.Printf("Segunda parte: %s\n", second.String())
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO PRIORITYQUEUE
+// ============================================================================
+
+func demonstratePriorityQueue() {
+	fmt.Println("=== DEMONSTRAÇÃO PRIORITYQUEUE ===")
+	
+	// Eventos com prioridade (menor número = mais urgente)
+	pq := queue.NewMinPriorityQueue(5)
+	fmt.Println("PriorityQueue (min-heap) criada")
+	
+	fmt.Println("\nAdicionando prioridades 5, 1, 8, 3, 9, 2...")
+	for _, priority := range []int{5, 1, 8, 3, 9, 2} {
+		pq.Enqueue(priority)
+		fmt.Printf("Enqueue %d: %s\n", priority, pq.String())
+	}
+	
+	front, _ := pq.Front()
+	rear, _ := pq.Rear()
+	fmt.Printf("\nMais urgente: %d, menos urgente: %d\n", front, rear)
+	
+	// Alterando prioridades
+	fmt.Println("\nDecreaseKey 8 -> 0 e Update 1 -> 7:")
+	pq.DecreaseKey(8, 0)
+	pq.Update(1, 7)
+	fmt.Printf("Fila: %s\n", pq.String())
+	
+	// Construção em O(n) e junção de heaps
+	fmt.Println("\nHeapify de [40, 10, 30] e Merge:")
+	other := queue.NewMinPriorityQueue(3)
+	other.Heapify([]int{40, 10, 30})
+	pq.Merge(other)
+	fmt.Printf("Fila após merge: %s\n", pq.String())
+	
+	// Processando em ordem de prioridade
+	fmt.Println("\nProcessando eventos:")
+	for !pq.IsEmpty() {
+		value, _ := pq.Dequeue()
+		fmt.Printf("Dequeue: %d\n", value)
+	}
+	
+	// Max-heap com as funções utilitárias da interface
+	fmt.Println("\nMax-heap com funções da interface Queue:")
+	maxQueue := queue.NewMaxPriorityQueue(5)
+	maxQueue.EnqueueAll([]int{15, 42, 8, 23})
+	max, _ := queue.QueueMax(maxQueue)
+	fmt.Printf("Fila: %s\n", maxQueue.String())
+	fmt.Printf("Máximo: %d, Soma: %d\n", max, queue.QueueSum(maxQueue))
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO DURABLEQUEUE
+// ============================================================================
+
+func demonstrateDurableQueue() {
+	fmt.Println("=== DEMONSTRAÇÃO DURABLEQUEUE ===")
+	
+	dir, err := os.MkdirTemp("", "fila-duravel-")
+	if err != nil {
+		fmt.Println("Erro:", err)
+		return
+	}
+	defer os.RemoveAll(dir)
+	
+	// Fsync em lotes: mais rápido, perde no máximo um lote numa queda de energia
+	config := queue.DurableConfig{Sync: queue.SyncBatch, BatchSize: 16}
+	jobs, err := queue.OpenDurableQueue(dir, config)
+	if err != nil {
+		fmt.Println("Erro:", err)
+		return
+	}
+	fmt.Printf("Fila aberta em %s\n", dir)
+	for job := 101; job <= 105; job++ {
+		if err := jobs.TryEnqueue(job); err != nil {
+			fmt.Println("Erro:", err)
+			return
+		}
+	}
+	done, _ := jobs.Dequeue()
+	fmt.Printf("Job %d processado, pendentes: %s\n", done, jobs.String())
+	jobs.Close()
+	
+	// Reabrir reaplica o log: os jobs pendentes continuam lá
+	jobs, err = queue.OpenDurableQueue(dir, config)
+	if err != nil {
+		fmt.Println("Erro:", err)
+		return
+	}
+	defer jobs.Close()
+	fmt.Printf("Depois de reabrir: %s\n", jobs.String())
+	
+	jobs.Compact()
+	entries, _ := os.ReadDir(dir)
+	fmt.Print("Arquivos após Compact:")
+	for _, entry := range entries {
+		fmt.Print(" ", entry.Name())
+	}
+	fmt.Println()
+	fmt.Println()
+}
+
+// ============================================================================
+// COMPARAÇÃO DE PERFORMANCE - QUEUES
+// ============================================================================
+
+func compareQueuePerformance() {
+	fmt.Println("=== COMPARAÇÃO DE PERFORMANCE - QUEUES ===")
+	
+	const numOperations = 100000
+	
+	fmt.Printf("Testando %d operações Enqueue/Dequeue...\n\n", numOperations)
+	
+	// ArrayQueue
+	fmt.Println("ArrayQueue:")
+	benchmarkFunction("  Enqueue", func() {
+		aq := queue.NewArrayQueue(10)
+		for i := 0; i < numOperations; i++ {
+			aq.Enqueue(i)
+		}
+	})
+	
+	benchmarkFunction("  Enqueue+Dequeue", func() {
+		aq := queue.NewArrayQueue(10)
+		for i := 0; i < numOperations; i++ {
+			aq.Enqueue(i)
+		}
+		for i := 0; i < numOperations; i++ {
+			aq.Dequeue()
+		}
+	})
+	
+	// LinkedQueue
+	fmt.Println("\nLinkedQueue:")
+	benchmarkFunction("  Enqueue", func() {
+		lq := queue.NewLinkedQueue()
+		for i := 0; i < numOperations; i++ {
+			lq.Enqueue(i)
+		}
+	})
+	
+	benchmarkFunction("  Enqueue+Dequeue", func() {
+		lq := queue.NewLinkedQueue()
+		for i := 0; i < numOperations; i++ {
+			lq.Enqueue(i)
+		}
+		for i := 0; i < numOperations; i++ {
+			lq.Dequeue()
+		}
+	})
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO DA INTERFACE QUEUE
+// ============================================================================
+
+func demonstrateQueueInterface() {
+	fmt.Println("=== DEMONSTRAÇÃO DA INTERFACE QUEUE ===")
+	
+	// Criando diferentes implementações
+	var queues []queue.Queue
+	queues = append(queues, queue.NewArrayQueue(5))
+	queues = append(queues, queue.NewLinkedQueue())
+	queues = append(queues, queue.NewMaxPriorityQueue(5))
+	
+	names := []string{"ArrayQueue", "LinkedQueue", "PriorityQueue (max)"}
+	
+	// Testando polimorfismo
+	for i, q := range queues {
+		fmt.Printf("\nTestando %s:\n", names[i])
+		
+		// Adicionando elementos
+		for j := 1; j <= 5; j++ {
+			q.Enqueue(j * 10)
+		}
+		
+		queue.PrintQueue(q, names[i])
+		
+		// Testando operações
+		front, _ := q.Front()
+		rear, _ := q.Rear()
+		fmt.Printf("Frente: %d, Final: %d\n", front, rear)
+		
+		// Removendo elementos
+		for j := 0; j < 2; j++ {
+			value, _ := q.Dequeue()
+			fmt.Printf("Removido: %d\n", value)
+		}
+		
+		queue.PrintQueue(q, names[i])
+	}
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// ALGORITMOS USANDO QUEUES
+// ============================================================================
+
+func demonstrateQueueAlgorithms() {
+	fmt.Println("=== ALGORITMOS USANDO QUEUES ===")
+	
+	// 1. Geração de números binários
+	fmt.Println("\n1. Geração de Números Binários (1 a 10):")
+	binaryNumbers := queue.GenerateBinaryNumbers(10)
+	for i, binary := range binaryNumbers {
+		fmt.Printf("%d -> %s\n", i+1, binary)
+	}
+	
+	// 2. Busca em largura (simulação)
+	fmt.Println("\n2. Busca em Largura (árvore como array):")
+	tree := []int{1, 2, 3, 4, 5, 6, 7} // Árvore binária completa
+	traversal := queue.LevelOrderTraversal(tree)
+	fmt.Printf("Árvore: %v\n", tree)
+	fmt.Printf("Travessia em largura: %v\n", traversal)
+	
+	// 3. Primeiro caractere não repetido
+	fmt.Println("\n3. Primeiro Caractere Não Repetido:")
+	streams := []string{"abccba", "abcabc", "aabc"}
+	for _, stream := range streams {
+		result := queue.FirstNonRepeatingCharacter(stream)
+		fmt.Printf("Stream: %s\n", stream)
+		fmt.Print("Resultado: ")
+		for _, char := range result {
+			if char == 0 {
+				fmt.Print("- ")
+			} else {
+				fmt.Printf("%c ", char)
+			}
+		}
+		fmt.Println()
+	}
+	
+	// 4. Rotação de fila
+	fmt.Println("\n4. Rotação de Fila:")
+	queue1 := queue.NewArrayQueue(10)
+	for i := 1; i <= 6; i++ {
+		queue1.Enqueue(i)
+	}
+	fmt.Printf("Original: %s\n", queue1.String())
+	
+	queue.RotateQueue(queue1, 2)
+	fmt.Printf("Após rotação de 2: %s\n", queue1.String())
+	
+	// 5. Intercalar fila
+	fmt.Println("\n5. Intercalar Fila:")
+	queue2 := queue.NewLinkedQueue()
+	for i := 1; i <= 6; i++ {
+		queue2.Enqueue(i)
+	}
+	fmt.Printf("Antes de intercalar: %s\n", queue2.String())
+	
+	queue.InterleaveQueue(queue2)
+	fmt.Printf("Após intercalar: %s\n", queue2.String())
+	
+	// 6. Estatísticas da fila
+	fmt.Println("\n6. Estatísticas da Fila:")
+	queue3 := queue.NewArrayQueue(10)
+	for i := 10; i <= 50; i += 10 {
+		queue3.Enqueue(i)
+	}
+	fmt.Printf("Fila: %s\n", queue3.String())
+	
+	max, _ := queue.QueueMax(queue3)
+	min, _ := queue.QueueMin(queue3)
+	sum := queue.QueueSum(queue3)
+	fmt.Printf("Máximo: %d\n", max)
+	fmt.Printf("Mínimo: %d\n", min)
+	fmt.Printf("Soma: %d\n", sum)
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// FUNÇÕES AUXILIARES PARA DEMONSTRAÇÃO
+// ============================================================================
+
+// benchmarkFunction executa uma função e mede o tempo
+func benchmarkFunction(name string, fn func()) {
+	start := time.Now()
+	fn()
+	duration := time.Since(start)
+	fmt.Printf("%s: %v\n", name, duration)
+}
+
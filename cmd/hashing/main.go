@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+
+	"dca3503/hashmap"
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - TABELAS HASH
+// ============================================================================
+
+func main() {
+	fmt.Println("=== TABELAS HASH: ENCADEAMENTO E ENDEREÇAMENTO ABERTO ===")
+	fmt.Println()
+	
+	demonstrateChaining()
+	demonstrateProbing()
+	demonstrateRehash()
+	compareStrategies()
+}
+
+// ============================================================================
+// ENCADEAMENTO SEPARADO
+// ============================================================================
+
+func demonstrateChaining() {
+	fmt.Println("=== ENCADEAMENTO: COLISÕES VIRAM CADEIAS ===")
+	
+	// Hash fraco de propósito (tamanho da palavra) para forçar colisões
+	m := hashmap.NewChainedMap[string, int](4, 2)
+	m.SetHasher(func(key string) uint64 { return uint64(len(key)) })
+	for i, word := range []string{"pilha", "fila", "lista", "deque", "heap", "mapa"} {
+		m.Put(word, i)
+	}
+	fmt.Print(m.Layout())
+	stats := m.GetStatistics()
+	fmt.Printf("fator de carga %.2f, maior cadeia %v, baldes por tamanho %v\n",
+		stats["loadFactor"], stats["longestChain"], stats["bucketSizes"])
+	fmt.Println()
+}
+
+// ============================================================================
+// SONDAGEM
+// ============================================================================
+
+func demonstrateProbing() {
+	fmt.Println("=== ENDEREÇAMENTO ABERTO: A MESMA SEQUÊNCIA EM CADA SONDAGEM ===")
+	
+	// Todas as chaves começam no slot (chave % 4): muitas colisões em 8 slots
+	for _, probing := range []hashmap.Probing{hashmap.LinearProbing, hashmap.QuadraticProbing, hashmap.RobinHood} {
+		m := hashmap.NewOpenMap[int, string](probing, 8, 0.9)
+		m.SetHasher(func(key int) uint64 { return uint64(key % 4) })
+		for _, key := range []int{0, 4, 1, 8, 5, 2} {
+			m.Put(key, fmt.Sprint(key))
+		}
+		m.Remove(4)
+		fmt.Printf("%s (após Remove(4)):\n%s", probing, m.Layout())
+	}
+	fmt.Println("(+d) é a distância de casa, † uma lápide e ∅ um slot vazio")
+	fmt.Println()
+}
+
+// ============================================================================
+// REHASH
+// ============================================================================
+
+func demonstrateRehash() {
+	fmt.Println("=== REHASH: A CAPACIDADE DOBRA QUANDO α PASSA DO LIMITE ===")
+	
+	var counters instrument.Counters
+	m := hashmap.NewOpenMap[int, int](hashmap.RobinHood, 4, 0.75)
+	m.SetCounters(&counters)
+	capacity := m.Capacity()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+		if m.Capacity() != capacity {
+			fmt.Printf("  chave %3d: %3d → %3d slots\n", i, capacity, m.Capacity())
+			capacity = m.Capacity()
+		}
+	}
+	fmt.Printf("%d chaves, α = %.2f, %s\n", m.Size(), m.LoadFactor(), counters)
+	fmt.Println()
+}
+
+// ============================================================================
+// COMPARAÇÃO
+// ============================================================================
+
+func compareStrategies() {
+	fmt.Println("=== SONDAGENS POR BUSCA COM SUCESSO (16384 SLOTS, CHAVES ALEATÓRIAS) ===")
+	
+	// Capacidade fixa e limite alto: o fator de carga é controlado pelo
+	// número de chaves, sem rehash no meio
+	const capacity = 1 << 14
+	rng := rand.New(rand.NewPCG(1, 2))
+	keys := make([]int, capacity)
+	for i := range keys {
+		keys[i] = rng.Int()
+	}
+	
+	for _, load := range []float64{0.5, 0.75, 0.9} {
+		fmt.Printf("α = %.2f:\n", load)
+		maps := []hashmap.Map[int, int]{
+			hashmap.NewChainedMap[int, int](capacity, 0.95),
+			hashmap.NewOpenMap[int, int](hashmap.LinearProbing, capacity, 0.95),
+			hashmap.NewOpenMap[int, int](hashmap.QuadraticProbing, capacity, 0.95),
+			hashmap.NewOpenMap[int, int](hashmap.RobinHood, capacity, 0.95),
+		}
+		for _, m := range maps {
+			for i, key := range keys[:int(load*capacity)] {
+				m.Put(key, i)
+			}
+			stats := m.GetStatistics()
+			if stats["strategy"] == "encadeamento" {
+				fmt.Printf("  %-14s cadeia média %.2f  maior %v\n",
+					"encadeamento", stats["averageChain"], stats["longestChain"])
+				continue
+			}
+			fmt.Printf("  %-14s sondagem média %.2f  maior %v\n",
+				stats["probing"], stats["averageProbe"], stats["longestProbe"])
+		}
+	}
+}
@@ -0,0 +1,342 @@
+package main
+
+import (
+	"fmt"
+	"time"
+	"dca3503/stack"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - DEMONSTRAÇÃO DE PILHAS
+// ============================================================================
+
+func main() {
+	fmt.Println("=== DEMONSTRAÇÃO DE PILHAS ===")
+	fmt.Println()
+	
+	// Demonstrações básicas
+	demonstrateArrayStack()
+	demonstrateLinkedStack()
+	
+	// Comparação de performance
+	compareStackPerformance()
+	
+	// Demonstração da interface
+	demonstrateStackInterface()
+	
+	// Algoritmos usando a interface
+	demonstrateStackAlgorithms()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO ARRAYSTACK
+// ============================================================================
+
+func demonstrateArrayStack() {
+	fmt.Println("=== DEMONSTRAÇÃO ARRAYSTACK ===")
+	
+	// Criação e inicialização
+	as := stack.NewArrayStack(5)
+	fmt.Printf("ArrayStack criado com capacidade: %d\n", as.Capacity())
+	
+	// Adicionando elementos (Push)
+	fmt.Println("\nAdicionando elementos de 1 a 8...")
+	for i := 1; i <= 8; i++ {
+		as.Push(i)
+		fmt.Printf("Push %d: %s\n", i, as.String())
+	}
+	fmt.Printf("Tamanho: %d, Capacidade: %d\n", as.Size(), as.Capacity())
+	
+	// Testando Peek
+	fmt.Println("\nTestando Peek:")
+	top, err := as.Peek()
+	if err == nil {
+		fmt.Printf("Elemento no topo: %d\n", top)
+	}
+	
+	// Removendo elementos (Pop)
+	fmt.Println("\nRemovendo 3 elementos:")
+	for i := 0; i < 3; i++ {
+		value, err := as.Pop()
+		if err == nil {
+			fmt.Printf("Pop: %d, Pilha: %s\n", value, as.String())
+		}
+	}
+	
+	// Testando operações auxiliares
+	fmt.Println("\nOperações auxiliares:")
+	fmt.Printf("Contém 3? %t\n", as.Contains(3))
+	fmt.Printf("Posição do 3: %d\n", as.Search(3))
+	fmt.Printf("ToSlice: %v\n", as.ToSlice())
+	
+	// Estatísticas
+	fmt.Println("\nEstatísticas:")
+	stats := as.GetStatistics()
+	for key, value := range stats {
+		fmt.Printf("%s: %v\n", key, value)
+	}
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO LINKEDSTACK
+// ============================================================================
+
+func demonstrateLinkedStack() {
+	fmt.Println("=== DEMONSTRAÇÃO LINKEDSTACK ===")
+	
+	// Criação
+	ls := stack.NewLinkedStack()
+	fmt.Println("LinkedStack criado")
+	
+	// Adicionando elementos
+	fmt.Println("\nAdicionando elementos de 10 a 16...")
+	for i := 10; i <= 16; i++ {
+		ls.Push(i)
+		fmt.Printf("Push %d: %s\n", i, ls.String())
+	}
+	
+	// Testando operações
+	fmt.Println("\nTestando operações:")
+	top, _ := ls.Peek()
+	fmt.Printf("Topo: %d\n", top)
+	fmt.Printf("Tamanho: %d\n", ls.Size())
+	fmt.Printf("Vazia? %t\n", ls.IsEmpty())
+	
+	// Clonando pilha
+	fmt.Println("\nClonando pilha:")
+	clone := ls.Clone()
+	fmt.Printf("Original: %s\n", ls.String())
+	fmt.Printf("Clone: %s\n", clone.String())
+	fmt.Printf("São iguais? %t\n", ls.Equals(clone))
+	
+	// Removendo alguns elementos
+	fmt.Println("\nRemovendo 4 elementos:")
+	for i := 0; i < 4; i++ {
+		value, _ := ls.Pop()
+		fmt.Printf("Pop: %d, Pilha: %s\n", value, ls.String())
+	}
+	
+	// Testando métodos avançados
+	fmt.Println("\nMétodos avançados:")
+	filtered := ls.Filter(func(x int) bool { return x%2 == 0 })
+	fmt.Printf("Elementos pares: %s\n", filtered.String())
+	
+	mapped := ls.Map(func(x int) int { return x * 2 })
+	fmt.Printf("Elementos dobrados: %s\n", mapped.String())
+	
+	sum := ls.Reduce(func(acc, x int) int { return acc + x }, 0)
+	fmt.Printf("Soma dos elementos: %d\n", sum)
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// COMPARAÇÃO DE PERFORMANCE - STACKS
+// ============================================================================
+
+func compareStackPerformance() {
+	fmt.Println("=== COMPARAÇÃO DE PERFORMANCE - STACKS ===")
+	
+	const numOperations = 100000
+	
+	fmt.Printf("Testando %d operações Push/Pop...\n\n", numOperations)
+	
+	// ArrayStack
+	fmt.Println("ArrayStack:")
+	benchmarkFunction("  Push", func() {
+		as := stack.NewArrayStack(10)
+		for i := 0; i < numOperations; i++ {
+			as.Push(i)
+		}
+	})
+	
+	benchmarkFunction("  Push+Pop", func() {
+		as := stack.NewArrayStack(10)
+		for i := 0; i < numOperations; i++ {
+			as.Push(i)
+		}
+		for i := 0; i < numOperations; i++ {
+			as.Pop()
+		}
+	})
+	
+	// LinkedStack
+	fmt.Println("\nLinkedStack:")
+	benchmarkFunction("  Push", func() {
+		ls := stack.NewLinkedStack()
+		for i := 0; i < numOperations; i++ {
+			ls.Push(i)
+		}
+	})
+	
+	benchmarkFunction("  Push+Pop", func() {
+		ls := stack.NewLinkedStack()
+		for i := 0; i < numOperations; i++ {
+			ls.Push(i)
+		}
+		for i := 0; i < numOperations; i++ {
+			ls.Pop()
+		}
+	})
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// DEMONSTRAÇÃO DA INTERFACE STACK
+// ============================================================================
+
+func demonstrateStackInterface() {
+	fmt.Println("=== DEMONSTRAÇÃO DA INTERFACE STACK ===")
+	
+	// Criando diferentes implementações
+	var stacks []stack.Stack
+	stacks = append(stacks, stack.NewArrayStack(5))
+	stacks = append(stacks, stack.NewLinkedStack())
+	
+	names := []string{"ArrayStack", "LinkedStack"}
+	
+	// Testando polimorfismo
+	for i, s := range stacks {
+		fmt.Printf("\nTestando %s:\n", names[i])
+		
+		// Adicionando elementos
+		for j := 1; j <= 5; j++ {
+			s.Push(j * 10)
+		}
+		
+		stack.PrintStack(s, names[i])
+		
+		// Testando operações
+		top, _ := s.Peek()
+		fmt.Printf("Topo: %d\n", top)
+		
+		// Removendo elementos
+		for j := 0; j < 2; j++ {
+			value, _ := s.Pop()
+			fmt.Printf("Removido: %d\n", value)
+		}
+		
+		stack.PrintStack(s, names[i])
+	}
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// ALGORITMOS USANDO STACKS
+// ============================================================================
+
+func demonstrateStackAlgorithms() {
+	fmt.Println("=== ALGORITMOS USANDO STACKS ===")
+	
+	// 1. Verificação de parênteses balanceados
+	fmt.Println("\n1. Verificação de Parênteses Balanceados:")
+	testCases := []string{
+		"()",
+		"()[]{}",
+		"([{}])",
+		"([)]",
+		"(((",
+		")))",
+	}
+	
+	for _, test := range testCases {
+		result := stack.IsValidParentheses(test)
+		fmt.Printf("'%s' -> %t\n", test, result)
+	}
+	
+	// 2. Avaliação de expressão pós-fixa
+	fmt.Println("\n2. Avaliação de Expressão Pós-fixa:")
+	postfixExpressions := [][]string{
+		{"3", "4", "+"},                    // 3 + 4 = 7
+		{"3", "4", "+", "2", "*"},          // (3 + 4) * 2 = 14
+		{"15", "7", "1", "1", "+", "-", "/", "3", "*", "2", "1", "1", "+", "+", "-"}, // Complexa
+	}
+	
+	for _, expr := range postfixExpressions {
+		result, err := stack.EvaluatePostfix(expr)
+		if err == nil {
+			fmt.Printf("%v -> %d\n", expr, result)
+		} else {
+			fmt.Printf("%v -> Erro: %v\n", expr, err)
+		}
+	}
+	
+	// 3. Inversão usando pilha
+	fmt.Println("\n3. Inversão usando Pilha:")
+	original := stack.NewArrayStack(10)
+	for i := 1; i <= 5; i++ {
+		original.Push(i)
+	}
+	fmt.Printf("Original: %s\n", original.String())
+	
+	stack.ReverseStack(original)
+	fmt.Printf("Invertida: %s\n", original.String())
+	
+	// 4. Busca em pilha
+	fmt.Println("\n4. Busca em Pilha:")
+	numbers := stack.NewLinkedStack()
+	for i := 10; i <= 50; i += 10 {
+		numbers.Push(i)
+	}
+	fmt.Printf("Pilha: %s\n", numbers.String())
+	
+	targets := []int{30, 60, 10}
+	for _, target := range targets {
+		found := stack.FindInStack(numbers, target)
+		fmt.Printf("Buscar %d: %t\n", target, found)
+	}
+	
+	// 5. Estatísticas da pilha
+	fmt.Println("\n5. Estatísticas da Pilha:")
+	max, _ := stack.StackMax(numbers)
+	sum := stack.StackSum(numbers)
+	fmt.Printf("Máximo: %d\n", max)
+	fmt.Printf("Soma: %d\n", sum)
+	
+	// 6. Expressões infixas (tokenizador + shunting-yard + avaliação)
+	fmt.Println("\n6. Expressões Infixas:")
+	variables := map[string]float64{"x": 3, "taxa": 0.25}
+	fmt.Printf("Variáveis: x = 3, taxa = 0.25\n")
+	infixExpressions := []string{
+		"2 + 3 * 4",
+		"2 ^ 3 ^ 2",
+		"-2 ^ 2",
+		"max(x, 10 * taxa, abs(-4)) % 3",
+		"1000 * (1 + taxa) ^ x",
+		"(1 + 2",
+		"4 / (x - 3)",
+		"min(x, y)",
+	}
+	for _, expression := range infixExpressions {
+		compiled, err := stack.Compile(expression)
+		if err != nil {
+			fmt.Printf("%-32s -> Erro: %v\n", expression, err)
+			continue
+		}
+		result, err := compiled.Evaluate(variables)
+		if err != nil {
+			fmt.Printf("%-32s -> pós-fixa [%s] -> Erro: %v\n", expression, compiled, err)
+			continue
+		}
+		fmt.Printf("%-32s -> pós-fixa [%s] = %g\n", expression, compiled, result)
+	}
+	
+	fmt.Println()
+}
+
+// ============================================================================
+// FUNÇÕES AUXILIARES PARA DEMONSTRAÇÃO
+// ============================================================================
+
+// benchmarkFunction executa uma função e mede o tempo
+func benchmarkFunction(name string, fn func()) {
+	start := time.Now()
+	fn()
+	duration := time.Since(start)
+	fmt.Printf("%s: %v\n", name, duration)
+}
+
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"dca3503/queue"
+	"dca3503/simulation"
+)
+
+// ============================================================================
+// PROGRAMA PRINCIPAL - SIMULAÇÃO DE FILAS DE ATENDIMENTO
+// ============================================================================
+
+// Uso:
+//   go run ./cmd/simulacao                       (cenários de demonstração)
+//   go run ./cmd/simulacao -c 3 -lambda 2.5 -mu 1 -service exp -n 100000
+//
+// Cada cenário é simulado evento a evento (chegadas e saídas numa fila de
+// prioridade, clientes esperando numa queue.Queue) e comparado com a fórmula
+// analítica correspondente (M/M/1, M/M/c ou M/G/1)
+
+func main() {
+	servers := flag.Int("c", 0, "número de servidores (0 = cenários de demonstração)")
+	lambda := flag.Float64("lambda", 0.9, "taxa de chegada λ (clientes por unidade de tempo)")
+	mu := flag.Float64("mu", 1, "taxa de atendimento μ de cada servidor")
+	service := flag.String("service", "exp", "distribuição do atendimento: exp, det ou unif")
+	customers := flag.Int("n", 200000, "número de clientes")
+	seed := flag.Uint64("seed", 1, "semente do gerador aleatório")
+	flag.Parse()
+	
+	if *servers == 0 {
+		demonstrateScenarios(*customers, *seed)
+		return
+	}
+	
+	distribution, err := serviceDistribution(*service, *mu)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	config := simulation.Config{
+		Servers:   *servers,
+		Arrival:   simulation.Exponential{Rate: *lambda},
+		Service:   distribution,
+		Customers: *customers,
+		Warmup:    *customers / 100,
+		Seed:      *seed,
+	}
+	if err := runScenario(config); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// serviceDistribution monta a distribuição do atendimento com média 1/μ
+func serviceDistribution(name string, mu float64) (simulation.Distribution, error) {
+	switch name {
+	case "exp":
+		return simulation.Exponential{Rate: mu}, nil
+	case "det":
+		return simulation.Deterministic{Value: 1 / mu}, nil
+	case "unif":
+		return simulation.Uniform{Min: 0, Max: 2 / mu}, nil
+	}
+	return nil, fmt.Errorf("distribuição desconhecida: %s (use exp, det ou unif)", name)
+}
+
+// runScenario simula, imprime o resultado e, se houver fórmula, a comparação
+func runScenario(config simulation.Config) error {
+	result, err := simulation.Run(config)
+	if err != nil {
+		return err
+	}
+	fmt.Print(result)
+	
+	metrics, err := simulation.Analytic(config)
+	if err != nil {
+		fmt.Printf("  (%v)\n\n", err)
+		return nil
+	}
+	fmt.Print(simulation.Compare(result, metrics))
+	fmt.Println()
+	return nil
+}
+
+// ============================================================================
+// CENÁRIOS DE DEMONSTRAÇÃO
+// ============================================================================
+
+func demonstrateScenarios(customers int, seed uint64) {
+	fmt.Println("=== SIMULAÇÃO DE EVENTOS DISCRETOS ===")
+	fmt.Println()
+	
+	scenarios := []struct {
+		title  string
+		config simulation.Config
+	}{
+		{"Um caixa com 50% de ocupação (M/M/1)", simulation.Config{
+			Servers: 1, Arrival: simulation.Exponential{Rate: 0.5}, Service: simulation.Exponential{Rate: 1}}},
+		{"Um caixa com 90% de ocupação: a espera explode perto de ρ = 1", simulation.Config{
+			Servers: 1, Arrival: simulation.Exponential{Rate: 0.9}, Service: simulation.Exponential{Rate: 1}}},
+		{"Três caixas com a mesma carga por caixa (M/M/3)", simulation.Config{
+			Servers: 3, Arrival: simulation.Exponential{Rate: 2.7}, Service: simulation.Exponential{Rate: 1}}},
+		{"Atendimento de duração fixa: metade da espera do M/M/1 (M/D/1)", simulation.Config{
+			Servers: 1, Arrival: simulation.Exponential{Rate: 0.9}, Service: simulation.Deterministic{Value: 1}}},
+		{"Chegadas uniformes: sem fórmula fechada (G/M/2)", simulation.Config{
+			Servers: 2, Arrival: simulation.Uniform{Min: 0, Max: 1.2}, Service: simulation.Exponential{Rate: 1}}},
+	}
+	
+	for i, scenario := range scenarios {
+		fmt.Printf("--- %d. %s ---\n", i+1, scenario.title)
+		config := scenario.config
+		config.Customers = customers
+		config.Warmup = customers / 100
+		config.Seed = seed
+		if i == 2 {
+			// Qualquer implementação de queue.Queue serve como fila de espera
+			config.NewQueue = func() queue.Queue { return queue.NewArrayQueue(16) }
+		}
+		if err := runScenario(config); err != nil {
+			fmt.Println("erro:", err)
+		}
+	}
+}
@@ -1,9 +1,14 @@
 package deque
 
 import (
-	"errors"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
+
+	"dca3503/capacity"
+	"dca3503/errs"
+	"dca3503/instrument"
 )
 
 // ============================================================================
@@ -15,13 +20,19 @@ import (
 // - Operações em ambas extremidades são O(1)
 // - Uso eficiente do espaço (reutiliza posições)
 // - Evita necessidade de mover elementos
-// - Capacidade fixa (pode ser redimensionada)
+// - Capacidade fixa (redimensionada conforme a política de capacidade)
 type ArrayDeque struct {
-	data     []int // Array interno que armazena os elementos
-	front    int   // Índice do primeiro elemento
-	rear     int   // Índice da próxima posição livre
-	size     int   // Número atual de elementos
-	capacity int   // Capacidade máxima do array
+	data     []int                // Array interno que armazena os elementos
+	front    int                  // Índice do primeiro elemento
+	rear     int                  // Índice da próxima posição livre
+	size     int                  // Número atual de elementos
+	capacity int                  // Capacidade máxima do array
+	policy   capacity.Policy      // Crescimento, redução e limite (valor zero: dobra, nunca reduz)
+	gate     *capacity.Gate       // Sincronização do OverflowBlock (nil nos outros modos)
+	grows    int                  // Redimensionamentos para cima
+	shrinks  int                  // Redimensionamentos para baixo
+	err      error                // Primeira inserção recusada por OverflowError (ver Err)
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewArrayDeque cria uma nova instância de ArrayDeque com capacidade inicial
@@ -38,45 +49,162 @@ func NewArrayDeque(initialCapacity int) *ArrayDeque {
 	}
 }
 
+// SetCounters associa contadores de operações ao deque (nil desliga a contagem)
+// Conta escritas no array, comparações de busca e redimensionamentos
+func (q *ArrayDeque) SetCounters(counters *instrument.Counters) {
+	q.counters = counters
+}
+
 // ============================================================================
 // MÉTODOS PRINCIPAIS
 // ============================================================================
 
 // EnqueueFront adiciona elemento no início da fila (comportamento de deque)
-// Complexidade: O(1)
+// Com MaxCapacity atingida segue o Overflow da política: OverflowError
+// descarta o elemento e guarda o erro em Err (use TryEnqueueFront para
+// recebê-lo na hora), OverflowBlock espera uma remoção de outra goroutine e OverflowOverwrite
+// descarta o elemento do final, a ponta oposta
+// Complexidade: O(1) - pode ser O(n) se redimensionar
 func (q *ArrayDeque) EnqueueFront(element int) {
-	if q.IsFull() {
-		q.resize(q.capacity * 2)
+	q.keepErr(q.enqueueFront(element, true))
+}
+
+// TryEnqueueFront é o EnqueueFront que nunca espera: com o deque no limite
+// retorna um erro errs.ErrFull, exceto em OverflowOverwrite
+func (q *ArrayDeque) TryEnqueueFront(element int) error {
+	return q.enqueueFront(element, false)
+}
+
+// enqueueFront implementa EnqueueFront e TryEnqueueFront
+func (q *ArrayDeque) enqueueFront(element int, wait bool) error {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
+	if err := q.makeRoom(wait, false); err != nil {
+		return err
+	}
+	q.pushFront(element)
+	return nil
+}
+
+// pushFront grava o elemento no início, crescendo o array se estiver cheio
+func (q *ArrayDeque) pushFront(element int) {
+	if q.size == q.capacity {
+		q.resize(q.policy.Grow(q.capacity, q.capacity+1))
 	}
 	
 	q.front = (q.front - 1 + q.capacity) % q.capacity
 	q.data[q.front] = element
 	q.size++
+	q.counters.AddMoves(1)
 }
 
 // EnqueueRear adiciona elemento no final da fila
+// Com MaxCapacity atingida segue o Overflow como EnqueueFront; ao
+// sobrescrever, descarta o elemento do início
 // Complexidade: O(1) - pode ser O(n) se redimensionar
 func (q *ArrayDeque) EnqueueRear(element int) {
+	q.keepErr(q.enqueueRear(element, true))
+}
+
+// TryEnqueueRear é o EnqueueRear que nunca espera: com o deque no limite
+// retorna um erro errs.ErrFull, exceto em OverflowOverwrite
+func (q *ArrayDeque) TryEnqueueRear(element int) error {
+	return q.enqueueRear(element, false)
+}
+
+// Err retorna o erro da primeira inserção (EnqueueFront ou EnqueueRear)
+// descartada por OverflowError, ou nil se nenhuma foi; serve a quem usa o
+// deque pela interface IDeque, sem as variantes Try*
+func (q *ArrayDeque) Err() error {
+	return q.err
+}
+
+// keepErr guarda err se for o primeiro erro de inserção
+func (q *ArrayDeque) keepErr(err error) {
+	if err != nil && q.err == nil {
+		q.err = err
+	}
+}
+
+// enqueueRear implementa EnqueueRear e TryEnqueueRear
+func (q *ArrayDeque) enqueueRear(element int, wait bool) error {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
+	if err := q.makeRoom(wait, true); err != nil {
+		return err
+	}
+	q.pushRear(element)
+	return nil
+}
+
+// pushRear grava o elemento no final, crescendo o array se estiver cheio
+func (q *ArrayDeque) pushRear(element int) {
 	// Verifica se precisa redimensionar
-	if q.IsFull() {
-		q.resize(q.capacity * 2)
+	if q.size == q.capacity {
+		q.resize(q.policy.Grow(q.capacity, q.capacity+1))
 	}
 	
 	q.data[q.rear] = element
 	q.rear = (q.rear + 1) % q.capacity
 	q.size++
+	q.counters.AddMoves(1)
+}
+
+// makeRoom trata uma inserção com o deque em MaxCapacity conforme o Overflow
+// Ao sobrescrever descarta a ponta oposta à inserção: o início quando
+// atRear, o final caso contrário
+// Complexidade: O(1), fora a espera do OverflowBlock
+func (q *ArrayDeque) makeRoom(wait, atRear bool) error {
+	if !q.policy.Full(q.size) {
+		return nil
+	}
+	switch {
+	case q.policy.Overflow == capacity.OverflowOverwrite && atRear:
+		q.evictFront()
+	case q.policy.Overflow == capacity.OverflowOverwrite:
+		q.evictRear()
+	case q.policy.Overflow == capacity.OverflowBlock && wait:
+		for q.policy.Full(q.size) {
+			q.gate.Wait()
+		}
+	default:
+		return errs.New(errs.ErrFull, "deque cheio: capacidade máxima %d", "full deque: maximum capacity %d", q.policy.MaxCapacity)
+	}
+	return nil
+}
+
+// evictFront descarta e retorna o elemento do início, sem reduzir o array
+func (q *ArrayDeque) evictFront() int {
+	value := q.data[q.front]
+	q.front = (q.front + 1) % q.capacity
+	q.size--
+	return value
+}
+
+// evictRear descarta e retorna o elemento do final, sem reduzir o array
+func (q *ArrayDeque) evictRear() int {
+	q.rear = (q.rear - 1 + q.capacity) % q.capacity
+	q.size--
+	return q.data[q.rear]
 }
 
 // DequeueFront remove e retorna elemento do início da fila
 // Complexidade: O(1)
 func (q *ArrayDeque) DequeueFront() (int, error) {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não é possível fazer dequeue")
+		return 0, errDequeueEmpty
 	}
 	
 	value := q.data[q.front]
 	q.front = (q.front + 1) % q.capacity
 	q.size--
+	q.shrink()
+	q.gate.Signal()
 	
 	return value, nil
 }
@@ -84,13 +212,18 @@ func (q *ArrayDeque) DequeueFront() (int, error) {
 // DequeueRear remove e retorna elemento do final da fila (comportamento de deque)
 // Complexidade: O(1)
 func (q *ArrayDeque) DequeueRear() (int, error) {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não é possível fazer dequeue")
+		return 0, errDequeueEmpty
 	}
 	
 	q.rear = (q.rear - 1 + q.capacity) % q.capacity
 	value := q.data[q.rear]
 	q.size--
+	q.shrink()
+	q.gate.Signal()
 	
 	return value, nil
 }
@@ -99,7 +232,7 @@ func (q *ArrayDeque) DequeueRear() (int, error) {
 // Complexidade: O(1)
 func (q *ArrayDeque) Front() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento na frente")
+		return 0, errFrontEmpty
 	}
 	return q.data[q.front], nil
 }
@@ -108,7 +241,7 @@ func (q *ArrayDeque) Front() (int, error) {
 // Complexidade: O(1)
 func (q *ArrayDeque) Rear() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento no final")
+		return 0, errRearEmpty
 	}
 	rearIndex := (q.rear - 1 + q.capacity) % q.capacity
 	return q.data[rearIndex], nil
@@ -127,18 +260,23 @@ func (q *ArrayDeque) IsEmpty() bool {
 }
 
 // IsFull verifica se a fila está cheia
+// Só acontece com MaxCapacity na política: sem limite o array sempre cresce
 // Complexidade: O(1)
 func (q *ArrayDeque) IsFull() bool {
-	return q.size == q.capacity
+	return q.policy.Full(q.size)
 }
 
 // Clear remove todos os elementos da fila
 // Complexidade: O(1)
 func (q *ArrayDeque) Clear() {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
 	q.front = 0
 	q.rear = 0
 	q.size = 0
 	// Não precisa limpar o array, apenas resetar os índices
+	q.gate.Signal()
 }
 
 // ============================================================================
@@ -201,32 +339,147 @@ func (q *ArrayDeque) resize(newCapacity int) {
 		newData[i] = q.data[index]
 	}
 	
+	if newCapacity > q.capacity {
+		q.grows++
+	} else {
+		q.shrinks++
+	}
 	q.data = newData
 	q.front = 0
-	q.rear = q.size
+	q.rear = q.size % newCapacity // Array cheio: rear volta para 0
 	q.capacity = newCapacity
+	q.counters.Resize(q.size)
+}
+
+// shrink reduz a capacidade depois de uma remoção, se a política mandar
+func (q *ArrayDeque) shrink() {
+	if next := q.policy.Shrink(q.capacity, q.size); next < q.capacity {
+		q.resize(next)
+	}
+}
+
+// SetGrowthPolicy troca a política de capacidade do deque
+// A política inicial dobra ao encher e nunca reduz sozinha
+// Retorna erro se a política for inválida ou se os elementos atuais não
+// couberem em MaxCapacity; um array maior que MaxCapacity é reduzido
+// Com OverflowBlock, EnqueueFront, EnqueueRear, DequeueFront, DequeueRear e
+// Clear passam a ser sincronizados para que outra goroutine possa abrir
+// espaço; os demais métodos continuam sem sincronização. Chame antes de
+// compartilhar o deque
+func (q *ArrayDeque) SetGrowthPolicy(policy capacity.Policy) error {
+	defer checkInvariants(q)
+	if err := policy.Validate(q.size); err != nil {
+		return err
+	}
+	q.policy = policy
+	q.gate = policy.NewGate()
+	if limit := policy.Clamp(q.capacity); limit < q.capacity {
+		q.resize(limit)
+	}
+	return nil
+}
+
+// GrowthPolicy retorna a política de capacidade atual
+func (q *ArrayDeque) GrowthPolicy() capacity.Policy {
+	return q.policy
 }
 
 // TrimToSize reduz a capacidade para o tamanho atual
 func (q *ArrayDeque) TrimToSize() {
+	defer checkInvariants(q)
 	if q.size < q.capacity {
-		q.resize(q.size)
+		newCapacity := q.size
+		if newCapacity == 0 {
+			newCapacity = 1 // Mantém pelo menos 1 de capacidade
+		}
+		q.resize(newCapacity)
 	}
 }
 
 // EnsureCapacity garante que a fila tenha pelo menos a capacidade mínima
+// Não passa de MaxCapacity
 func (q *ArrayDeque) EnsureCapacity(minCapacity int) {
+	defer checkInvariants(q)
+	minCapacity = q.policy.Clamp(minCapacity)
 	if minCapacity > q.capacity {
 		q.resize(minCapacity)
 	}
 }
 
+// ============================================================================
+// USO COMO BUFFER CIRCULAR
+// ============================================================================
+
+// OverwriteFront adiciona um elemento no início como num buffer circular:
+// com MaxCapacity atingida descarta o elemento do final, qualquer que seja
+// o Overflow da política, e o retorna com ok == true
+// Sem MaxCapacity nunca descarta nada: o deque cresce como no EnqueueFront
+// Complexidade: O(1) - pode ser O(n) se redimensionar
+func (q *ArrayDeque) OverwriteFront(element int) (evicted int, ok bool) {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
+	if q.policy.Full(q.size) {
+		evicted, ok = q.evictRear(), true
+	}
+	q.pushFront(element)
+	return evicted, ok
+}
+
+// OverwriteRear adiciona um elemento no final como num buffer circular,
+// descartando e retornando o elemento do início se MaxCapacity foi atingida
+// Complexidade: O(1) - pode ser O(n) se redimensionar
+func (q *ArrayDeque) OverwriteRear(element int) (evicted int, ok bool) {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
+	if q.policy.Full(q.size) {
+		evicted, ok = q.evictFront(), true
+	}
+	q.pushRear(element)
+	return evicted, ok
+}
+
+// At retorna o elemento na posição index a partir do início (0 é o início)
+// Complexidade: O(1)
+func (q *ArrayDeque) At(index int) (int, error) {
+	if index < 0 || index >= q.size {
+		return 0, errs.Index(index, q.size)
+	}
+	return q.data[(q.front+index)%q.capacity], nil
+}
+
+// AtBack retorna o elemento na posição index a partir do final (0 é o final)
+// Complexidade: O(1)
+func (q *ArrayDeque) AtBack(index int) (int, error) {
+	if index < 0 || index >= q.size {
+		return 0, errs.Index(index, q.size)
+	}
+	return q.data[(q.front+q.size-1-index)%q.capacity], nil
+}
+
+// Snapshot copia os elementos (do início para o final) para dst e retorna o
+// slice resultante, como append(dst[:0], q.ToSlice()...), reaproveitando o
+// array de dst quando ele tem capacidade suficiente
+// Complexidade: O(n), no máximo duas cópias contíguas
+func (q *ArrayDeque) Snapshot(dst []int) []int {
+	dst = slices.Grow(dst[:0], q.size)[:q.size]
+	if end := q.front + q.size; end <= q.capacity {
+		copy(dst, q.data[q.front:end])
+	} else {
+		n := copy(dst, q.data[q.front:])
+		copy(dst[n:], q.data[:end-q.capacity])
+	}
+	return dst
+}
+
 // ============================================================================
 // MÉTODOS AVANÇADOS
 // ============================================================================
 
 // EnqueueAll adiciona múltiplos elementos de uma vez
 func (q *ArrayDeque) EnqueueAll(elements []int) {
+	defer checkInvariants(q)
 	for _, element := range elements {
 		q.EnqueueRear(element)
 	}
@@ -234,12 +487,13 @@ func (q *ArrayDeque) EnqueueAll(elements []int) {
 
 // DequeueMultiple remove e retorna múltiplos elementos do início
 func (q *ArrayDeque) DequeueMultiple(count int) ([]int, error) {
+	defer checkInvariants(q)
 	if count <= 0 {
 		return []int{}, nil
 	}
 	
 	if count > q.size {
-		return nil, fmt.Errorf("não há elementos suficientes: solicitado %d, disponível %d", count, q.size)
+		return nil, errs.NotEnough(count, q.size)
 	}
 	
 	result := make([]int, count)
@@ -255,6 +509,7 @@ func (q *ArrayDeque) DequeueMultiple(count int) ([]int, error) {
 func (q *ArrayDeque) Contains(element int) bool {
 	for i := 0; i < q.size; i++ {
 		index := (q.front + i) % q.capacity
+		q.counters.AddComparisons(1)
 		if q.data[index] == element {
 			return true
 		}
@@ -266,6 +521,7 @@ func (q *ArrayDeque) Contains(element int) bool {
 func (q *ArrayDeque) IndexOf(element int) int {
 	for i := 0; i < q.size; i++ {
 		index := (q.front + i) % q.capacity
+		q.counters.AddComparisons(1)
 		if q.data[index] == element {
 			return i
 		}
@@ -281,6 +537,8 @@ func (q *ArrayDeque) Clone() *ArrayDeque {
 // CloneArrayDeque cria uma cópia independente retornando *ArrayDeque
 func (q *ArrayDeque) CloneArrayDeque() *ArrayDeque {
 	newQueue := NewArrayDeque(q.capacity)
+	newQueue.policy = q.policy
+	newQueue.gate = q.policy.NewGate()
 	
 	for i := 0; i < q.size; i++ {
 		index := (q.front + i) % q.capacity
@@ -321,12 +579,15 @@ func (q *ArrayDeque) GetMemoryUsage() (int, int, float64) {
 }
 
 // GetStatistics retorna estatísticas da fila
+// grows e shrinks contam os redimensionamentos para cima e para baixo
 func (q *ArrayDeque) GetStatistics() map[string]interface{} {
 	if q.IsEmpty() {
 		return map[string]interface{}{
 			"size":     0,
 			"capacity": q.capacity,
 			"isEmpty":  true,
+			"grows":    q.grows,
+			"shrinks":  q.shrinks,
 		}
 	}
 	
@@ -361,11 +622,14 @@ func (q *ArrayDeque) GetStatistics() map[string]interface{} {
 		"usedSlots":   usedSlots,
 		"totalSlots":  totalSlots,
 		"utilization": fmt.Sprintf("%.1f%%", utilization),
+		"grows":       q.grows,
+		"shrinks":     q.shrinks,
 	}
 }
 
 // Rotate rotaciona a fila k posições para a esquerda
 func (q *ArrayDeque) Rotate(k int) {
+	defer checkInvariants(q)
 	if q.IsEmpty() || k <= 0 {
 		return
 	}
@@ -381,6 +645,7 @@ func (q *ArrayDeque) Rotate(k int) {
 
 // Reverse inverte a ordem dos elementos na fila
 func (q *ArrayDeque) Reverse() {
+	defer checkInvariants(q)
 	if q.size <= 1 {
 		return
 	}
@@ -490,4 +755,42 @@ func (q *ArrayDeque) FilterIDeque(predicate func(int) bool) IDeque {
 // MapIDeque implementa IDeque.Map() retornando IDeque
 func (q *ArrayDeque) MapIDeque(mapper func(int) int) IDeque {
 	return q.Map(mapper)
-}
\ No newline at end of file
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) do início para o final
+// Percorre o array circular sem copiar os elementos
+func (q *ArrayDeque) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(i, q.data[(q.front+i)%q.capacity]) {
+				return
+			}
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início para o final
+func (q *ArrayDeque) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.data[(q.front+i)%q.capacity]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward retorna um iterador sobre pares (posição, elemento) do final para o início
+func (q *ArrayDeque) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := q.size - 1; i >= 0; i-- {
+			if !yield(i, q.data[(q.front+i)%q.capacity]) {
+				return
+			}
+		}
+	}
+}
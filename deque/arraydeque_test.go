@@ -0,0 +1,47 @@
+package deque_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"dca3503/capacity"
+	"dca3503/deque"
+	"dca3503/errs"
+)
+
+func TestArrayDequeRingBuffer(t *testing.T) {
+	d := deque.NewArrayDeque(2)
+	if err := d.SetGrowthPolicy(capacity.Policy{MaxCapacity: 4}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 1; i <= 4; i++ {
+		if _, ok := d.OverwriteRear(i); ok {
+			t.Fatalf("OverwriteRear(%d) abaixo do limite descartou um elemento", i)
+		}
+	}
+	
+	// Sobrescreve mesmo com OverflowError: cada ponta descarta a oposta
+	if evicted, ok := d.OverwriteRear(5); !ok || evicted != 1 {
+		t.Errorf("OverwriteRear(5) = (%d, %v), esperado (1, true)", evicted, ok)
+	}
+	if evicted, ok := d.OverwriteFront(0); !ok || evicted != 5 {
+		t.Errorf("OverwriteFront(0) = (%d, %v), esperado (5, true)", evicted, ok)
+	}
+	want := []int{0, 2, 3, 4}
+	for i := range want {
+		front, errFront := d.At(i)
+		back, errBack := d.AtBack(i)
+		if errFront != nil || errBack != nil || front != want[i] || back != want[len(want)-1-i] {
+			t.Errorf("At(%d) = %d, AtBack(%d) = %d, deque %s", i, front, i, back, d)
+		}
+	}
+	if _, err := d.At(-1); !errors.Is(err, errs.ErrIndexOutOfRange) {
+		t.Errorf("At(-1): %v", err)
+	}
+	
+	dst := make([]int, 1, 4)
+	if got := d.Snapshot(dst); !slices.Equal(got, want) || &got[0] != &dst[0] || d.Capacity() != 4 {
+		t.Errorf("Snapshot = %v, deveria reaproveitar dst; capacidade %d", got, d.Capacity())
+	}
+}
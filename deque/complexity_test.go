@@ -0,0 +1,76 @@
+package deque_test
+
+import (
+	"testing"
+
+	"dca3503/deque"
+	"dca3503/instrument"
+)
+
+// Cada caso confere, contando operações elementares, uma complexidade
+// anotada nos comentários das implementações
+func TestDequeComplexity(t *testing.T) {
+	cases := []struct {
+		name     string
+		metric   instrument.Metric
+		expected instrument.Growth
+		run      instrument.Run
+	}{
+		{"ArrayDeque.EnqueueFront amortizado", instrument.Moves, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				d := deque.NewArrayDeque(1)
+				d.SetCounters(c)
+				for i := 0; i < n; i++ {
+					d.EnqueueFront(i)
+				}
+				return n
+			}},
+		{"Deque.GetAt", instrument.Traversals, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				d := deque.NewDeque()
+				for i := 0; i < n; i++ {
+					d.EnqueueRear(i)
+				}
+				d.SetCounters(c)
+				for i := 0; i < n; i++ {
+					d.GetAt(i)
+				}
+				return n
+			}},
+		{"Deque.DequeueRear", instrument.Total, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				d := deque.NewDeque()
+				for i := 0; i < n; i++ {
+					d.EnqueueRear(i)
+				}
+				d.SetCounters(c)
+				for i := 0; i < n; i++ {
+					d.DequeueRear()
+				}
+				return n
+			}},
+		{"LinkedListDeque.DequeueRear", instrument.Traversals, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				d := deque.NewLinkedListDeque()
+				for i := 0; i < n; i++ {
+					d.EnqueueRear(i)
+				}
+				d.SetCounters(c)
+				for i := 0; i < n; i++ {
+					d.DequeueRear()
+				}
+				return n
+			}},
+	}
+	
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := instrument.Measure(nil, tc.run)
+			fit := instrument.FitGrowth(samples, tc.metric)
+			if fit.Growth != tc.expected {
+				t.Errorf("esperado O(%s), medido %s\n%s", tc.expected, fit,
+					instrument.Report(tc.name, samples, tc.metric))
+			}
+		})
+	}
+}
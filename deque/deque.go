@@ -1,9 +1,13 @@
 package deque
 
 import (
-	"errors"
 	"fmt"
+	"iter"
 	"strings"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/links"
 )
 
 // ============================================================================
@@ -24,9 +28,10 @@ type DequeNode struct {
 // - Uso dinâmico de memória
 // - Navegação bidirecional
 type Deque struct {
-	front *DequeNode // Ponteiro para o primeiro nó
-	rear  *DequeNode // Ponteiro para o último nó
-	size  int        // Contador de elementos
+	front    *DequeNode           // Ponteiro para o primeiro nó
+	rear     *DequeNode           // Ponteiro para o último nó
+	size     int                  // Contador de elementos
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewDeque cria uma nova instância de Deque
@@ -38,6 +43,12 @@ func NewDeque() *Deque {
 	}
 }
 
+// SetCounters associa contadores de operações ao deque (nil desliga a contagem)
+// Conta alocações de nós, comparações de busca e saltos de ponteiro
+func (d *Deque) SetCounters(counters *instrument.Counters) {
+	d.counters = counters
+}
+
 // ============================================================================
 // MÉTODOS PRINCIPAIS DO DEQUE
 // ============================================================================
@@ -45,11 +56,13 @@ func NewDeque() *Deque {
 // EnqueueFront adiciona elemento no início do deque
 // Complexidade: O(1)
 func (d *Deque) EnqueueFront(value int) {
+	defer checkInvariants(d)
 	newNode := &DequeNode{
 		data: value,
 		next: d.front,
 		prev: nil,
 	}
+	d.counters.AddAllocations(1)
 	
 	if d.IsEmpty() {
 		// Primeiro elemento
@@ -67,11 +80,13 @@ func (d *Deque) EnqueueFront(value int) {
 // EnqueueRear adiciona elemento no final do deque
 // Complexidade: O(1)
 func (d *Deque) EnqueueRear(value int) {
+	defer checkInvariants(d)
 	newNode := &DequeNode{
 		data: value,
 		next: nil,
 		prev: d.rear,
 	}
+	d.counters.AddAllocations(1)
 	
 	if d.IsEmpty() {
 		// Primeiro elemento
@@ -89,8 +104,9 @@ func (d *Deque) EnqueueRear(value int) {
 // DequeueFront remove e retorna elemento do início do deque
 // Complexidade: O(1)
 func (d *Deque) DequeueFront() (int, error) {
+	defer checkInvariants(d)
 	if d.IsEmpty() {
-		return 0, errors.New("deque vazio: não é possível remover do início")
+		return 0, errRemoveFrontEmpty
 	}
 	
 	value := d.front.data
@@ -112,8 +128,9 @@ func (d *Deque) DequeueFront() (int, error) {
 // DequeueRear remove e retorna elemento do final do deque
 // Complexidade: O(1)
 func (d *Deque) DequeueRear() (int, error) {
+	defer checkInvariants(d)
 	if d.IsEmpty() {
-		return 0, errors.New("deque vazio: não é possível remover do final")
+		return 0, errRemoveRearEmpty
 	}
 	
 	value := d.rear.data
@@ -136,7 +153,7 @@ func (d *Deque) DequeueRear() (int, error) {
 // Complexidade: O(1)
 func (d *Deque) Front() (int, error) {
 	if d.IsEmpty() {
-		return 0, errors.New("deque vazio: não há elemento no início")
+		return 0, errNoFront
 	}
 	return d.front.data, nil
 }
@@ -145,7 +162,7 @@ func (d *Deque) Front() (int, error) {
 // Complexidade: O(1)
 func (d *Deque) Rear() (int, error) {
 	if d.IsEmpty() {
-		return 0, errors.New("deque vazio: não há elemento no final")
+		return 0, errNoRear
 	}
 	return d.rear.data, nil
 }
@@ -169,6 +186,7 @@ func (d *Deque) Size() int {
 // Clear remove todos os elementos do deque
 // Complexidade: O(1)
 func (d *Deque) Clear() {
+	defer checkInvariants(d)
 	d.front = nil
 	d.rear = nil
 	d.size = 0
@@ -214,6 +232,16 @@ func (d *Deque) ToSliceReverse() []int {
 	return result
 }
 
+// Links fotografa os nós como estão na memória, a partir do front e do
+// rear, com os ponteiros next e prev reais (ver links.Snapshot)
+// Complexidade: O(n)
+func (d *Deque) Links() links.Snapshot[int] {
+	return links.Walk([]*DequeNode{d.front, d.rear},
+		func(node *DequeNode) int { return node.data },
+		func(node *DequeNode) *DequeNode { return node.next },
+		func(node *DequeNode) *DequeNode { return node.prev })
+}
+
 // String retorna uma representação em string do deque
 // Complexidade: O(n)
 func (d *Deque) String() string {
@@ -283,10 +311,12 @@ func (d *Deque) PeekRear() (int, error) {
 func (d *Deque) Contains(element int) bool {
 	current := d.front
 	for current != nil {
+		d.counters.AddComparisons(1)
 		if current.data == element {
 			return true
 		}
 		current = current.next
+		d.counters.AddTraversals(1)
 	}
 	return false
 }
@@ -299,10 +329,12 @@ func (d *Deque) IndexOf(element int) int {
 	index := 0
 	
 	for current != nil {
+		d.counters.AddComparisons(1)
 		if current.data == element {
 			return index
 		}
 		current = current.next
+		d.counters.AddTraversals(1)
 		index++
 	}
 	
@@ -313,7 +345,7 @@ func (d *Deque) IndexOf(element int) int {
 // Complexidade: O(n) no pior caso, O(n/2) em média (busca bidirecional)
 func (d *Deque) GetAt(index int) (int, error) {
 	if index < 0 || index >= d.size {
-		return 0, fmt.Errorf("índice fora dos limites: %d", index)
+		return 0, errs.Index(index, d.size)
 	}
 	
 	// Otimização: escolhe direção mais próxima
@@ -323,6 +355,7 @@ func (d *Deque) GetAt(index int) (int, error) {
 		for i := 0; i < index; i++ {
 			current = current.next
 		}
+		d.counters.AddTraversals(index)
 		return current.data, nil
 	} else {
 		// Busca do final
@@ -330,6 +363,7 @@ func (d *Deque) GetAt(index int) (int, error) {
 		for i := d.size - 1; i > index; i-- {
 			current = current.prev
 		}
+		d.counters.AddTraversals(d.size - 1 - index)
 		return current.data, nil
 	}
 }
@@ -337,8 +371,9 @@ func (d *Deque) GetAt(index int) (int, error) {
 // RemoveAt remove elemento na posição especificada
 // Complexidade: O(n) no pior caso, O(n/2) em média
 func (d *Deque) RemoveAt(index int) (int, error) {
+	defer checkInvariants(d)
 	if index < 0 || index >= d.size {
-		return 0, fmt.Errorf("índice fora dos limites: %d", index)
+		return 0, errs.Index(index, d.size)
 	}
 	
 	// Casos especiais para extremidades
@@ -357,12 +392,14 @@ func (d *Deque) RemoveAt(index int) (int, error) {
 		for i := 0; i < index; i++ {
 			nodeToRemove = nodeToRemove.next
 		}
+		d.counters.AddTraversals(index)
 	} else {
 		// Busca do final
 		nodeToRemove = d.rear
 		for i := d.size - 1; i > index; i-- {
 			nodeToRemove = nodeToRemove.prev
 		}
+		d.counters.AddTraversals(d.size - 1 - index)
 	}
 	
 	// Remove o nó
@@ -377,8 +414,9 @@ func (d *Deque) RemoveAt(index int) (int, error) {
 // InsertAt insere elemento na posição especificada
 // Complexidade: O(n) no pior caso, O(n/2) em média
 func (d *Deque) InsertAt(index int, value int) error {
+	defer checkInvariants(d)
 	if index < 0 || index > d.size {
-		return fmt.Errorf("índice fora dos limites: %d", index)
+		return errs.Index(index, d.size)
 	}
 	
 	// Casos especiais para extremidades
@@ -399,12 +437,14 @@ func (d *Deque) InsertAt(index int, value int) error {
 		for i := 0; i < index; i++ {
 			nextNode = nextNode.next
 		}
+		d.counters.AddTraversals(index)
 	} else {
 		// Busca do final
 		nextNode = d.rear
 		for i := d.size - 1; i > index; i-- {
 			nextNode = nextNode.prev
 		}
+		d.counters.AddTraversals(d.size - 1 - index)
 	}
 	
 	// Cria e insere novo nó
@@ -413,6 +453,7 @@ func (d *Deque) InsertAt(index int, value int) error {
 		next: nextNode,
 		prev: nextNode.prev,
 	}
+	d.counters.AddAllocations(1)
 	
 	nextNode.prev.next = newNode
 	nextNode.prev = newNode
@@ -424,6 +465,7 @@ func (d *Deque) InsertAt(index int, value int) error {
 // Reverse inverte a ordem dos elementos no deque
 // Complexidade: O(n)
 func (d *Deque) Reverse() {
+	defer checkInvariants(d)
 	if d.size <= 1 {
 		return
 	}
@@ -637,48 +679,43 @@ func (d *Deque) MapIDeque(mapper func(int) int) IDeque {
 }
 
 // ============================================================================
-// EXEMPLO DE USO
+// ITERADORES (RANGE-OVER-FUNC)
 // ============================================================================
 
-/*
-func main() {
-	deque := NewDeque()
-	
-	// Teste de inserções
-	fmt.Println("=== Testando Deque ===")
-	deque.EnqueueFront(10)
-	deque.EnqueueRear(20)
-	deque.EnqueueFront(5)
-	deque.EnqueueRear(25)
-	
-	fmt.Printf("Deque: %s\n", deque)
-	fmt.Printf("Tamanho: %d\n", deque.Size())
-	
-	// Teste de acesso
-	if front, err := deque.Front(); err == nil {
-		fmt.Printf("Primeiro: %d\n", front)
-	}
-	if rear, err := deque.Rear(); err == nil {
-		fmt.Printf("Último: %d\n", rear)
+// All retorna um iterador sobre pares (posição, elemento) do início para o final
+func (d *Deque) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		index := 0
+		for current := d.front; current != nil; current = current.next {
+			if !yield(index, current.data) {
+				return
+			}
+			index++
+		}
 	}
-	
-	// Teste de remoções
-	fmt.Println("\nRemoções:")
-	if value, err := deque.DequeueFront(); err == nil {
-		fmt.Printf("Removido do início: %d\n", value)
+}
+
+// Values retorna um iterador sobre os elementos do início para o final
+func (d *Deque) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for current := d.front; current != nil; current = current.next {
+			if !yield(current.data) {
+				return
+			}
+		}
 	}
-	if value, err := deque.DequeueRear(); err == nil {
-		fmt.Printf("Removido do final: %d\n", value)
+}
+
+// Backward retorna um iterador sobre pares (posição, elemento) do final para o início
+// Usa os ponteiros prev a partir do rear
+func (d *Deque) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		index := d.size - 1
+		for current := d.rear; current != nil; current = current.prev {
+			if !yield(index, current.data) {
+				return
+			}
+			index--
+		}
 	}
-	
-	fmt.Printf("Deque após remoções: %s\n", deque)
-	
-	// Teste de busca
-	fmt.Printf("Contém 10: %t\n", deque.Contains(10))
-	fmt.Printf("Índice do 20: %d\n", deque.IndexOf(20))
-	
-	// Estatísticas
-	stats := deque.GetStatistics()
-	fmt.Printf("Estatísticas: %+v\n", stats)
 }
-*/
\ No newline at end of file
@@ -0,0 +1,34 @@
+package deque_test
+
+import (
+	"testing"
+
+	"dca3503/capacity"
+	"dca3503/deque"
+	"dca3503/deque/dequetest"
+)
+
+func TestArrayDequeConformance(t *testing.T) {
+	dequetest.Run(t, func() deque.IDeque { return deque.NewArrayDeque(2) })
+}
+
+func TestArrayDequeGrowthPolicyConformance(t *testing.T) {
+	// Crescimento personalizado: três posições a mais que o dobro
+	grow := capacity.GrowthFunc(func(current int) int { return 2*current + 3 })
+	policy := capacity.Policy{Growth: grow, ShrinkAt: 0.2, MinCapacity: 4}
+	dequetest.Run(t, func() deque.IDeque {
+		d := deque.NewArrayDeque(4)
+		if err := d.SetGrowthPolicy(policy); err != nil {
+			t.Fatal(err)
+		}
+		return d
+	})
+}
+
+func TestDequeConformance(t *testing.T) {
+	dequetest.Run(t, func() deque.IDeque { return deque.NewDeque() })
+}
+
+func TestLinkedListDequeConformance(t *testing.T) {
+	dequetest.Run(t, func() deque.IDeque { return deque.NewLinkedListDeque() })
+}
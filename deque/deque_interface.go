@@ -1,5 +1,7 @@
 package deque
 
+import "dca3503/errs"
+
 // ============================================================================
 // DEQUE INTERFACE - DEFINIÇÃO DA INTERFACE PARA DEQUES
 // ============================================================================
@@ -29,6 +31,23 @@ type IDeque interface {
 	String() string          // Representação em string
 }
 
+// ============================================================================
+// ERROS
+// ============================================================================
+
+// Erros dos deques; todos satisfazem errors.Is(err, errs.ErrEmpty)
+// ArrayDeque e LinkedListDeque usam as mensagens de fila, Deque as de deque
+var (
+	errDequeueEmpty = errs.New(errs.ErrEmpty, "fila vazia: não é possível fazer dequeue", "empty queue: cannot dequeue")
+	errFrontEmpty   = errs.New(errs.ErrEmpty, "fila vazia: não há elemento na frente", "empty queue: no element at the front")
+	errRearEmpty    = errs.New(errs.ErrEmpty, "fila vazia: não há elemento no final", "empty queue: no element at the rear")
+	
+	errRemoveFrontEmpty = errs.New(errs.ErrEmpty, "deque vazio: não é possível remover do início", "empty deque: cannot remove from the front")
+	errRemoveRearEmpty  = errs.New(errs.ErrEmpty, "deque vazio: não é possível remover do final", "empty deque: cannot remove from the rear")
+	errNoFront          = errs.New(errs.ErrEmpty, "deque vazio: não há elemento no início", "empty deque: no element at the front")
+	errNoRear           = errs.New(errs.ErrEmpty, "deque vazio: não há elemento no final", "empty deque: no element at the rear")
+)
+
 // ============================================================================
 // OPERAÇÕES COMUNS PARA DEQUES
 // ============================================================================
@@ -0,0 +1,390 @@
+// Package dequetest implementa uma suíte de conformidade para deque.IDeque
+//
+// Qualquer implementação da interface pode ser validada com uma chamada:
+//
+//	func TestMeuDeque(t *testing.T) {
+//		dequetest.Run(t, func() deque.IDeque { return NovoMeuDeque() })
+//	}
+//
+// A suíte verifica os contratos da interface (erros em deque vazio, ordem
+// nas duas extremidades, Front/Rear sem remoção, ToSlice do início para o
+// final, concordância entre ToSlice e String) e executa sequências aleatórias
+// de operações comparando o deque com um modelo simples baseado em slice. Se a implementação tiver
+// um método Validate() error, ele é chamado depois de cada passo, e os
+// iteradores All, Values e Backward que ela tiver são conferidos contra ToSlice.
+package dequetest
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"dca3503/deque"
+	"dca3503/errs"
+)
+
+// Factory cria um deque novo e vazio a cada chamada
+type Factory func() deque.IDeque
+
+// RandomSeeds são as sementes usadas nas sequências aleatórias
+// Fixas para que uma falha seja sempre reproduzível
+var RandomSeeds = []uint64{1, 2, 3, 42, 2024}
+
+// RandomOperations é o número de operações por sequência aleatória
+var RandomOperations = 2000
+
+// Run executa toda a suíte de conformidade sobre deques criados por newDeque
+func Run(t *testing.T, newDeque Factory) {
+	t.Helper()
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, newDeque()) })
+	t.Run("BothEnds", func(t *testing.T) { testBothEnds(t, newDeque()) })
+	t.Run("AsQueue", func(t *testing.T) { testAsQueue(t, newDeque()) })
+	t.Run("AsStack", func(t *testing.T) { testAsStack(t, newDeque()) })
+	t.Run("FrontRearDoNotRemove", func(t *testing.T) { testFrontRear(t, newDeque()) })
+	t.Run("GrowAndShrink", func(t *testing.T) { testGrowAndShrink(t, newDeque()) })
+	t.Run("Clear", func(t *testing.T) { testClear(t, newDeque()) })
+	t.Run("ToSliceIsCopy", func(t *testing.T) { testToSliceIsCopy(t, newDeque()) })
+	t.Run("Randomized", func(t *testing.T) {
+		for _, seed := range RandomSeeds {
+			t.Run("seed="+strconv.FormatUint(seed, 10), func(t *testing.T) {
+				testRandomized(t, newDeque(), seed)
+			})
+		}
+	})
+}
+
+// ============================================================================
+// CONTRATOS
+// ============================================================================
+
+func testEmpty(t *testing.T, d deque.IDeque) {
+	if d.Size() != 0 || !d.IsEmpty() {
+		t.Fatalf("deque novo: Size()=%d IsEmpty()=%v, esperado 0 e true", d.Size(), d.IsEmpty())
+	}
+	if _, err := d.DequeueFront(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("DequeueFront() em deque vazio deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	if _, err := d.DequeueRear(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("DequeueRear() em deque vazio deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	if _, err := d.Front(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Front() em deque vazio deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	if _, err := d.Rear(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Rear() em deque vazio deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	// Erros não podem alterar o estado
+	checkState(t, d, []int{})
+}
+
+func testBothEnds(t *testing.T, d deque.IDeque) {
+	d.EnqueueRear(3)
+	d.EnqueueFront(2)
+	d.EnqueueRear(4)
+	d.EnqueueFront(1)
+	checkState(t, d, []int{1, 2, 3, 4})
+	
+	if got, err := d.DequeueRear(); err != nil || got != 4 {
+		t.Fatalf("DequeueRear() = (%d, %v), esperado (4, nil)", got, err)
+	}
+	if got, err := d.DequeueFront(); err != nil || got != 1 {
+		t.Fatalf("DequeueFront() = (%d, %v), esperado (1, nil)", got, err)
+	}
+	checkState(t, d, []int{2, 3})
+	
+	// Esvaziar pela extremidade oposta à inserção
+	if got, err := d.DequeueFront(); err != nil || got != 2 {
+		t.Fatalf("DequeueFront() = (%d, %v), esperado (2, nil)", got, err)
+	}
+	if got, err := d.DequeueRear(); err != nil || got != 3 {
+		t.Fatalf("DequeueRear() = (%d, %v), esperado (3, nil)", got, err)
+	}
+	checkState(t, d, []int{})
+}
+
+func testAsQueue(t *testing.T, d deque.IDeque) {
+	for i := 0; i < 5; i++ {
+		d.EnqueueRear(i)
+	}
+	for i := 0; i < 5; i++ {
+		got, err := d.DequeueFront()
+		if err != nil || got != i {
+			t.Fatalf("DequeueFront() = (%d, %v), esperado (%d, nil)", got, err, i)
+		}
+	}
+	checkState(t, d, []int{})
+}
+
+func testAsStack(t *testing.T, d deque.IDeque) {
+	for i := 0; i < 5; i++ {
+		d.EnqueueFront(i)
+	}
+	checkState(t, d, []int{4, 3, 2, 1, 0})
+	for i := 4; i >= 0; i-- {
+		got, err := d.DequeueFront()
+		if err != nil || got != i {
+			t.Fatalf("DequeueFront() = (%d, %v), esperado (%d, nil)", got, err, i)
+		}
+	}
+	checkState(t, d, []int{})
+}
+
+func testFrontRear(t *testing.T, d deque.IDeque) {
+	d.EnqueueRear(10)
+	d.EnqueueRear(20)
+	d.EnqueueRear(30)
+	for i := 0; i < 3; i++ {
+		front, errFront := d.Front()
+		rear, errRear := d.Rear()
+		if errFront != nil || errRear != nil || front != 10 || rear != 30 {
+			t.Fatalf("Front()=(%d, %v) Rear()=(%d, %v), esperado 10 e 30",
+				front, errFront, rear, errRear)
+		}
+	}
+	checkState(t, d, []int{10, 20, 30})
+}
+
+func testGrowAndShrink(t *testing.T, d deque.IDeque) {
+	// Cresce pelas duas extremidades e esvazia pelas duas
+	const n = 500
+	for i := 0; i < n; i++ {
+		d.EnqueueFront(-i - 1)
+		d.EnqueueRear(i)
+	}
+	if d.Size() != 2*n {
+		t.Fatalf("Size() = %d, esperado %d", d.Size(), 2*n)
+	}
+	for i := n - 1; i >= 0; i-- {
+		front, errFront := d.DequeueFront()
+		rear, errRear := d.DequeueRear()
+		if errFront != nil || errRear != nil || front != -i-1 || rear != i {
+			t.Fatalf("DequeueFront()=(%d, %v) DequeueRear()=(%d, %v), esperado %d e %d",
+				front, errFront, rear, errRear, -i-1, i)
+		}
+	}
+	checkState(t, d, []int{})
+}
+
+func testClear(t *testing.T, d deque.IDeque) {
+	for i := 0; i < 50; i++ {
+		d.EnqueueRear(i)
+	}
+	d.Clear()
+	checkState(t, d, []int{})
+	
+	// O deque continua utilizável depois de Clear
+	d.EnqueueRear(2)
+	d.EnqueueFront(1)
+	checkState(t, d, []int{1, 2})
+}
+
+func testToSliceIsCopy(t *testing.T, d deque.IDeque) {
+	d.EnqueueRear(1)
+	d.EnqueueRear(2)
+	slice := d.ToSlice()
+	slice[0] = 100
+	if got, _ := d.Front(); got != 1 {
+		t.Errorf("alterar o resultado de ToSlice modificou o deque: Front() = %d", got)
+	}
+}
+
+// ============================================================================
+// SEQUÊNCIAS ALEATÓRIAS CONTRA UM MODELO
+// ============================================================================
+
+// testRandomized aplica operações aleatórias no deque e num slice (modelo)
+// e compara os dois depois de cada passo
+func testRandomized(t *testing.T, d deque.IDeque, seed uint64) {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	model := []int{}
+	
+	for step := 0; step < RandomOperations; step++ {
+		value := rng.IntN(100) - 50
+		var op string
+		switch rng.IntN(10) {
+		case 0, 1, 2:
+			op = "EnqueueFront(" + strconv.Itoa(value) + ")"
+			d.EnqueueFront(value)
+			model = slices.Insert(model, 0, value)
+		case 3, 4, 5:
+			op = "EnqueueRear(" + strconv.Itoa(value) + ")"
+			d.EnqueueRear(value)
+			model = append(model, value)
+		case 6:
+			op = "DequeueFront()"
+			got, err := d.DequeueFront()
+			if len(model) == 0 {
+				if !errors.Is(err, errs.ErrEmpty) {
+					t.Fatalf("passo %d: DequeueFront() em deque vazio deveria retornar errs.ErrEmpty, retornou %v", step, err)
+				}
+				break
+			}
+			want := model[0]
+			model = model[1:]
+			if err != nil || got != want {
+				t.Fatalf("passo %d: DequeueFront() = (%d, %v), modelo tem %d", step, got, err, want)
+			}
+		case 7:
+			op = "DequeueRear()"
+			got, err := d.DequeueRear()
+			if len(model) == 0 {
+				if !errors.Is(err, errs.ErrEmpty) {
+					t.Fatalf("passo %d: DequeueRear() em deque vazio deveria retornar errs.ErrEmpty, retornou %v", step, err)
+				}
+				break
+			}
+			want := model[len(model)-1]
+			model = model[:len(model)-1]
+			if err != nil || got != want {
+				t.Fatalf("passo %d: DequeueRear() = (%d, %v), modelo tem %d", step, got, err, want)
+			}
+		case 8:
+			op = "Front()/Rear()"
+			front, errFront := d.Front()
+			rear, errRear := d.Rear()
+			if len(model) == 0 {
+				if errFront == nil || errRear == nil {
+					t.Fatalf("passo %d: Front()/Rear() em deque vazio deveriam retornar erro", step)
+				}
+				break
+			}
+			if errFront != nil || front != model[0] {
+				t.Fatalf("passo %d: Front() = (%d, %v), modelo tem %d", step, front, errFront, model[0])
+			}
+			if last := model[len(model)-1]; errRear != nil || rear != last {
+				t.Fatalf("passo %d: Rear() = (%d, %v), modelo tem %d", step, rear, errRear, last)
+			}
+		case 9:
+			// Clear raro para deixar o deque crescer
+			if rng.IntN(20) == 0 {
+				op = "Clear()"
+				d.Clear()
+				model = model[:0]
+			}
+		}
+		
+		if err := validate(d); err != nil {
+			t.Fatalf("passo %d (%s): %v", step, op, err)
+		}
+		
+		if d.Size() != len(model) || !slices.Equal(d.ToSlice(), model) {
+			t.Fatalf("passo %d (%s): deque %v (Size()=%d), modelo %v",
+				step, op, d.ToSlice(), d.Size(), model)
+		}
+		if d.IsEmpty() != (len(model) == 0) {
+			t.Fatalf("passo %d (%s): IsEmpty()=%v com %d elementos", step, op, d.IsEmpty(), len(model))
+		}
+	}
+	checkString(t, d)
+}
+
+// ============================================================================
+// AUXILIARES
+// ============================================================================
+
+// checkState compara tamanho, ToSlice e String com o conteúdo esperado
+func checkState(t *testing.T, d deque.IDeque, want []int) {
+	t.Helper()
+	if err := validate(d); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if d.Size() != len(want) {
+		t.Fatalf("Size() = %d, esperado %d", d.Size(), len(want))
+	}
+	if d.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("IsEmpty() = %v com %d elementos", d.IsEmpty(), len(want))
+	}
+	if got := d.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, esperado %v", got, want)
+	}
+	checkIterators(t, d)
+	checkString(t, d)
+}
+
+// validate roda Validate() quando a implementação oferece o verificador de
+// invariantes estruturais; implementações sem ele passam direto
+func validate(structure deque.IDeque) error {
+	if validator, ok := structure.(interface{ Validate() error }); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// checkIterators confere os iteradores All, Values e Backward que a
+// implementação oferecer: percorrem os elementos de ToSlice na mesma ordem
+// (Backward na inversa, com as mesmas posições) e param assim que yield
+// retorna false
+func checkIterators(t *testing.T, d deque.IDeque) {
+	t.Helper()
+	want := d.ToSlice()
+	positions := make([]int, len(want))
+	for i := range positions {
+		positions[i] = i
+	}
+	if it, ok := d.(interface{ All() iter.Seq2[int, int] }); ok {
+		checkSeq(t, "All", it.All(), positions, want)
+	}
+	if it, ok := d.(interface{ Values() iter.Seq[int] }); ok {
+		// Numera os valores para conferir como os pares de All
+		numbered := func(yield func(int, int) bool) {
+			i := 0
+			for value := range it.Values() {
+				if !yield(i, value) {
+					return
+				}
+				i++
+			}
+		}
+		checkSeq(t, "Values", numbered, positions, want)
+	}
+	if it, ok := d.(interface{ Backward() iter.Seq2[int, int] }); ok {
+		slices.Reverse(positions)
+		backward := slices.Clone(want)
+		slices.Reverse(backward)
+		checkSeq(t, "Backward", it.Backward(), positions, backward)
+	}
+}
+
+// checkSeq percorre seq inteiro comparando com os pares esperados e depois
+// de novo, parando no meio: seq não pode chamar yield depois de um false
+func checkSeq(t *testing.T, name string, seq iter.Seq2[int, int], positions, want []int) {
+	t.Helper()
+	gotPositions, gotValues := []int{}, []int{}
+	for position, value := range seq {
+		gotPositions = append(gotPositions, position)
+		gotValues = append(gotValues, value)
+	}
+	if !slices.Equal(gotPositions, positions) || !slices.Equal(gotValues, want) {
+		t.Fatalf("%s() = posições %v, valores %v; esperado %v e %v", name, gotPositions, gotValues, positions, want)
+	}
+	
+	stop := len(want)/2 + 1
+	calls := 0
+	seq(func(int, int) bool {
+		calls++
+		return calls < stop
+	})
+	if len(want) > 0 && calls != stop {
+		t.Fatalf("%s(): yield chamado %d vezes, mas retornou false na chamada %d", name, calls, stop)
+	}
+}
+
+var numberPattern = regexp.MustCompile(`-?\d+`)
+
+// checkString verifica se String mostra os mesmos números, na mesma ordem, que ToSlice
+// O formato (colchetes, separadores, rótulos) fica livre para cada implementação
+func checkString(t *testing.T, d deque.IDeque) {
+	t.Helper()
+	got := []int{}
+	for _, match := range numberPattern.FindAllString(d.String(), -1) {
+		number, _ := strconv.Atoi(match)
+		got = append(got, number)
+	}
+	if want := d.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("String() = %q não concorda com ToSlice() = %v", d.String(), want)
+	}
+}
@@ -1,9 +1,13 @@
 package deque
 
 import (
-	"errors"
 	"fmt"
+	"iter"
 	"strings"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/links"
 )
 
 // ============================================================================
@@ -23,9 +27,10 @@ type LinkedDequeNode struct {
 // - Uso dinâmico de memória (aloca conforme necessário)
 // - Mantém ponteiros para frente e final para eficiência
 type LinkedListDeque struct {
-	front *LinkedDequeNode // Ponteiro para o primeiro nó
-	rear  *LinkedDequeNode // Ponteiro para o último nó
-	size  int        // Contador de elementos
+	front    *LinkedDequeNode     // Ponteiro para o primeiro nó
+	rear     *LinkedDequeNode     // Ponteiro para o último nó
+	size     int                  // Contador de elementos
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewLinkedListDeque cria uma nova instância de LinkedListDeque
@@ -37,6 +42,13 @@ func NewLinkedListDeque() *LinkedListDeque {
 	}
 }
 
+// SetCounters associa contadores de operações ao deque (nil desliga a contagem)
+// Conta alocações de nós, comparações de busca e saltos de ponteiro
+// (DequeueRear aparece como O(n) em Traversals)
+func (q *LinkedListDeque) SetCounters(counters *instrument.Counters) {
+	q.counters = counters
+}
+
 // ============================================================================
 // MÉTODOS PRINCIPAIS
 // ============================================================================
@@ -44,10 +56,12 @@ func NewLinkedListDeque() *LinkedListDeque {
 // EnqueueFront adiciona elemento no início da fila (comportamento de deque)
 // Complexidade: O(1)
 func (q *LinkedListDeque) EnqueueFront(element int) {
+	defer checkInvariants(q)
 	newNode := &LinkedDequeNode{
 		data: element,
 		next: q.front,
 	}
+	q.counters.AddAllocations(1)
 	
 	if q.IsEmpty() {
 		// Primeiro elemento
@@ -64,10 +78,12 @@ func (q *LinkedListDeque) EnqueueFront(element int) {
 // EnqueueRear adiciona elemento no final da fila
 // Complexidade: O(1)
 func (q *LinkedListDeque) EnqueueRear(element int) {
+	defer checkInvariants(q)
 	newNode := &LinkedDequeNode{
 		data: element,
 		next: nil,
 	}
+	q.counters.AddAllocations(1)
 	
 	if q.IsEmpty() {
 		// Primeiro elemento
@@ -85,8 +101,9 @@ func (q *LinkedListDeque) EnqueueRear(element int) {
 // DequeueFront remove e retorna elemento do início da fila
 // Complexidade: O(1)
 func (q *LinkedListDeque) DequeueFront() (int, error) {
+	defer checkInvariants(q)
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não é possível fazer dequeue")
+		return 0, errDequeueEmpty
 	}
 	
 	value := q.front.data
@@ -104,8 +121,9 @@ func (q *LinkedListDeque) DequeueFront() (int, error) {
 // DequeueRear remove e retorna elemento do final da fila (comportamento de deque)
 // Complexidade: O(n) - precisa percorrer até o penúltimo nó
 func (q *LinkedListDeque) DequeueRear() (int, error) {
+	defer checkInvariants(q)
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não é possível fazer dequeue")
+		return 0, errDequeueEmpty
 	}
 	
 	if q.size == 1 {
@@ -121,6 +139,7 @@ func (q *LinkedListDeque) DequeueRear() (int, error) {
 	current := q.front
 	for current.next != q.rear {
 		current = current.next
+		q.counters.AddTraversals(1)
 	}
 	
 	value := q.rear.data
@@ -135,7 +154,7 @@ func (q *LinkedListDeque) DequeueRear() (int, error) {
 // Complexidade: O(1)
 func (q *LinkedListDeque) Front() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento na frente")
+		return 0, errFrontEmpty
 	}
 	return q.front.data, nil
 }
@@ -144,7 +163,7 @@ func (q *LinkedListDeque) Front() (int, error) {
 // Complexidade: O(1)
 func (q *LinkedListDeque) Rear() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento no final")
+		return 0, errRearEmpty
 	}
 	return q.rear.data, nil
 }
@@ -171,6 +190,7 @@ func (q *LinkedListDeque) IsFull() bool {
 // Clear remove todos os elementos da fila
 // Complexidade: O(1) - apenas redefine ponteiros, GC limpa os nós
 func (q *LinkedListDeque) Clear() {
+	defer checkInvariants(q)
 	q.front = nil
 	q.rear = nil
 	q.size = 0
@@ -201,6 +221,16 @@ func (q *LinkedListDeque) ToSlice() []int {
 	return result
 }
 
+// Links fotografa os nós como estão na memória, a partir do front e do
+// rear, com os ponteiros next reais (ver links.Snapshot)
+// Complexidade: O(n)
+func (q *LinkedListDeque) Links() links.Snapshot[int] {
+	return links.Walk([]*LinkedDequeNode{q.front, q.rear},
+		func(node *LinkedDequeNode) int { return node.data },
+		func(node *LinkedDequeNode) *LinkedDequeNode { return node.next },
+		nil)
+}
+
 // String retorna uma representação em string da fila
 // Complexidade: O(n)
 func (q *LinkedListDeque) String() string {
@@ -234,6 +264,7 @@ func (q *LinkedListDeque) String() string {
 // EnqueueAll adiciona múltiplos elementos de uma vez
 // Complexidade: O(m) onde m é o número de elementos
 func (q *LinkedListDeque) EnqueueAll(elements []int) {
+	defer checkInvariants(q)
 	for _, element := range elements {
 		q.EnqueueRear(element)
 	}
@@ -242,12 +273,13 @@ func (q *LinkedListDeque) EnqueueAll(elements []int) {
 // DequeueMultiple remove e retorna múltiplos elementos do início
 // Complexidade: O(n) onde n é o número de elementos a remover
 func (q *LinkedListDeque) DequeueMultiple(count int) ([]int, error) {
+	defer checkInvariants(q)
 	if count <= 0 {
 		return []int{}, nil
 	}
 	
 	if count > q.size {
-		return nil, fmt.Errorf("não há elementos suficientes: solicitado %d, disponível %d", count, q.size)
+		return nil, errs.NotEnough(count, q.size)
 	}
 	
 	result := make([]int, count)
@@ -264,10 +296,12 @@ func (q *LinkedListDeque) DequeueMultiple(count int) ([]int, error) {
 func (q *LinkedListDeque) Contains(element int) bool {
 	current := q.front
 	for current != nil {
+		q.counters.AddComparisons(1)
 		if current.data == element {
 			return true
 		}
 		current = current.next
+		q.counters.AddTraversals(1)
 	}
 	return false
 }
@@ -280,10 +314,12 @@ func (q *LinkedListDeque) IndexOf(element int) int {
 	index := 0
 	
 	for current != nil {
+		q.counters.AddComparisons(1)
 		if current.data == element {
 			return index
 		}
 		current = current.next
+		q.counters.AddTraversals(1)
 		index++
 	}
 	
@@ -342,6 +378,7 @@ func (q *LinkedListDeque) EqualsLinkedListDeque(other *LinkedListDeque) bool {
 // Reverse inverte a ordem dos elementos na fila
 // Complexidade: O(n)
 func (q *LinkedListDeque) Reverse() {
+	defer checkInvariants(q)
 	if q.size <= 1 {
 		return
 	}
@@ -523,6 +560,7 @@ func (q *LinkedListDeque) DropWhile(predicate func(int) bool) *LinkedListDeque {
 
 // Rotate rotaciona a fila k posições para a esquerda
 func (q *LinkedListDeque) Rotate(k int) {
+	defer checkInvariants(q)
 	if q.IsEmpty() || k <= 0 {
 		return
 	}
@@ -589,13 +627,14 @@ func (q *LinkedListDeque) Merge(other *LinkedListDeque) *LinkedListDeque {
 // GetNth retorna o n-ésimo elemento (0-indexado) sem removê-lo
 func (q *LinkedListDeque) GetNth(n int) (int, error) {
 	if n < 0 || n >= q.size {
-		return 0, fmt.Errorf("índice fora dos limites: %d", n)
+		return 0, errs.Index(n, q.size)
 	}
 	
 	current := q.front
 	for i := 0; i < n; i++ {
 		current = current.next
 	}
+	q.counters.AddTraversals(n)
 	
 	return current.data, nil
 }
@@ -635,4 +674,33 @@ func (q *LinkedListDeque) FilterIDeque(predicate func(int) bool) IDeque {
 // MapIDeque implementa IDeque.Map() retornando IDeque
 func (q *LinkedListDeque) MapIDeque(mapper func(int) int) IDeque {
 	return q.Map(mapper)
-}
\ No newline at end of file
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) do início para o final
+// Não há Backward, pois os nós só apontam para o próximo
+func (q *LinkedListDeque) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		index := 0
+		for current := q.front; current != nil; current = current.next {
+			if !yield(index, current.data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início para o final
+func (q *LinkedListDeque) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for current := q.front; current != nil; current = current.next {
+			if !yield(current.data) {
+				return
+			}
+		}
+	}
+}
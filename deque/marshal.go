@@ -0,0 +1,160 @@
+package deque
+
+import (
+	"fmt"
+
+	"dca3503/serial"
+)
+
+// ============================================================================
+// SERIALIZAÇÃO (JSON, BINÁRIO E GOB)
+// ============================================================================
+
+// Os deques são gravados do início (Front) para o final (Rear), a ordem de
+// ToSlice, em JSON como um array e em binário no formato do pacote serial.
+// Os três deques leem os dados uns dos outros. Unmarshal substitui todo o
+// conteúdo e funciona também com o valor zero (var d Deque); a capacidade
+// não é gravada. O ArrayDeque mantém a política de capacidade e rejeita com
+// errs.ErrFull dados que passem de MaxCapacity
+// Complexidade: Θ(n) em todas as operações
+
+// MarshalJSON implementa json.Marshaler
+func (q *ArrayDeque) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(q.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (q *ArrayDeque) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("ArrayDeque: %w", err)
+	}
+	return q.load(values)
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (q *ArrayDeque) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Deque, q.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (q *ArrayDeque) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Deque, data)
+	if err != nil {
+		return fmt.Errorf("ArrayDeque: %w", err)
+	}
+	return q.load(values)
+}
+
+// load troca o conteúdo pelos valores, reaproveitando o array se couber
+// Os elementos voltam a começar no slot 0
+func (q *ArrayDeque) load(values []int) error {
+	defer checkInvariants(q)
+	if err := q.policy.Validate(len(values)); err != nil {
+		return fmt.Errorf("ArrayDeque: %w", err)
+	}
+	if len(values) > q.capacity || q.capacity <= 0 {
+		q.capacity = q.policy.Clamp(max(len(values), 10)) // Capacidade padrão do construtor
+		q.data = make([]int, q.capacity)
+	}
+	copy(q.data, values)
+	q.front = 0
+	q.size = len(values)
+	q.rear = q.size % q.capacity
+	q.counters.AddMoves(len(values))
+	return nil
+}
+
+// MarshalJSON implementa json.Marshaler
+func (d *Deque) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(d.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (d *Deque) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("Deque: %w", err)
+	}
+	d.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (d *Deque) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Deque, d.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (d *Deque) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Deque, data)
+	if err != nil {
+		return fmt.Errorf("Deque: %w", err)
+	}
+	d.load(values)
+	return nil
+}
+
+// load troca o conteúdo por uma nova cadeia duplamente ligada com os valores
+func (d *Deque) load(values []int) {
+	defer checkInvariants(d)
+	d.front, d.rear = nil, nil
+	for _, value := range values {
+		node := &DequeNode{data: value, prev: d.rear}
+		if d.rear == nil {
+			d.front = node
+		} else {
+			d.rear.next = node
+		}
+		d.rear = node
+	}
+	d.size = len(values)
+	d.counters.AddAllocations(len(values))
+}
+
+// MarshalJSON implementa json.Marshaler
+func (q *LinkedListDeque) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(q.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (q *LinkedListDeque) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("LinkedListDeque: %w", err)
+	}
+	q.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (q *LinkedListDeque) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Deque, q.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (q *LinkedListDeque) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Deque, data)
+	if err != nil {
+		return fmt.Errorf("LinkedListDeque: %w", err)
+	}
+	q.load(values)
+	return nil
+}
+
+// load troca o conteúdo por uma nova cadeia de nós com os valores
+func (q *LinkedListDeque) load(values []int) {
+	defer checkInvariants(q)
+	q.front, q.rear = nil, nil
+	for _, value := range values {
+		node := &LinkedDequeNode{data: value}
+		if q.rear == nil {
+			q.front = node
+		} else {
+			q.rear.next = node
+		}
+		q.rear = node
+	}
+	q.size = len(values)
+	q.counters.AddAllocations(len(values))
+}
@@ -0,0 +1,138 @@
+package deque
+
+import "fmt"
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// Validate verifica as invariantes internas do ArrayDeque
+// - capacity == len(data) e capacity > 0
+// - 0 <= front < capacity e 0 <= size <= capacity
+// - rear == (front + size) % capacity
+// - capacity <= MaxCapacity da política, quando houver limite
+// Complexidade: Θ(1)
+func (q *ArrayDeque) Validate() error {
+	if q.capacity != len(q.data) {
+		return fmt.Errorf("ArrayDeque: capacity %d diferente do array interno (%d)", q.capacity, len(q.data))
+	}
+	if q.capacity <= 0 {
+		return fmt.Errorf("ArrayDeque: capacity deveria ser positiva (%d)", q.capacity)
+	}
+	if q.front < 0 || q.front >= q.capacity {
+		return fmt.Errorf("ArrayDeque: front %d fora de [0, %d)", q.front, q.capacity)
+	}
+	if q.size < 0 || q.size > q.capacity {
+		return fmt.Errorf("ArrayDeque: size %d fora de [0, %d]", q.size, q.capacity)
+	}
+	if want := (q.front + q.size) % q.capacity; q.rear != want {
+		return fmt.Errorf("ArrayDeque: rear %d, esperado (front+size)%%capacity = %d", q.rear, want)
+	}
+	if limit := q.policy.Clamp(q.capacity); limit < q.capacity {
+		return fmt.Errorf("ArrayDeque: capacity %d acima do máximo da política (%d)", q.capacity, limit)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas do Deque (duplamente ligado)
+// - front, rear e size concordam sobre o deque estar vazio
+// - front.prev == nil e rear.next == nil
+// - para cada nó, node.next.prev == node (percursos nos dois sentidos concordam)
+// - o percurso para frente termina em rear e o para trás em front, ambos com size nós
+// Complexidade: O(n)
+func (d *Deque) Validate() error {
+	if d.size < 0 {
+		return fmt.Errorf("Deque: size negativo (%d)", d.size)
+	}
+	if (d.front == nil) != (d.rear == nil) || (d.front == nil) != (d.size == 0) {
+		return fmt.Errorf("Deque: front nil = %v, rear nil = %v com size %d",
+			d.front == nil, d.rear == nil, d.size)
+	}
+	if d.front == nil {
+		return nil
+	}
+	if d.front.prev != nil {
+		return fmt.Errorf("Deque: front.prev deveria ser nil")
+	}
+	if d.rear.next != nil {
+		return fmt.Errorf("Deque: rear.next deveria ser nil")
+	}
+	
+	count := 1
+	current := d.front
+	for current.next != nil {
+		if current.next.prev != current {
+			return fmt.Errorf("Deque: na posição %d, next.prev não aponta de volta", count-1)
+		}
+		current = current.next
+		count++
+		if count > d.size {
+			return fmt.Errorf("Deque: mais nós que size (%d) ou ciclo em next", d.size)
+		}
+	}
+	if current != d.rear {
+		return fmt.Errorf("Deque: o percurso para frente não termina em rear")
+	}
+	if count != d.size {
+		return fmt.Errorf("Deque: size %d, mas o percurso para frente tem %d nós", d.size, count)
+	}
+	
+	count = 1
+	for current = d.rear; current.prev != nil; current = current.prev {
+		count++
+		if count > d.size {
+			return fmt.Errorf("Deque: mais nós que size (%d) ou ciclo em prev", d.size)
+		}
+	}
+	if current != d.front {
+		return fmt.Errorf("Deque: o percurso para trás não termina em front")
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas do LinkedListDeque
+// - front, rear e size concordam sobre o deque estar vazio
+// - rear.next == nil
+// - a cadeia a partir de front tem exatamente size nós e termina em rear
+// Complexidade: O(n)
+func (q *LinkedListDeque) Validate() error {
+	if (q.front == nil) != (q.rear == nil) || (q.front == nil) != (q.size == 0) {
+		return fmt.Errorf("LinkedListDeque: front nil = %v, rear nil = %v com size %d",
+			q.front == nil, q.rear == nil, q.size)
+	}
+	if q.front == nil {
+		return nil
+	}
+	if q.rear.next != nil {
+		return fmt.Errorf("LinkedListDeque: rear.next deveria ser nil")
+	}
+	
+	count := 1
+	current := q.front
+	for current.next != nil {
+		current = current.next
+		count++
+		if count > q.size {
+			return fmt.Errorf("LinkedListDeque: mais nós que size (%d) ou ciclo", q.size)
+		}
+	}
+	if current != q.rear {
+		return fmt.Errorf("LinkedListDeque: a cadeia não termina em rear")
+	}
+	if count != q.size {
+		return fmt.Errorf("LinkedListDeque: size %d, mas a cadeia tem %d nós", q.size, count)
+	}
+	return nil
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug);
+// uma invariante quebrada vira panic, apontando a operação culpada no stack trace
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
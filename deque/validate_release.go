@@ -0,0 +1,6 @@
+//go:build !debug
+
+package deque
+
+// debugValidate desliga checkInvariants (compilação normal)
+const debugValidate = false
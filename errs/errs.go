@@ -0,0 +1,135 @@
+// Package errs define os erros compartilhados pelas estruturas de dados.
+//
+// As operações de listas, pilhas, filas, deques e árvores retornam erros
+// que podem ser distinguidos sem comparar mensagens:
+//
+//	if errors.Is(err, errs.ErrEmpty) { ... }          // estrutura vazia
+//	if errors.Is(err, errs.ErrFull) { ... }           // capacidade esgotada
+//	if errors.Is(err, errs.ErrIndexOutOfRange) { ... } // índice inválido
+//
+//	var indexErr *errs.IndexError
+//	if errors.As(err, &indexErr) {
+//		fmt.Println(indexErr.Index, indexErr.Size)
+//	}
+//
+// As mensagens saem em português por padrão; SetLanguage(English) troca o
+// idioma de todos os erros, inclusive dos já criados, porque o texto é
+// escolhido na hora em que Error() é chamado.
+package errs
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ============================================================================
+// IDIOMA DAS MENSAGENS
+// ============================================================================
+
+// Language é o idioma das mensagens de erro
+type Language int32
+
+const (
+	Portuguese Language = iota // Padrão
+	English
+)
+
+// language guarda o idioma atual; atômico para SetLanguage ser seguro
+// mesmo com erros sendo formatados em outras goroutines
+var language atomic.Int32
+
+// SetLanguage escolhe o idioma das mensagens de erro
+func SetLanguage(l Language) {
+	language.Store(int32(l))
+}
+
+// CurrentLanguage retorna o idioma atual das mensagens de erro
+func CurrentLanguage() Language {
+	return Language(language.Load())
+}
+
+// Message escolhe, entre as duas versões de um texto, a do idioma atual
+func Message(pt, en string) string {
+	if CurrentLanguage() == English {
+		return en
+	}
+	return pt
+}
+
+// ============================================================================
+// ERROS COMPARTILHADOS
+// ============================================================================
+
+// sentinel é um erro identificado pelo endereço, com texto nos dois idiomas
+type sentinel struct {
+	pt, en string
+}
+
+func (e *sentinel) Error() string {
+	return Message(e.pt, e.en)
+}
+
+// Define cria um novo erro sentinela com a mensagem nos dois idiomas
+// Use para erros específicos de um pacote, como queue.ErrQueueClosed
+func Define(pt, en string) error {
+	return &sentinel{pt, en}
+}
+
+// Erros sentinela: compare com errors.Is
+var (
+	ErrEmpty           = Define("estrutura vazia", "empty structure")
+	ErrFull            = Define("estrutura cheia", "full structure")
+	ErrIndexOutOfRange = Define("índice fora dos limites", "index out of range")
+)
+
+// detailed é um erro com mensagem própria que também é um erro sentinela
+type detailed struct {
+	kind   error
+	pt, en string
+	args   []any
+}
+
+func (e *detailed) Error() string {
+	return fmt.Sprintf(Message(e.pt, e.en), e.args...)
+}
+
+func (e *detailed) Unwrap() error {
+	return e.kind
+}
+
+// New cria um erro com a mensagem nos dois idiomas (formatos de fmt com os
+// mesmos argumentos) para o qual errors.Is(err, kind) é verdadeiro
+// kind pode ser nil quando a mensagem só precisa dos dois idiomas
+// Exemplo: New(ErrEmpty, "pilha vazia: não é possível fazer pop", "empty stack: cannot pop")
+func New(kind error, pt, en string, args ...any) error {
+	return &detailed{kind, pt, en, args}
+}
+
+// NotEnough é o erro de operações em lote (PopMultiple, DequeueMultiple)
+// que pedem mais elementos do que a estrutura tem; é um ErrEmpty
+func NotEnough(requested, available int) error {
+	return New(ErrEmpty, "não há elementos suficientes: solicitado %d, disponível %d",
+		"not enough elements: requested %d, available %d", requested, available)
+}
+
+// IndexError é o erro de um índice (ou posição) fora dos limites
+// errors.Is(err, ErrIndexOutOfRange) é verdadeiro, e errors.As recupera o
+// índice recebido e o tamanho da estrutura naquele momento
+type IndexError struct {
+	Index int // Índice recebido
+	Size  int // Tamanho da estrutura
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf(Message("índice %d fora dos limites (tamanho %d)", "index %d out of range (size %d)"), e.Index, e.Size)
+}
+
+// Is faz errors.Is(err, ErrIndexOutOfRange) reconhecer o IndexError
+func (e *IndexError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
+
+// Index cria um IndexError; atalho para &IndexError{index, size}
+func Index(index, size int) error {
+	return &IndexError{Index: index, Size: size}
+}
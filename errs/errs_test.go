@@ -0,0 +1,104 @@
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/serial"
+	"dca3503/stack"
+)
+
+// english troca o idioma durante o teste e restaura o anterior no fim
+func english(t *testing.T) {
+	previous := errs.CurrentLanguage()
+	errs.SetLanguage(errs.English)
+	t.Cleanup(func() { errs.SetLanguage(previous) })
+}
+
+func TestMessagesFollowLanguage(t *testing.T) {
+	err := errs.New(errs.ErrEmpty, "pilha vazia: %d", "empty stack: %d", 7)
+	index := errs.Index(5, 3)
+	if err.Error() != "pilha vazia: 7" || index.Error() != "índice 5 fora dos limites (tamanho 3)" {
+		t.Errorf("português: %q, %q", err, index)
+	}
+	
+	// Erros já criados também mudam de idioma
+	english(t)
+	if err.Error() != "empty stack: 7" || index.Error() != "index 5 out of range (size 3)" {
+		t.Errorf("inglês: %q, %q", err, index)
+	}
+	if errs.ErrFull.Error() != "full structure" {
+		t.Errorf("ErrFull em inglês: %q", errs.ErrFull)
+	}
+}
+
+func TestIsAndAs(t *testing.T) {
+	wrapped := fmt.Errorf("camada externa: %w", errs.Index(-1, 4))
+	if !errors.Is(wrapped, errs.ErrIndexOutOfRange) || errors.Is(wrapped, errs.ErrEmpty) {
+		t.Errorf("errors.Is com IndexError embrulhado: %v", wrapped)
+	}
+	var indexErr *errs.IndexError
+	if !errors.As(wrapped, &indexErr) || indexErr.Index != -1 || indexErr.Size != 4 {
+		t.Errorf("errors.As: %+v", indexErr)
+	}
+	
+	notEnough := errs.NotEnough(5, 2)
+	if !errors.Is(notEnough, errs.ErrEmpty) || errors.Is(notEnough, errs.ErrFull) {
+		t.Errorf("NotEnough deveria ser só ErrEmpty: %v", notEnough)
+	}
+	
+	// Sentinelas com o mesmo texto continuam distintas
+	own := errs.Define("estrutura vazia", "empty structure")
+	if errors.Is(own, errs.ErrEmpty) || own.Error() != errs.ErrEmpty.Error() {
+		t.Errorf("Define deveria criar uma sentinela nova: %v", own)
+	}
+	if errors.Unwrap(errs.New(nil, "só texto", "text only")) != nil {
+		t.Error("New com kind nil não deveria embrulhar nada")
+	}
+}
+
+func TestStructuresReturnSharedErrors(t *testing.T) {
+	english(t)
+	
+	_, err := stack.NewLinkedStack().Pop()
+	if !errors.Is(err, errs.ErrEmpty) || err.Error() != "empty stack: cannot pop" {
+		t.Errorf("LinkedStack.Pop: %v", err)
+	}
+	_, err = queue.NewArrayQueue(4).DequeueMultiple(2)
+	if !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("ArrayQueue.DequeueMultiple: %v", err)
+	}
+	
+	l := list.NewArrayList[int](4)
+	l.Add(1)
+	var indexErr *errs.IndexError
+	if err := l.Remove(3); !errors.As(err, &indexErr) || *indexErr != (errs.IndexError{Index: 3, Size: 1}) {
+		t.Errorf("ArrayList.Remove(3): %v", err)
+	}
+}
+
+func TestMessagesOutsideStructuresFollowLanguage(t *testing.T) {
+	english(t)
+	
+	if _, err := stack.EvaluatePostfix([]string{"1", "0", "/"}); err == nil || err.Error() != `token 3 ("/"): division by zero` {
+		t.Errorf("EvaluatePostfix: %v", err)
+	}
+	if _, err := queue.OpenDurableQueue(t.TempDir(), queue.DurableConfig{SegmentSize: -1}); err == nil || !strings.Contains(err.Error(), "invalid segment size: -1") {
+		t.Errorf("OpenDurableQueue: %v", err)
+	}
+	
+	// Erros de decodificação embrulham a sentinela, que também muda de idioma
+	data, _ := serial.Marshal(serial.Stack, []int64{1, 200})
+	_, err := serial.Unmarshal[int8](serial.Stack, data)
+	if !errors.Is(err, serial.ErrMismatch) || err.Error() != "element 1: data of another type: 200 does not fit in int8" {
+		t.Errorf("serial.Unmarshal: %v", err)
+	}
+	if _, err := serial.Unmarshal[string](serial.List, data); !errors.Is(err, serial.ErrMismatch) || err.Error() != "data of another type: written by a stack, read as list" {
+		t.Errorf("serial.Unmarshal de outra família: %v", err)
+	}
+}
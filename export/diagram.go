@@ -0,0 +1,147 @@
+// Package export desenha o estado interno das estruturas de dados como
+// Graphviz DOT ou Mermaid, para aulas e depuração.
+//
+// Cada estrutura vira um Diagram: uma sequência de nós ligados (listas,
+// pilhas e filas encadeadas, com as setas next/prev) ou um array (ArrayList,
+// ArrayStack e os buffers circulares de ArrayQueue e ArrayDeque, com as
+// posições vazias e os marcadores front/rear). Os nós ligados são desenhados
+// a partir dos ponteiros reais (ver pacote links), não da ordem de ToSlice:
+// um ciclo ou um prev errado aparece no desenho. O mesmo Diagram é
+// exportado nos dois formatos:
+//
+//	d := export.FromDoublyLinkedList(l).Highlight(l.IndexOfNode(l.FindNode(30)))
+//	os.WriteFile("lista.dot", []byte(d.DOT()), 0o644) // dot -Tsvg lista.dot
+//	fmt.Println(d.Mermaid())                          // cole num bloco ```mermaid
+package export
+
+import (
+	"fmt"
+	"slices"
+	
+	"dca3503/links"
+)
+
+// ============================================================================
+// MODELO DO DESENHO
+// ============================================================================
+
+// cell é um nó de uma estrutura encadeada ou uma posição de um array
+type cell struct {
+	label       string // Valor formatado (vazio nas posições livres do array)
+	position    int    // Posição lógica do elemento (0 = início/topo); -1 se vazia
+	highlighted bool
+}
+
+// edge é um ponteiro entre nós; to == toNil aponta para nil
+type edge struct {
+	from, to int
+	label    string // "next" ou "prev"
+}
+
+// marker é um ponteiro da estrutura (head, tail, front, rear, top) para um
+// nó ou posição; cell == toNil aponta para nil
+type marker struct {
+	name string
+	cell int
+}
+
+// toNil é o destino de ponteiros nulos (o mesmo valor de links.Nil)
+const toNil = links.Nil
+
+// Diagram é o desenho de uma estrutura, pronto para exportar com DOT ou Mermaid
+type Diagram struct {
+	title   string
+	array   bool // Posições de um array em vez de nós ligados
+	cells   []cell
+	edges   []edge
+	markers []marker
+}
+
+// newChain cria o desenho dos nós ligados fotografados em snapshot, com as
+// setas next (e prev, se houver) que existem de verdade e um marcador para
+// cada raiz: names[i] é o nome de snapshot.Roots[i]
+// Só os nós da cadeia a partir da primeira raiz têm posição lógica; os
+// demais (alcançados só por outra raiz ou por prev) não podem ser destacados
+func newChain[T any](title string, snapshot links.Snapshot[T], names ...string) *Diagram {
+	d := &Diagram{title: title}
+	for i, value := range snapshot.Values {
+		position := i
+		if i >= snapshot.Chain {
+			position = -1
+		}
+		d.cells = append(d.cells, cell{label: fmt.Sprint(value), position: position})
+	}
+	for i := range snapshot.Values {
+		d.edges = append(d.edges, edge{i, snapshot.Next[i], "next"}) // links.Nil == toNil
+		if snapshot.Prev != nil {
+			d.edges = append(d.edges, edge{i, snapshot.Prev[i], "prev"})
+		}
+	}
+	for i, name := range names {
+		d.mark(name, snapshot.Roots[i])
+	}
+	return d
+}
+
+// newArray cria o desenho de um array com as posições físicas slots
+// positions[s] é a posição lógica guardada no slot s, ou -1 se estiver vazio
+func newArray[T any](title string, slots []T, positions []int) *Diagram {
+	d := &Diagram{title: title, array: true}
+	for s, value := range slots {
+		c := cell{position: positions[s]}
+		if c.position >= 0 {
+			c.label = fmt.Sprint(value)
+		}
+		d.cells = append(d.cells, c)
+	}
+	return d
+}
+
+// circularPositions calcula as posições lógicas de um buffer circular:
+// o slot front guarda a posição 0, front+1 a posição 1 e assim por diante
+func circularPositions(capacity, front, size int) []int {
+	positions := make([]int, capacity)
+	for s := range positions {
+		positions[s] = -1
+	}
+	for i := 0; i < size; i++ {
+		positions[(front+i)%capacity] = i
+	}
+	return positions
+}
+
+// mark adiciona um marcador apontando para a célula (toNil = nil)
+func (d *Diagram) mark(name string, cell int) *Diagram {
+	d.markers = append(d.markers, marker{name, cell})
+	return d
+}
+
+// ============================================================================
+// PERSONALIZAÇÃO
+// ============================================================================
+
+// Highlight destaca os elementos nas posições lógicas dadas, na mesma ordem
+// de ToSlice da estrutura (0 = início da lista/fila, topo da pilha)
+// Posições inexistentes (como o -1 de uma busca sem sucesso) são ignoradas.
+// Para destacar o resultado de GetMiddle use MiddleIndex, que dá a posição
+// certa em cada lista (com tamanho par, LinkedList para no segundo dos dois
+// do meio e DoublyLinkedList no primeiro); para um nó de FindNode, IndexOfNode
+func (d *Diagram) Highlight(positions ...int) *Diagram {
+	for i := range d.cells {
+		if d.cells[i].position >= 0 && slices.Contains(positions, d.cells[i].position) {
+			d.cells[i].highlighted = true
+		}
+	}
+	return d
+}
+
+// WithTitle troca o título do desenho (por padrão, o nome do tipo)
+func (d *Diagram) WithTitle(title string) *Diagram {
+	d.title = title
+	return d
+}
+
+// Title retorna o título do desenho
+func (d *Diagram) Title() string {
+	return d.title
+}
@@ -0,0 +1,109 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"strings"
+)
+
+// ============================================================================
+// GRAPHVIZ DOT
+// ============================================================================
+
+// Cores usadas nos dois formatos
+const (
+	highlightColor = "#ffd700" // Elementos destacados
+	emptyColor     = "#eeeeee" // Posições livres do array
+	markerColor    = "#1f4e9c" // head, tail, front, rear, top
+)
+
+// DOT retorna o desenho na linguagem do Graphviz
+// Nós ligados ficam da esquerda para a direita, com next em linha cheia e
+// prev tracejado; arrays viram uma tabela com o índice de cada posição
+// embaixo e os marcadores apontando de cima
+// Para gerar a imagem: dot -Tsvg estrutura.dot -o estrutura.svg
+func (d *Diagram) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph estrutura {\n")
+	fmt.Fprintf(&b, "\tlabel=%s;\n\tlabelloc=t;\n", dotQuote(d.title))
+	b.WriteString("\tnode [fontname=\"Helvetica\"];\n")
+	b.WriteString("\tedge [fontname=\"Helvetica\", fontsize=10];\n")
+	if d.array {
+		d.dotArray(&b)
+	} else {
+		d.dotChain(&b)
+	}
+	
+	for _, m := range d.markers {
+		fmt.Fprintf(&b, "\tm_%s [shape=plaintext, label=%s, fontcolor=%q];\n", m.name, dotQuote(m.name), markerColor)
+		target := "nil_" + m.name
+		switch {
+		case m.cell == toNil:
+			fmt.Fprintf(&b, "\t%s [shape=plaintext, label=\"nil\"];\n", target)
+		case d.array:
+			target = fmt.Sprintf("array:s%d:n", m.cell)
+		default:
+			target = fmt.Sprintf("n%d", m.cell)
+		}
+		fmt.Fprintf(&b, "\tm_%s -> %s [color=%q, penwidth=2];\n", m.name, target, markerColor)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotChain escreve os nós ligados e os ponteiros entre eles
+func (d *Diagram) dotChain(b *strings.Builder) {
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+	for i, c := range d.cells {
+		style := ""
+		if c.highlighted {
+			style = fmt.Sprintf(", style=\"rounded,filled\", fillcolor=%q", highlightColor)
+		}
+		fmt.Fprintf(b, "\tn%d [label=%s%s];\n", i, dotQuote(c.label), style)
+	}
+	for i, e := range d.edges {
+		target := fmt.Sprintf("n%d", e.to)
+		if e.to == toNil {
+			// Um nil por ponteiro: um só nó compartilhado embolaria o desenho
+			target = fmt.Sprintf("nil%d", i)
+			fmt.Fprintf(b, "\t%s [shape=plaintext, label=\"nil\"];\n", target)
+		}
+		style := ""
+		if e.label == "prev" {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(b, "\tn%d -> %s [label=%q%s];\n", e.from, target, e.label, style)
+	}
+}
+
+// dotArray escreve o array como uma tabela HTML do Graphviz: uma linha com
+// os valores (porta s<i> em cada célula) e outra com os índices
+func (d *Diagram) dotArray(b *strings.Builder) {
+	b.WriteString("\tarray [shape=plaintext, label=<\n")
+	b.WriteString("\t\t<table border=\"0\" cellborder=\"1\" cellspacing=\"0\" cellpadding=\"6\">\n")
+	b.WriteString("\t\t<tr>")
+	for s, c := range d.cells {
+		label, color := html.EscapeString(c.label), ""
+		switch {
+		case c.highlighted:
+			color = fmt.Sprintf(" bgcolor=%q", highlightColor)
+		case c.position < 0:
+			label, color = "∅", fmt.Sprintf(" bgcolor=%q", emptyColor)
+		}
+		fmt.Fprintf(b, "<td port=\"s%d\"%s>%s</td>", s, color, label)
+	}
+	if len(d.cells) == 0 {
+		b.WriteString("<td>capacidade 0</td>")
+	}
+	b.WriteString("</tr>\n\t\t<tr>")
+	for s := range d.cells {
+		fmt.Fprintf(b, "<td border=\"0\"><font point-size=\"10\">%d</font></td>", s)
+	}
+	b.WriteString("</tr>\n\t\t</table>\n\t>];\n")
+}
+
+// dotQuote coloca o texto entre aspas escapando o que o DOT exige
+func dotQuote(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(text) + `"`
+}
@@ -0,0 +1,197 @@
+package export_test
+
+import (
+	"strings"
+	"testing"
+	
+	"dca3503/deque"
+	"dca3503/export"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+func TestLinkedListDOT(t *testing.T) {
+	l := list.NewLinkedList[int]()
+	l.AddAll([]int{10, 20})
+	got := export.FromLinkedList(l).Highlight(1).DOT()
+	want := `digraph estrutura {
+	label="LinkedList";
+	labelloc=t;
+	node [fontname="Helvetica"];
+	edge [fontname="Helvetica", fontsize=10];
+	rankdir=LR;
+	node [shape=box, style=rounded];
+	n0 [label="10"];
+	n1 [label="20", style="rounded,filled", fillcolor="#ffd700"];
+	n0 -> n1 [label="next"];
+	nil1 [shape=plaintext, label="nil"];
+	n1 -> nil1 [label="next"];
+	m_head [shape=plaintext, label="head", fontcolor="#1f4e9c"];
+	m_head -> n0 [color="#1f4e9c", penwidth=2];
+}
+`
+	if got != want {
+		t.Errorf("DOT():\n%s\nesperado:\n%s", got, want)
+	}
+}
+
+func TestDoublyLinkedListMermaid(t *testing.T) {
+	l := list.NewDoublyLinkedList[string]()
+	l.AddAll([]string{"a", `b"c`, "d"})
+	d := export.FromDoublyLinkedList(l).Highlight(l.IndexOfNode(l.FindNode("d")))
+	got := d.Mermaid()
+	for _, line := range []string{
+		`title: "DoublyLinkedList"`,
+		"flowchart LR",
+		`n1["b#quot;c"]`,
+		"n0 -->|next| n1",
+		"n1 -.->|prev| n0",
+		"n0 -.->|prev| nil1",
+		"m_tail ==> n2",
+		"class n2 destaque",
+	} {
+		if !strings.Contains(got, line+"\n") {
+			t.Errorf("Mermaid() sem a linha %q:\n%s", line, got)
+		}
+	}
+	if strings.Contains(got, " vazio\n") || strings.Contains(got, "class n0 ") {
+		t.Errorf("Mermaid() com classes a mais:\n%s", got)
+	}
+}
+
+// GetMiddle para em posições diferentes nas duas listas com tamanho par;
+// MiddleIndex destaca o nó que ele devolveu
+func TestHighlightMiddle(t *testing.T) {
+	doubly := list.NewDoublyLinkedList[int]()
+	doubly.AddAll([]int{10, 20, 30, 40})
+	middle, _ := doubly.GetMiddle()
+	d := export.FromDoublyLinkedList(doubly).Highlight(doubly.MiddleIndex())
+	if dot := d.DOT(); middle != 20 || !strings.Contains(dot, `n1 [label="20", style="rounded,filled", fillcolor="#ffd700"];`) || strings.Contains(dot, `n2 [label="30", style`) {
+		t.Errorf("GetMiddle() = %d, DOT():\n%s", middle, dot)
+	}
+	if mermaid := d.Mermaid(); !strings.Contains(mermaid, "class n1 destaque\n") || strings.Contains(mermaid, "class n2 ") {
+		t.Errorf("Mermaid():\n%s", mermaid)
+	}
+	
+	for n := 0; n <= 7; n++ {
+		linked := list.NewLinkedList[int]()
+		doubly := list.NewDoublyLinkedList[int]()
+		for i := 0; i < n; i++ {
+			linked.Add(i * 10)
+			doubly.Add(i * 10)
+		}
+		if n == 0 {
+			if linked.MiddleIndex() != -1 || doubly.MiddleIndex() != -1 {
+				t.Errorf("MiddleIndex de lista vazia: %d e %d", linked.MiddleIndex(), doubly.MiddleIndex())
+			}
+			continue
+		}
+		if middle, _ := linked.GetMiddle(); middle != linked.MiddleIndex()*10 {
+			t.Errorf("LinkedList com %d: GetMiddle() = %d, MiddleIndex() = %d", n, middle, linked.MiddleIndex())
+		}
+		if middle, _ := doubly.GetMiddle(); middle != doubly.MiddleIndex()*10 {
+			t.Errorf("DoublyLinkedList com %d: GetMiddle() = %d, MiddleIndex() = %d", n, middle, doubly.MiddleIndex())
+		}
+	}
+}
+
+func TestCircularBuffer(t *testing.T) {
+	q := queue.NewArrayQueue(4)
+	for i := 1; i <= 4; i++ {
+		q.Enqueue(i)
+	}
+	q.Dequeue()
+	q.Dequeue()
+	q.Enqueue(5) // Dá a volta: slots [5 ∅ 3 4], front = 2, rear = 1
+	d := export.FromArrayQueue(q).Highlight(0, 2, 99)
+	
+	dot := d.DOT()
+	for _, fragment := range []string{
+		`<td port="s0" bgcolor="#ffd700">5</td>`,
+		`<td port="s1" bgcolor="#eeeeee">∅</td>`,
+		`<td port="s2" bgcolor="#ffd700">3</td>`,
+		`<td port="s3">4</td>`,
+		"m_front -> array:s2:n",
+		"m_rear -> array:s1:n",
+	} {
+		if !strings.Contains(dot, fragment) {
+			t.Errorf("DOT() sem %q:\n%s", fragment, dot)
+		}
+	}
+	mermaid := d.Mermaid()
+	for _, line := range []string{`s1["[1] ∅"]`, "s0 ~~~ s1", "m_front ==> s2", "class s0,s2 destaque", "class s1 vazio"} {
+		if !strings.Contains(mermaid, line+"\n") {
+			t.Errorf("Mermaid() sem a linha %q:\n%s", line, mermaid)
+		}
+	}
+	
+	// Deque cheio: front e rear apontam para a mesma posição
+	full := deque.NewArrayDeque(2)
+	full.EnqueueRear(1)
+	full.EnqueueFront(0) // front volta para o slot 1: [1 0]
+	dot = export.FromArrayDeque(full).DOT()
+	if !strings.Contains(dot, "m_front -> array:s1:n") || !strings.Contains(dot, "m_rear -> array:s1:n") {
+		t.Errorf("ArrayDeque cheio:\n%s", dot)
+	}
+}
+
+func TestEmptyStructuresPointToNil(t *testing.T) {
+	diagrams := map[string]*export.Diagram{
+		"LinkedList":  export.FromLinkedList(list.NewLinkedList[int]()),
+		"LinkedStack": export.FromLinkedStack(stack.NewLinkedStack()),
+		"LinkedQueue": export.FromLinkedQueue(queue.NewLinkedQueue()),
+		"Deque":       export.FromDeque(deque.NewDeque()),
+		"ArrayStack":  export.FromArrayStack(stack.NewArrayStack(3)),
+	}
+	for name, d := range diagrams {
+		if !strings.Contains(d.DOT(), `[shape=plaintext, label="nil"]`) || !strings.Contains(d.Mermaid(), "((nil))") {
+			t.Errorf("%s vazia: marcadores deveriam apontar para nil\n%s", name, d.DOT())
+		}
+	}
+}
+
+func TestChainDrawsRealPointers(t *testing.T) {
+	d := deque.NewDeque()
+	d.EnqueueRear(2)
+	d.EnqueueFront(1)
+	d.EnqueueRear(3)
+	got := export.FromDeque(d).Highlight(2).Mermaid()
+	for _, line := range []string{
+		"n0 -->|next| n1",
+		"n1 -.->|prev| n0",
+		"n2 -.->|prev| n1",
+		"m_front ==> n0",
+		"m_rear ==> n2",
+		"class n2 destaque",
+	} {
+		if !strings.Contains(got, line+"\n") {
+			t.Errorf("Mermaid() sem a linha %q:\n%s", line, got)
+		}
+	}
+	
+	// Sem prev nas estruturas simples; rear aponta para o último nó da cadeia
+	q := queue.NewLinkedQueue()
+	q.EnqueueAll([]int{4, 5})
+	dot := export.FromLinkedQueue(q).DOT()
+	if strings.Contains(dot, "prev") || !strings.Contains(dot, "m_rear -> n1") || !strings.Contains(dot, "n1 -> nil1") {
+		t.Errorf("LinkedQueue:\n%s", dot)
+	}
+}
+
+func TestStackPositionsCountFromTop(t *testing.T) {
+	s := stack.NewArrayStack(4)
+	s.PushAll([]int{7, 8, 9})
+	got := export.FromArrayStack(s).Highlight(0).WithTitle("pilha").Mermaid()
+	for _, line := range []string{`title: "pilha"`, `s2["[2] 9"]`, "m_top ==> s2", "class s2 destaque", "class s3 vazio"} {
+		if !strings.Contains(got, line+"\n") {
+			t.Errorf("Mermaid() sem a linha %q:\n%s", line, got)
+		}
+	}
+	
+	arrayList := list.NewArrayList[string](3)
+	arrayList.Add("<x>")
+	if dot := export.FromArrayList(arrayList).DOT(); !strings.Contains(dot, `<td port="s0">&lt;x&gt;</td>`) {
+		t.Errorf("valor não escapado na tabela HTML:\n%s", dot)
+	}
+}
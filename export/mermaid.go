@@ -0,0 +1,114 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ============================================================================
+// MERMAID
+// ============================================================================
+
+// Mermaid retorna o desenho como um flowchart do Mermaid, que GitHub,
+// GitLab e muitos editores de Markdown desenham direto de um bloco
+// ```mermaid
+// next é uma seta cheia e prev uma pontilhada; os marcadores são setas
+// grossas; destaques e posições vazias usam as classes destaque e vazio
+func (d *Diagram) Mermaid() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "---\ntitle: %s\n---\n", strconv.Quote(d.title)) // Entre aspas: o YAML não aceita ":" solto
+	if d.array {
+		d.mermaidArray(&b)
+	} else {
+		d.mermaidChain(&b)
+	}
+	
+	for _, m := range d.markers {
+		fmt.Fprintf(&b, "    m_%s([%s])\n", m.name, mermaidQuote(m.name))
+		target := d.mermaidID(m.cell)
+		if m.cell == toNil {
+			target = "nil_" + m.name
+			fmt.Fprintf(&b, "    %s((nil))\n", target)
+		}
+		fmt.Fprintf(&b, "    m_%s ==> %s\n", m.name, target)
+	}
+	
+	var highlighted, empty []string
+	for i, c := range d.cells {
+		switch {
+		case c.highlighted:
+			highlighted = append(highlighted, d.mermaidID(i))
+		case c.position < 0:
+			empty = append(empty, d.mermaidID(i))
+		}
+	}
+	fmt.Fprintf(&b, "    classDef destaque fill:%s,stroke:#b8860b,stroke-width:2px\n", highlightColor)
+	fmt.Fprintf(&b, "    classDef vazio fill:%s,stroke-dasharray:3 3,color:#999999\n", emptyColor)
+	fmt.Fprintf(&b, "    classDef marcador fill:none,stroke:none,color:%s\n", markerColor)
+	if len(highlighted) > 0 {
+		fmt.Fprintf(&b, "    class %s destaque\n", strings.Join(highlighted, ","))
+	}
+	if len(empty) > 0 {
+		fmt.Fprintf(&b, "    class %s vazio\n", strings.Join(empty, ","))
+	}
+	if len(d.markers) > 0 {
+		names := make([]string, len(d.markers))
+		for i, m := range d.markers {
+			names[i] = "m_" + m.name
+		}
+		fmt.Fprintf(&b, "    class %s marcador\n", strings.Join(names, ","))
+	}
+	return b.String()
+}
+
+// mermaidChain escreve os nós ligados e os ponteiros entre eles
+func (d *Diagram) mermaidChain(b *strings.Builder) {
+	b.WriteString("flowchart LR\n")
+	for i, c := range d.cells {
+		fmt.Fprintf(b, "    n%d[\"%s\"]\n", i, mermaidQuote(c.label))
+	}
+	for i, e := range d.edges {
+		target := fmt.Sprintf("n%d", e.to)
+		if e.to == toNil {
+			target = fmt.Sprintf("nil%d", i)
+			fmt.Fprintf(b, "    %s((nil))\n", target)
+		}
+		arrow := "-->"
+		if e.label == "prev" {
+			arrow = "-.->"
+		}
+		fmt.Fprintf(b, "    n%d %s|%s| %s\n", e.from, arrow, e.label, target)
+	}
+}
+
+// mermaidArray escreve as posições do array lado a lado num subgrafo; as
+// ligações invisíveis (~~~) mantêm os índices em ordem
+func (d *Diagram) mermaidArray(b *strings.Builder) {
+	b.WriteString("flowchart TB\n")
+	b.WriteString("    subgraph array [\" \"]\n        direction LR\n")
+	for s, c := range d.cells {
+		label := mermaidQuote(c.label)
+		if c.position < 0 {
+			label = "∅"
+		}
+		fmt.Fprintf(b, "        s%d[\"[%d] %s\"]\n", s, s, label)
+	}
+	for s := 1; s < len(d.cells); s++ {
+		fmt.Fprintf(b, "        s%d ~~~ s%d\n", s-1, s)
+	}
+	b.WriteString("    end\n")
+}
+
+// mermaidID retorna o identificador Mermaid da célula
+func (d *Diagram) mermaidID(cell int) string {
+	if d.array {
+		return fmt.Sprintf("s%d", cell)
+	}
+	return fmt.Sprintf("n%d", cell)
+}
+
+// mermaidQuote escapa o texto para dentro de ["..."] (aspas viram entidade)
+func mermaidQuote(text string) string {
+	return strings.NewReplacer(`"`, "#quot;", "\n", "<br/>").Replace(text)
+}
@@ -0,0 +1,113 @@
+package export
+
+import (
+	"dca3503/deque"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/stack"
+)
+
+// ============================================================================
+// LISTAS
+// ============================================================================
+
+// FromArrayList desenha o array interno do ArrayList: os elementos nas
+// primeiras posições e o resto da capacidade vazio
+func FromArrayList[T comparable](l *list.ArrayList[T]) *Diagram {
+	values := l.ToSlice()
+	slots := make([]T, l.Capacity())
+	positions := make([]int, l.Capacity())
+	for s := range slots {
+		positions[s] = -1
+		if s < len(values) {
+			slots[s], positions[s] = values[s], s
+		}
+	}
+	return newArray("ArrayList", slots, positions)
+}
+
+// FromLinkedList desenha os nós da LinkedList com os ponteiros next e o head
+func FromLinkedList[T comparable](l *list.LinkedList[T]) *Diagram {
+	return newChain("LinkedList", l.Links(), "head")
+}
+
+// FromDoublyLinkedList desenha os nós da DoublyLinkedList com os ponteiros
+// next e prev, o head e o tail
+func FromDoublyLinkedList[T comparable](l *list.DoublyLinkedList[T]) *Diagram {
+	return newChain("DoublyLinkedList", l.Links(), "head", "tail")
+}
+
+// ============================================================================
+// PILHAS
+// ============================================================================
+
+// FromArrayStack desenha o array da ArrayStack: a base no índice 0 e o
+// marcador top no último elemento (nil com a pilha vazia)
+// As posições de Highlight contam a partir do topo, como em ToSlice
+func FromArrayStack(s *stack.ArrayStack) *Diagram {
+	fromTop := s.ToSlice()
+	slots := make([]int, s.Capacity())
+	positions := make([]int, s.Capacity())
+	for i := range slots {
+		positions[i] = -1
+		if i < len(fromTop) {
+			positions[i] = len(fromTop) - 1 - i
+			slots[i] = fromTop[positions[i]]
+		}
+	}
+	top := len(fromTop) - 1 // -1 == toNil com a pilha vazia
+	return newArray("ArrayStack", slots, positions).mark("top", top)
+}
+
+// FromLinkedStack desenha os nós da LinkedStack do topo para a base
+func FromLinkedStack(s *stack.LinkedStack) *Diagram {
+	return newChain("LinkedStack", s.Links(), "top")
+}
+
+// ============================================================================
+// FILAS
+// ============================================================================
+
+// FromArrayQueue desenha o buffer circular da ArrayQueue como está na
+// memória: os elementos podem dar a volta no fim do array, front aponta
+// para o primeiro e rear para a próxima posição livre
+func FromArrayQueue(q *queue.ArrayQueue) *Diagram {
+	return fromCircular("ArrayQueue", q.GetInternalState())
+}
+
+// FromLinkedQueue desenha os nós da LinkedQueue com front e rear
+func FromLinkedQueue(q *queue.LinkedQueue) *Diagram {
+	return newChain("LinkedQueue", q.Links(), "front", "rear")
+}
+
+// ============================================================================
+// DEQUES
+// ============================================================================
+
+// FromArrayDeque desenha o buffer circular do ArrayDeque (ver FromArrayQueue)
+func FromArrayDeque(q *deque.ArrayDeque) *Diagram {
+	return fromCircular("ArrayDeque", q.GetInternalState())
+}
+
+// FromDeque desenha os nós duplamente ligados do Deque com front e rear
+func FromDeque(q *deque.Deque) *Diagram {
+	return newChain("Deque", q.Links(), "front", "rear")
+}
+
+// FromLinkedListDeque desenha os nós do LinkedListDeque (só com next, por
+// isso DequeueRear precisa percorrer até o penúltimo)
+func FromLinkedListDeque(q *deque.LinkedListDeque) *Diagram {
+	return newChain("LinkedListDeque", q.Links(), "front", "rear")
+}
+
+// fromCircular monta o desenho a partir de GetInternalState de um buffer
+// circular (chaves data, front, rear e size)
+func fromCircular(title string, state map[string]interface{}) *Diagram {
+	data := state["data"].([]int)
+	front, rear, size := state["front"].(int), state["rear"].(int), state["size"].(int)
+	if len(data) == 0 {
+		return newArray(title, data, nil)
+	}
+	d := newArray(title, data, circularPositions(len(data), front, size))
+	return d.mark("front", front).mark("rear", rear%len(data))
+}
@@ -0,0 +1,318 @@
+package hashmap
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"strings"
+
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// CHAINEDMAP - ENCADEAMENTO SEPARADO
+// ============================================================================
+
+// chainNode é um nó da cadeia de um balde
+// Mesma ideia do Node da LinkedList, com a chave, o valor e o hash guardado
+// para não recalculá-lo no rehash nem comparar chaves com hash diferente
+type chainNode[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	next  *chainNode[K, V] // Próximo nó do mesmo balde (nil se for o último)
+}
+
+// ChainedMap implementa Map com encadeamento separado
+// Características:
+// - um array de baldes; cada balde é a cabeça de uma lista ligada de nós
+// - chaves que colidem ficam na mesma cadeia
+// - Get/Put/Remove custam O(1 + α), com α = size/baldes (fator de carga)
+// - α pode passar de 1; o rehash mantém α <= maxLoadFactor
+type ChainedMap[K comparable, V any] struct {
+	table[K]
+	buckets []*chainNode[K, V]
+}
+
+// NewChainedMap cria um map com encadeamento separado
+// capacity é o número inicial de baldes (arredondado para potência de 2) e
+// maxLoadFactor o fator de carga que dispara o rehash; valores não
+// positivos usam DefaultCapacity e DefaultMaxLoadFactor
+func NewChainedMap[K comparable, V any](capacity int, maxLoadFactor float64) *ChainedMap[K, V] {
+	m := &ChainedMap[K, V]{table: newTable[K](capacity, maxLoadFactor, math.MaxFloat64)}
+	m.buckets = make([]*chainNode[K, V], m.capacity())
+	return m
+}
+
+// SetHasher troca a função hash e redistribui as chaves (nil volta ao padrão)
+// Complexidade: O(n + m) para m baldes
+func (m *ChainedMap[K, V]) SetHasher(hasher Hasher[K]) {
+	defer checkInvariants(m)
+	if hasher == nil {
+		hasher = DefaultHasher[K]()
+	}
+	m.hasher = hasher
+	for node := range m.nodes() {
+		node.hash = hasher(node.key)
+	}
+	m.Rehash(m.capacity())
+}
+
+// SetCounters associa contadores de operações ao map (nil desliga a contagem)
+// Conta comparações de chave, saltos na cadeia, nós alocados e rehashes
+func (m *ChainedMap[K, V]) SetCounters(counters *instrument.Counters) {
+	m.counters = counters
+}
+
+// ============================================================================
+// OPERAÇÕES PRINCIPAIS
+// ============================================================================
+
+// find procura a chave na cadeia do seu balde
+// Retorna o nó (nil se ausente) e o nó anterior na cadeia (nil se for a cabeça)
+func (m *ChainedMap[K, V]) find(key K, hash uint64) (node, previous *chainNode[K, V]) {
+	node = m.buckets[m.home(hash)]
+	for node != nil {
+		if node.hash == hash {
+			m.counters.AddComparisons(1)
+			if node.key == key {
+				return node, previous
+			}
+		}
+		previous, node = node, node.next
+		m.counters.AddTraversals(1)
+	}
+	return nil, previous
+}
+
+// Get retorna o valor da chave
+// Complexidade: O(1 + α) em média, O(n) se todas as chaves colidirem
+func (m *ChainedMap[K, V]) Get(key K) (V, bool) {
+	if node, _ := m.find(key, m.hasher(key)); node != nil {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains verifica se a chave existe
+// Complexidade: O(1 + α) em média
+func (m *ChainedMap[K, V]) Contains(key K) bool {
+	node, _ := m.find(key, m.hasher(key))
+	return node != nil
+}
+
+// Put insere ou atualiza a chave; retorna true se a chave era nova
+// Complexidade: O(1 + α) amortizado
+// Pseudocódigo:
+// 1. Calcular o hash e procurar na cadeia do balde hash & mask
+// 2. Se achou, trocar o valor
+// 3. Se a nova chave passar do fator de carga, dobrar os baldes (rehash)
+// 4. Inserir o nó no início da cadeia do balde
+func (m *ChainedMap[K, V]) Put(key K, value V) bool {
+	defer checkInvariants(m)
+	hash := m.hasher(key)
+	if node, _ := m.find(key, hash); node != nil {
+		node.value = value
+		return false
+	}
+	
+	if m.exceeds(m.size + 1) {
+		m.Rehash(m.grownCapacity(m.size + 1))
+	}
+	bucket := m.home(hash)
+	m.buckets[bucket] = &chainNode[K, V]{key: key, value: value, hash: hash, next: m.buckets[bucket]}
+	m.counters.AddAllocations(1)
+	m.size++
+	return true
+}
+
+// Remove retira a chave e retorna o valor que ela tinha
+// Complexidade: O(1 + α) em média
+func (m *ChainedMap[K, V]) Remove(key K) (V, bool) {
+	defer checkInvariants(m)
+	hash := m.hasher(key)
+	node, previous := m.find(key, hash)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	if previous == nil {
+		m.buckets[m.home(hash)] = node.next
+	} else {
+		previous.next = node.next
+	}
+	m.size--
+	return node.value, true
+}
+
+// Rehash reconstrói a tabela com pelo menos capacity baldes
+// A capacidade é arredondada para potência de 2 e nunca fica abaixo do
+// necessário para o fator de carga; os nós são religados, não copiados
+// Complexidade: O(n + m)
+func (m *ChainedMap[K, V]) Rehash(capacity int) {
+	defer checkInvariants(m)
+	capacity = roundCapacity(capacity)
+	for m.size > 0 && float64(m.size) > m.maxLoadFactor*float64(capacity) {
+		capacity *= 2
+	}
+	
+	old := m.buckets
+	m.buckets = make([]*chainNode[K, V], capacity)
+	m.mask = uint64(capacity) - 1
+	m.rehashes++
+	m.counters.Resize(m.size)
+	for _, node := range old {
+		for node != nil {
+			next := node.next
+			bucket := m.home(node.hash)
+			node.next = m.buckets[bucket]
+			m.buckets[bucket] = node
+			node = next
+		}
+	}
+}
+
+// Clear remove todas as chaves mantendo o número de baldes
+// Complexidade: O(m)
+func (m *ChainedMap[K, V]) Clear() {
+	defer checkInvariants(m)
+	clear(m.buckets)
+	m.size = 0
+}
+
+// ============================================================================
+// CONSULTA E ITERAÇÃO
+// ============================================================================
+
+// Size retorna o número de chaves
+// Complexidade: Θ(1)
+func (m *ChainedMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty verifica se o map está vazio
+// Complexidade: Θ(1)
+func (m *ChainedMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Capacity retorna o número de baldes
+func (m *ChainedMap[K, V]) Capacity() int {
+	return m.capacity()
+}
+
+// LoadFactor retorna α = size / baldes (pode passar de 1)
+func (m *ChainedMap[K, V]) LoadFactor() float64 {
+	return float64(m.size) / float64(m.capacity())
+}
+
+// nodes percorre os nós balde a balde
+func (m *ChainedMap[K, V]) nodes() iter.Seq[*chainNode[K, V]] {
+	return func(yield func(*chainNode[K, V]) bool) {
+		for _, node := range m.buckets {
+			for ; node != nil; node = node.next {
+				if !yield(node) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All percorre os pares balde a balde, na ordem de cada cadeia
+// Não altere o map durante a iteração
+// Complexidade: O(n + m)
+func (m *ChainedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := range m.nodes() {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys retorna as chaves na ordem de All
+// Complexidade: O(n + m)
+func (m *ChainedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	for node := range m.nodes() {
+		keys = append(keys, node.key)
+	}
+	return keys
+}
+
+// Values retorna um iterador sobre os valores na ordem de All
+// Complexidade: O(n + m)
+func (m *ChainedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for node := range m.nodes() {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+// chainLengths retorna o tamanho da cadeia de cada balde
+func (m *ChainedMap[K, V]) chainLengths() []int {
+	lengths := make([]int, len(m.buckets))
+	for i, node := range m.buckets {
+		for ; node != nil; node = node.next {
+			lengths[i]++
+		}
+	}
+	return lengths
+}
+
+// GetStatistics retorna tamanho, capacidade, fator de carga e a distribuição
+// das cadeias: bucketSizes[k] é quantos baldes têm exatamente k nós
+// Complexidade: O(n + m)
+func (m *ChainedMap[K, V]) GetStatistics() map[string]interface{} {
+	stats := m.statistics()
+	stats["strategy"] = "encadeamento"
+	
+	histogram := make(map[int]int)
+	longest, used := 0, 0
+	for _, length := range m.chainLengths() {
+		histogram[length]++
+		if length > 0 {
+			used++
+		}
+		longest = max(longest, length)
+	}
+	averageChain := 0.0
+	if used > 0 {
+		averageChain = float64(m.size) / float64(used)
+	}
+	stats["bucketSizes"] = histogram
+	stats["emptyBuckets"] = histogram[0]
+	stats["longestChain"] = longest
+	stats["averageChain"] = averageChain // Média entre os baldes não vazios
+	return stats
+}
+
+// Layout desenha cada balde com sua cadeia, útil para visualizar colisões
+//
+//	[0] → a:1 → q:17
+//	[1] ∅
+func (m *ChainedMap[K, V]) Layout() string {
+	var builder strings.Builder
+	for i, node := range m.buckets {
+		builder.WriteString(fmt.Sprintf("[%d]", i))
+		if node == nil {
+			builder.WriteString(" ∅")
+		}
+		for ; node != nil; node = node.next {
+			builder.WriteString(fmt.Sprintf(" → %v:%v", node.key, node.value))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// String retorna os pares na ordem de All, o tamanho e o número de baldes
+func (m *ChainedMap[K, V]) String() string {
+	return fmt.Sprintf("ChainedMap%s (%d/%d)", formatPairs(m.All()), m.size, m.capacity())
+}
@@ -0,0 +1,151 @@
+package hashmap
+
+import (
+	"math"
+	"reflect"
+)
+
+// ============================================================================
+// FUNÇÕES HASH
+// ============================================================================
+
+// Hasher transforma uma chave em um inteiro de 64 bits
+// Chaves iguais precisam ter o mesmo hash; chaves diferentes devem ter hashes
+// bem espalhados, porque o índice usa só os bits baixos (hash & mask)
+type Hasher[K comparable] func(key K) uint64
+
+const (
+	fnvOffset = 14695981039346656037
+	fnvPrime  = 1099511628211
+)
+
+// HashString calcula o FNV-1a de 64 bits da string
+// Pseudocódigo:
+// 1. h = offset
+// 2. Para cada byte b: h = (h XOR b) * primo
+func HashString(s string) uint64 {
+	hash := uint64(fnvOffset)
+	for i := 0; i < len(s); i++ {
+		hash ^= uint64(s[i])
+		hash *= fnvPrime
+	}
+	return hash
+}
+
+// HashUint64 embaralha os bits de um inteiro (finalizador do SplitMix64)
+// Sem isso, chaves 0, 16, 32... cairiam todas no balde 0 de uma tabela de 16
+func HashUint64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// DefaultHasher escolhe a função hash pelo tipo da chave
+// - strings: HashString
+// - inteiros, bool e floats: HashUint64 sobre os bits do valor
+// - demais tipos (structs, arrays, ponteiros...): hashValue, que percorre o
+//   valor com reflect combinando o hash de cada campo
+// O último caso é genérico mas lento; para chaves compostas em código que
+// precisa de desempenho, passe um Hasher próprio com SetHasher
+func DefaultHasher[K comparable]() Hasher[K] {
+	return func(key K) uint64 {
+		switch k := any(key).(type) {
+		case string:
+			return HashString(k)
+		case int:
+			return HashUint64(uint64(k))
+		case int8:
+			return HashUint64(uint64(k))
+		case int16:
+			return HashUint64(uint64(k))
+		case int32:
+			return HashUint64(uint64(k))
+		case int64:
+			return HashUint64(uint64(k))
+		case uint:
+			return HashUint64(uint64(k))
+		case uint8:
+			return HashUint64(uint64(k))
+		case uint16:
+			return HashUint64(uint64(k))
+		case uint32:
+			return HashUint64(uint64(k))
+		case uint64:
+			return HashUint64(k)
+		case uintptr:
+			return HashUint64(uint64(k))
+		case bool:
+			if k {
+				return HashUint64(1)
+			}
+			return HashUint64(0)
+		case float32:
+			return hashFloat(float64(k))
+		case float64:
+			return hashFloat(k)
+		}
+		return hashValue(reflect.ValueOf(&key).Elem())
+	}
+}
+
+// hashFloat trata 0.0 e -0.0 (iguais com ==, mas com bits diferentes)
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return HashUint64(0)
+	}
+	return HashUint64(math.Float64bits(f))
+}
+
+// hashValue calcula o hash de uma chave composta campo a campo
+// Precisa concordar com ==: por isso não serve formatar a chave com
+// fmt.Sprintf("%#v"), que escreve 0.0 e -0.0 de jeitos diferentes.
+// Floats (inclusive as partes de um complex) passam por hashFloat, e uma
+// interface usa o hash do valor guardado nela
+// Pseudocódigo:
+// 1. Tipos básicos: o mesmo hash de DefaultHasher
+// 2. Ponteiros e canais: o endereço (== compara endereços)
+// 3. Arrays e structs: h = HashUint64(h XOR hash do elemento), em ordem
+func hashValue(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.String:
+		return HashString(v.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return HashUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return HashUint64(v.Uint())
+	case reflect.Bool:
+		if v.Bool() {
+			return HashUint64(1)
+		}
+		return HashUint64(0)
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return HashUint64(hashFloat(real(c)) ^ hashFloat(imag(c))*fnvPrime)
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return HashUint64(uint64(v.Pointer()))
+	case reflect.Interface:
+		if v.IsNil() {
+			return HashUint64(0)
+		}
+		return hashValue(v.Elem())
+	case reflect.Array:
+		hash := uint64(fnvOffset)
+		for i := 0; i < v.Len(); i++ {
+			hash = HashUint64(hash ^ hashValue(v.Index(i)))
+		}
+		return hash
+	case reflect.Struct:
+		hash := uint64(fnvOffset)
+		for i := 0; i < v.NumField(); i++ {
+			hash = HashUint64(hash ^ hashValue(v.Field(i)))
+		}
+		return hash
+	}
+	// Chaves comparáveis não chegam aqui (slices, maps e funções não são)
+	return 0
+}
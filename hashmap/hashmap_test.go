@@ -0,0 +1,297 @@
+package hashmap_test
+
+import (
+	"fmt"
+	"iter"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"dca3503/hashmap"
+	"dca3503/instrument"
+)
+
+var (
+	_ hashmap.Map[string, int] = (*hashmap.ChainedMap[string, int])(nil)
+	_ hashmap.Map[string, int] = (*hashmap.OpenMap[string, int])(nil)
+	_ instrument.Instrumented  = (*hashmap.ChainedMap[string, int])(nil)
+	_ instrument.Instrumented  = (*hashmap.OpenMap[string, int])(nil)
+)
+
+// implementations cria um map de cada estratégia com a mesma configuração
+func implementations(capacity int, maxLoadFactor float64) map[string]interface {
+	hashmap.Map[int, int]
+	SetHasher(hashmap.Hasher[int])
+	Validate() error
+} {
+	return map[string]interface {
+		hashmap.Map[int, int]
+		SetHasher(hashmap.Hasher[int])
+		Validate() error
+	}{
+		"encadeamento": hashmap.NewChainedMap[int, int](capacity, maxLoadFactor),
+		"linear":       hashmap.NewOpenMap[int, int](hashmap.LinearProbing, capacity, maxLoadFactor),
+		"quadrática":   hashmap.NewOpenMap[int, int](hashmap.QuadraticProbing, capacity, maxLoadFactor),
+		"robin hood":   hashmap.NewOpenMap[int, int](hashmap.RobinHood, capacity, maxLoadFactor),
+	}
+}
+
+func TestMapsAgainstBuiltin(t *testing.T) {
+	// O hasher ruim concentra as chaves em poucos baldes e força colisões
+	hashers := map[string]hashmap.Hasher[int]{
+		"padrão": nil,
+		"ruim":   func(key int) uint64 { return uint64(key % 7) },
+	}
+	for hasherName, hasher := range hashers {
+		for name, m := range implementations(4, 0.9) {
+			m.SetHasher(hasher)
+			rng := rand.New(rand.NewPCG(7, 11))
+			model := map[int]int{}
+			for step := 0; step < 4000; step++ {
+				key := rng.IntN(200)
+				switch rng.IntN(3) {
+				case 0:
+					_, existed := model[key]
+					model[key] = step
+					if added := m.Put(key, step); added == existed {
+						t.Fatalf("%s/%s passo %d: Put(%d) = %v", name, hasherName, step, key, added)
+					}
+				case 1:
+					want, wantOK := model[key]
+					delete(model, key)
+					if got, ok := m.Remove(key); got != want || ok != wantOK {
+						t.Fatalf("%s/%s passo %d: Remove(%d) = %d, %v; esperado %d, %v", name, hasherName, step, key, got, ok, want, wantOK)
+					}
+				default:
+					want, wantOK := model[key]
+					if got, ok := m.Get(key); got != want || ok != wantOK {
+						t.Fatalf("%s/%s passo %d: Get(%d) = %d, %v; esperado %d, %v", name, hasherName, step, key, got, ok, want, wantOK)
+					}
+				}
+			}
+			if err := m.Validate(); err != nil {
+				t.Fatalf("%s/%s: %v", name, hasherName, err)
+			}
+			if m.Size() != len(model) {
+				t.Errorf("%s/%s: tamanho %d, esperado %d", name, hasherName, m.Size(), len(model))
+			}
+			seen := map[int]int{}
+			for key, value := range m.All() {
+				seen[key] = value
+			}
+			if fmt.Sprint(seen) != fmt.Sprint(model) {
+				t.Errorf("%s/%s: All() difere do modelo", name, hasherName)
+			}
+		}
+	}
+}
+
+func TestRehashKeepsLoadFactor(t *testing.T) {
+	for name, m := range implementations(0, 0.5) {
+		if m.Capacity() != hashmap.DefaultCapacity {
+			t.Errorf("%s: capacidade padrão %d", name, m.Capacity())
+		}
+		for i := 0; i < 1000; i++ {
+			m.Put(i, i*i)
+			if m.LoadFactor() > 0.5 {
+				t.Fatalf("%s: fator de carga %.2f com %d chaves", name, m.LoadFactor(), m.Size())
+			}
+		}
+		stats := m.GetStatistics()
+		// 16 → 2048 slots: 7 duplicações
+		if m.Capacity() != 2048 || stats["rehashes"] != 7 {
+			t.Errorf("%s: capacidade %d após %v rehashes", name, m.Capacity(), stats["rehashes"])
+		}
+		for i := 0; i < 1000; i++ {
+			if value, ok := m.Get(i); !ok || value != i*i {
+				t.Fatalf("%s: Get(%d) = %d, %v", name, i, value, ok)
+			}
+		}
+		m.Clear()
+		if !m.IsEmpty() || m.Capacity() != 2048 || len(m.Keys()) != 0 {
+			t.Errorf("%s: Clear deixou %d chaves em %d slots", name, m.Size(), m.Capacity())
+		}
+	}
+}
+
+func TestTombstonesAndBackwardShift(t *testing.T) {
+	sameHome := func(key int) uint64 { return 0 }
+	
+	linear := hashmap.NewOpenMap[int, string](hashmap.LinearProbing, 8, 0.9)
+	linear.SetHasher(sameHome)
+	for i := 1; i <= 4; i++ {
+		linear.Put(i, fmt.Sprint(i))
+	}
+	linear.Remove(2)
+	if linear.Tombstones() != 1 {
+		t.Errorf("linear: %d lápides, esperado 1", linear.Tombstones())
+	}
+	// A lápide não pode cortar a sondagem de 3 e 4
+	if value, ok := linear.Get(4); !ok || value != "4" {
+		t.Errorf("linear: Get(4) depois da lápide = %q, %v", value, ok)
+	}
+	// Uma chave nova reusa a lápide
+	linear.Put(5, "5")
+	if linear.Tombstones() != 0 || linear.Layout() != "[0] 1:1 (+0)\n[1] 5:5 (+1)\n[2] 3:3 (+2)\n[3] 4:4 (+3)\n[4] ∅\n[5] ∅\n[6] ∅\n[7] ∅\n" {
+		t.Errorf("linear: layout após reusar a lápide:\n%s", linear.Layout())
+	}
+	
+	robin := hashmap.NewOpenMap[int, string](hashmap.RobinHood, 8, 0.9)
+	robin.SetHasher(sameHome)
+	for i := 1; i <= 4; i++ {
+		robin.Put(i, fmt.Sprint(i))
+	}
+	robin.Remove(2)
+	want := "[0] 1:1 (+0)\n[1] 3:3 (+1)\n[2] 4:4 (+2)\n[3] ∅\n[4] ∅\n[5] ∅\n[6] ∅\n[7] ∅\n"
+	if robin.Tombstones() != 0 || robin.Layout() != want {
+		t.Errorf("robin hood: layout após Remove(2):\n%s", robin.Layout())
+	}
+}
+
+func TestRobinHoodEqualizesProbes(t *testing.T) {
+	linear := hashmap.NewOpenMap[int, int](hashmap.LinearProbing, 1024, 0.9)
+	robin := hashmap.NewOpenMap[int, int](hashmap.RobinHood, 1024, 0.9)
+	rng := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 900; i++ {
+		key := rng.Int()
+		linear.Put(key, i)
+		robin.Put(key, i)
+	}
+	linearStats, robinStats := linear.GetStatistics(), robin.GetStatistics()
+	// A média de sondagens é a mesma (mesmas chaves, mesma sondagem linear);
+	// Robin Hood só redistribui, encurtando a pior
+	if linearStats["averageProbe"] != robinStats["averageProbe"] {
+		t.Errorf("médias diferentes: linear %v, robin hood %v", linearStats["averageProbe"], robinStats["averageProbe"])
+	}
+	if robinStats["longestProbe"].(int) >= linearStats["longestProbe"].(int) {
+		t.Errorf("maior sondagem: linear %v, robin hood %v", linearStats["longestProbe"], robinStats["longestProbe"])
+	}
+}
+
+func TestChainedStatistics(t *testing.T) {
+	m := hashmap.NewChainedMap[string, int](4, 4)
+	m.SetHasher(func(key string) uint64 { return uint64(len(key)) })
+	for _, word := range []string{"a", "b", "c", "dd", "ee", "fff"} {
+		m.Put(word, len(word))
+	}
+	stats := m.GetStatistics()
+	histogram := stats["bucketSizes"].(map[int]int)
+	// Baldes: [0] vazio, [1] a,b,c, [2] dd,ee, [3] fff
+	if histogram[0] != 1 || histogram[1] != 1 || histogram[2] != 1 || histogram[3] != 1 {
+		t.Errorf("histograma %v", histogram)
+	}
+	if stats["longestChain"] != 3 || stats["emptyBuckets"] != 1 || stats["averageChain"] != 2.0 {
+		t.Errorf("estatísticas %v", stats)
+	}
+	if got := m.Layout(); got != "[0] ∅\n[1] → c:1 → b:1 → a:1\n[2] → ee:2 → dd:2\n[3] → fff:3\n" {
+		t.Errorf("Layout():\n%s", got)
+	}
+	if value, ok := m.Remove("b"); !ok || value != 1 || slices.Contains(m.Keys(), "b") {
+		t.Errorf("Remove(b) = %d, %v; chaves %v", value, ok, m.Keys())
+	}
+	if got := m.String(); got != "ChainedMap{c: 1, a: 1, ee: 2, dd: 2, fff: 3} (5/4)" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestValuesFollowAll(t *testing.T) {
+	chained := hashmap.NewChainedMap[int, int](0, 0)
+	open := hashmap.NewOpenMap[int, int](hashmap.LinearProbing, 0, 0)
+	maps := map[string]interface {
+		hashmap.Map[int, int]
+		Values() iter.Seq[int]
+	}{"ChainedMap": chained, "OpenMap": open}
+	for name, m := range maps {
+		for i := 0; i < 20; i++ {
+			m.Put(i, i*i)
+		}
+		var want []int
+		for _, value := range m.All() {
+			want = append(want, value)
+		}
+		if got := slices.Collect(m.Values()); !slices.Equal(got, want) {
+			t.Errorf("%s: Values() = %v, esperado %v", name, got, want)
+		}
+		
+		// Parar no meio encerra a iteração
+		count := 0
+		for range m.Values() {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		if count != 3 {
+			t.Errorf("%s: %d valores depois do break", name, count)
+		}
+	}
+}
+
+func TestCountersAndHashers(t *testing.T) {
+	var counters instrument.Counters
+	m := hashmap.NewChainedMap[int, int](1, 100)
+	m.SetCounters(&counters)
+	for i := 0; i < 10; i++ {
+		m.Put(i, i)
+	}
+	// Um único balde: a i-ésima inserção percorre i nós
+	if counters.Allocations != 10 || counters.Traversals != 45 {
+		t.Errorf("contadores %v", counters)
+	}
+	
+	hasher := hashmap.DefaultHasher[float64]()
+	if hasher(0.0) != hasher(math.Copysign(0, -1)) {
+		t.Error("0.0 e -0.0 devem ter o mesmo hash")
+	}
+	if hashmap.HashString("") != 14695981039346656037 || hashmap.HashString("a") != 0xaf63dc4c8601ec8c {
+		t.Error("HashString não segue o FNV-1a de 64 bits")
+	}
+	type point struct{ X, Y int }
+	points := hashmap.NewOpenMap[point, string](hashmap.QuadraticProbing, 0, 0)
+	points.Put(point{1, 2}, "a")
+	if value, ok := points.Get(point{1, 2}); !ok || value != "a" {
+		t.Errorf("chave struct: Get = %q, %v", value, ok)
+	}
+}
+
+// Chaves iguais com == precisam do mesmo hash também dentro de structs,
+// arrays, complex e interfaces
+func TestDefaultHasherCompositeKeys(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+	type point struct {
+		X, Y float64
+		Name string
+	}
+	if hasher := hashmap.DefaultHasher[point](); hasher(point{0, 1, "a"}) != hasher(point{negZero, 1, "a"}) {
+		t.Error("struct: 0.0 e -0.0 devem ter o mesmo hash")
+	}
+	if hasher := hashmap.DefaultHasher[[2]float64](); hasher([2]float64{1, 0}) != hasher([2]float64{1, negZero}) {
+		t.Error("array: 0.0 e -0.0 devem ter o mesmo hash")
+	}
+	if hasher := hashmap.DefaultHasher[complex128](); hasher(complex(0, 2)) != hasher(complex(negZero, 2)) {
+		t.Error("complex: 0.0 e -0.0 devem ter o mesmo hash")
+	}
+	if hasher := hashmap.DefaultHasher[any](); hasher(point{0, 1, "a"}) != hasher(point{negZero, 1, "a"}) || hasher(nil) != hasher(any(nil)) {
+		t.Error("interface: o hash deve ser o do valor guardado")
+	}
+	if hasher := hashmap.DefaultHasher[point](); hasher(point{1, 2, "a"}) == hasher(point{2, 1, "a"}) {
+		t.Error("struct: a ordem dos campos deve contar no hash")
+	}
+	
+	chained := hashmap.NewChainedMap[point, string](0, 0)
+	open := hashmap.NewOpenMap[point, string](hashmap.RobinHood, 0, 0)
+	for i := 0; i < 50; i++ {
+		chained.Put(point{negZero, float64(i), "p"}, fmt.Sprint(i))
+		open.Put(point{negZero, float64(i), "p"}, fmt.Sprint(i))
+	}
+	for i := 0; i < 50; i++ {
+		key := point{0, float64(i), "p"}
+		if value, ok := chained.Get(key); !ok || value != fmt.Sprint(i) {
+			t.Errorf("ChainedMap.Get(%v) = %q, %v", key, value, ok)
+		}
+		if value, ok := open.Get(key); !ok || value != fmt.Sprint(i) {
+			t.Errorf("OpenMap.Get(%v) = %q, %v", key, value, ok)
+		}
+	}
+}
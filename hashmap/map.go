@@ -0,0 +1,143 @@
+// Package hashmap implementa tabelas hash com duas estratégias de colisão
+// atrás da mesma interface Map:
+//
+//   - ChainedMap: encadeamento separado, cada balde é uma cadeia de nós
+//     ligados no estilo da LinkedList do pacote list
+//   - OpenMap: endereçamento aberto com sondagem linear, quadrática ou
+//     Robin Hood, tudo guardado num único array de slots
+//
+// As duas crescem (rehash) quando o fator de carga passa do limite
+// configurado e expõem em GetStatistics o histograma de tamanho dos baldes
+// ou de comprimento das sondagens, para comparar as estratégias na prática.
+package hashmap
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// INTERFACE MAP
+// ============================================================================
+
+// Map define o contrato comum de ChainedMap e OpenMap
+type Map[K comparable, V any] interface {
+	Get(key K) (V, bool)     // Busca o valor da chave
+	Put(key K, value V) bool // Insere ou atualiza; true se a chave era nova
+	Remove(key K) (V, bool)  // Remove a chave e retorna o valor que tinha
+	Contains(key K) bool     // Verifica se a chave existe
+	Size() int               // Número de chaves
+	IsEmpty() bool           // Size() == 0
+	Capacity() int           // Número de baldes ou slots
+	LoadFactor() float64     // Size() / Capacity()
+	Clear()                  // Remove todas as chaves (mantém a capacidade)
+	Keys() []K               // Chaves na ordem da tabela
+	All() iter.Seq2[K, V]    // Percorre os pares na ordem da tabela
+	GetStatistics() map[string]interface{}
+}
+
+const (
+	// DefaultCapacity é usada quando a capacidade informada não é positiva
+	DefaultCapacity = 16
+	// DefaultMaxLoadFactor é o limite do fator de carga antes do rehash
+	DefaultMaxLoadFactor = 0.75
+	// maxOpenLoadFactor limita o endereçamento aberto: com a tabela quase
+	// cheia as sondagens ficam longas demais (e com 1.0 não há slot livre)
+	maxOpenLoadFactor = 0.95
+)
+
+// ============================================================================
+// CONFIGURAÇÃO COMPARTILHADA
+// ============================================================================
+
+// table guarda o que ChainedMap e OpenMap têm em comum
+type table[K comparable] struct {
+	hasher        Hasher[K]
+	size          int
+	mask          uint64  // capacidade - 1 (a capacidade é sempre potência de 2)
+	maxLoadFactor float64 // Rehash quando size/capacidade passa deste valor
+	rehashes      int     // Quantas vezes a tabela foi reconstruída
+	counters      *instrument.Counters
+}
+
+// newTable normaliza capacidade e fator de carga e usa o hasher padrão
+// Capacidade não positiva vira DefaultCapacity; fator de carga fora de
+// (0, limit] vira DefaultMaxLoadFactor
+func newTable[K comparable](capacity int, maxLoadFactor, limit float64) table[K] {
+	if capacity <= 0 {
+		capacity = DefaultCapacity
+	}
+	if maxLoadFactor <= 0 || maxLoadFactor > limit {
+		maxLoadFactor = DefaultMaxLoadFactor
+	}
+	return table[K]{
+		hasher:        DefaultHasher[K](),
+		mask:          uint64(roundCapacity(capacity)) - 1,
+		maxLoadFactor: maxLoadFactor,
+	}
+}
+
+// roundCapacity arredonda para a próxima potência de 2 (mínimo 1)
+// Com potência de 2 o índice do balde é hash & mask, sem divisão, e a
+// sondagem quadrática por números triangulares visita todos os slots
+func roundCapacity(capacity int) int {
+	if capacity <= 1 {
+		return 1
+	}
+	return 1 << bits.Len(uint(capacity-1))
+}
+
+// capacity retorna o número de baldes ou slots
+func (t *table[K]) capacity() int {
+	return int(t.mask) + 1
+}
+
+// home retorna o balde (ou primeiro slot da sondagem) de um hash
+func (t *table[K]) home(hash uint64) int {
+	return int(hash & t.mask)
+}
+
+// exceeds verifica se ter count ocupados passaria do fator de carga
+func (t *table[K]) exceeds(count int) bool {
+	return float64(count) > t.maxLoadFactor*float64(t.capacity())
+}
+
+// grownCapacity escolhe a capacidade do próximo rehash: o dobro, ou mais se
+// for preciso para caber count chaves abaixo do fator de carga
+func (t *table[K]) grownCapacity(count int) int {
+	capacity := t.capacity() * 2
+	for float64(count) > t.maxLoadFactor*float64(capacity) {
+		capacity *= 2
+	}
+	return capacity
+}
+
+// statistics monta o mapa no formato de GetStatistics das outras estruturas
+func (t *table[K]) statistics() map[string]interface{} {
+	return map[string]interface{}{
+		"size":          t.size,
+		"capacity":      t.capacity(),
+		"isEmpty":       t.size == 0,
+		"loadFactor":    float64(t.size) / float64(t.capacity()),
+		"maxLoadFactor": t.maxLoadFactor,
+		"rehashes":      t.rehashes,
+	}
+}
+
+// formatPairs escreve os pares na forma {k: v, ...}
+func formatPairs[K comparable, V any](pairs iter.Seq2[K, V]) string {
+	var builder strings.Builder
+	builder.WriteString("{")
+	for key, value := range pairs {
+		if builder.Len() > 1 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprintf("%v: %v", key, value))
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
@@ -0,0 +1,428 @@
+package hashmap
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// OPENMAP - ENDEREÇAMENTO ABERTO
+// ============================================================================
+
+// Probing é a estratégia usada para achar o próximo slot numa colisão
+type Probing int
+
+const (
+	// LinearProbing tenta h, h+1, h+2, ... (forma agrupamentos primários)
+	LinearProbing Probing = iota
+	// QuadraticProbing tenta h, h+1, h+3, h+6, ... (números triangulares)
+	// Com capacidade potência de 2 essa sequência visita todos os slots
+	QuadraticProbing
+	// RobinHood é a sondagem linear em que quem está mais longe de casa
+	// toma o slot de quem está mais perto; as distâncias ficam parecidas e a
+	// busca sem sucesso para cedo. Remove desloca os vizinhos para trás em
+	// vez de deixar lápides
+	RobinHood
+)
+
+// String retorna o nome da estratégia em português
+func (p Probing) String() string {
+	switch p {
+	case LinearProbing:
+		return "linear"
+	case QuadraticProbing:
+		return "quadrática"
+	case RobinHood:
+		return "robin hood"
+	}
+	return fmt.Sprintf("Probing(%d)", int(p))
+}
+
+// slotState diferencia slot nunca usado de slot com chave removida
+type slotState uint8
+
+const (
+	slotEmpty    slotState = iota // Nunca ocupado: a busca pode parar aqui
+	slotOccupied                  // Contém uma chave
+	slotDeleted                   // Lápide: a busca continua, a inserção pode reusar
+)
+
+// slot é uma posição do array de um OpenMap
+type slot[K comparable, V any] struct {
+	key      K
+	value    V
+	hash     uint64
+	distance int // Passo da sondagem em que a chave foi colocada (0 = em casa)
+	state    slotState
+}
+
+// OpenMap implementa Map com endereçamento aberto
+// Características:
+// - todas as chaves ficam no próprio array de slots, sem nós alocados
+// - colisões são resolvidas procurando outro slot (sondagem)
+// - o fator de carga é sempre < 1; perto de 1 as sondagens explodem
+// - linear e quadrática marcam remoções com lápides (contam na carga até o rehash)
+type OpenMap[K comparable, V any] struct {
+	table[K]
+	probing    Probing
+	slots      []slot[K, V]
+	tombstones int // Slots com lápide (sempre 0 em RobinHood)
+}
+
+// NewOpenMap cria um map com endereçamento aberto e a sondagem escolhida
+// capacity é o número inicial de slots (arredondado para potência de 2) e
+// maxLoadFactor o limite de (chaves + lápides) / slots; valores não positivos
+// ou acima de 0.95 usam DefaultCapacity e DefaultMaxLoadFactor
+func NewOpenMap[K comparable, V any](probing Probing, capacity int, maxLoadFactor float64) *OpenMap[K, V] {
+	if probing < LinearProbing || probing > RobinHood {
+		probing = LinearProbing
+	}
+	m := &OpenMap[K, V]{table: newTable[K](capacity, maxLoadFactor, maxOpenLoadFactor), probing: probing}
+	m.slots = make([]slot[K, V], m.capacity())
+	return m
+}
+
+// SetHasher troca a função hash e redistribui as chaves (nil volta ao padrão)
+// Complexidade: O(n + m) para m slots
+func (m *OpenMap[K, V]) SetHasher(hasher Hasher[K]) {
+	defer checkInvariants(m)
+	if hasher == nil {
+		hasher = DefaultHasher[K]()
+	}
+	m.hasher = hasher
+	for i := range m.slots {
+		if m.slots[i].state == slotOccupied {
+			m.slots[i].hash = hasher(m.slots[i].key)
+		}
+	}
+	m.Rehash(m.capacity())
+}
+
+// SetCounters associa contadores de operações ao map (nil desliga a contagem)
+// Conta comparações de chave, slots sondados, movimentos e rehashes
+func (m *OpenMap[K, V]) SetCounters(counters *instrument.Counters) {
+	m.counters = counters
+}
+
+// Probing retorna a estratégia de sondagem
+func (m *OpenMap[K, V]) Probing() Probing {
+	return m.probing
+}
+
+// ============================================================================
+// SONDAGEM
+// ============================================================================
+
+// probe retorna o slot do passo step da sondagem que começa em home
+// Linear e Robin Hood: home + step; quadrática: home + step(step+1)/2
+func (m *OpenMap[K, V]) probe(home, step int) int {
+	offset := step
+	if m.probing == QuadraticProbing {
+		offset = step * (step + 1) / 2
+	}
+	return int(uint64(home+offset) & m.mask)
+}
+
+// find procura a chave e retorna o índice do slot (-1 se ausente)
+// Pseudocódigo:
+// 1. Começar no slot hash & mask e seguir a sequência de sondagem
+// 2. Slot vazio: a chave não existe
+// 3. Lápide: continuar
+// 4. Robin Hood: ocupante mais perto de casa do que nós = a chave não existe
+// (ela teria tomado esse slot)
+// 5. Hash e chave iguais: encontrada
+func (m *OpenMap[K, V]) find(key K, hash uint64) int {
+	home := m.home(hash)
+	for step := 0; step < len(m.slots); step++ {
+		index := m.probe(home, step)
+		current := &m.slots[index]
+		m.counters.AddTraversals(1)
+		switch current.state {
+		case slotEmpty:
+			return -1
+		case slotDeleted:
+			continue
+		}
+		if m.probing == RobinHood && current.distance < step {
+			return -1
+		}
+		if current.hash == hash {
+			m.counters.AddComparisons(1)
+			if current.key == key {
+				return index
+			}
+		}
+	}
+	return -1
+}
+
+// Get retorna o valor da chave
+// Complexidade: O(1) esperado para fator de carga constante, O(n) no pior caso
+func (m *OpenMap[K, V]) Get(key K) (V, bool) {
+	if index := m.find(key, m.hasher(key)); index >= 0 {
+		return m.slots[index].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains verifica se a chave existe
+// Complexidade: O(1) esperado
+func (m *OpenMap[K, V]) Contains(key K) bool {
+	return m.find(key, m.hasher(key)) >= 0
+}
+
+// Put insere ou atualiza a chave; retorna true se a chave era nova
+// Complexidade: O(1) esperado amortizado
+// Pseudocódigo:
+// 1. Se a chave existe, trocar o valor
+// 2. Se (chaves + 1) passar do fator de carga, rehash para o dobro
+// 3. Se só passar contando as lápides, rehash do mesmo tamanho para limpá-las
+// 4. Inserir com a estratégia de sondagem (place)
+func (m *OpenMap[K, V]) Put(key K, value V) bool {
+	defer checkInvariants(m)
+	hash := m.hasher(key)
+	if index := m.find(key, hash); index >= 0 {
+		m.slots[index].value = value
+		m.counters.AddMoves(1)
+		return false
+	}
+	
+	if m.exceeds(m.size + 1) {
+		m.Rehash(m.grownCapacity(m.size + 1))
+	} else if m.exceeds(m.size + m.tombstones + 1) {
+		// O excesso vem das lápides: limpar sem crescer já basta
+		m.Rehash(m.capacity())
+	}
+	m.place(slot[K, V]{key: key, value: value, hash: hash, state: slotOccupied})
+	m.size++
+	return true
+}
+
+// place coloca uma chave que sabidamente não está na tabela
+// Linear e quadrática: primeiro slot vazio ou lápide da sondagem
+// Robin Hood: a cada passo, se o ocupante está mais perto de casa que a
+// chave em mãos, trocam de lugar e a sondagem continua com o ocupante
+func (m *OpenMap[K, V]) place(entry slot[K, V]) {
+	home := m.home(entry.hash)
+	for step := 0; ; step++ {
+		index := m.probe(home, step)
+		current := &m.slots[index]
+		m.counters.AddTraversals(1)
+		if current.state != slotOccupied {
+			if current.state == slotDeleted {
+				m.tombstones--
+			}
+			entry.distance = step
+			*current = entry
+			m.counters.AddMoves(1)
+			return
+		}
+		if m.probing == RobinHood && current.distance < step {
+			// O ocupante é "rico" (está perto de casa): cede o slot
+			entry.distance = step
+			*current, entry = entry, *current
+			m.counters.AddMoves(1)
+			home = m.home(entry.hash)
+			step = entry.distance
+		}
+	}
+}
+
+// Remove retira a chave e retorna o valor que ela tinha
+// Linear e quadrática deixam uma lápide (o slot vazio cortaria a sondagem
+// de outras chaves); Robin Hood puxa os vizinhos seguintes um slot para trás
+// Complexidade: O(1) esperado
+func (m *OpenMap[K, V]) Remove(key K) (V, bool) {
+	defer checkInvariants(m)
+	index := m.find(key, m.hasher(key))
+	if index < 0 {
+		var zero V
+		return zero, false
+	}
+	value := m.slots[index].value
+	m.size--
+	
+	if m.probing != RobinHood {
+		m.slots[index] = slot[K, V]{state: slotDeleted}
+		m.tombstones++
+		return value, true
+	}
+	
+	// Backward shift: enquanto o próximo estiver fora de casa, ele recua
+	next := int(uint64(index+1) & m.mask)
+	for m.slots[next].state == slotOccupied && m.slots[next].distance > 0 {
+		m.slots[index] = m.slots[next]
+		m.slots[index].distance--
+		m.counters.AddMoves(1)
+		index, next = next, int(uint64(next+1)&m.mask)
+	}
+	m.slots[index] = slot[K, V]{}
+	return value, true
+}
+
+// Rehash reconstrói a tabela com pelo menos capacity slots, descartando as
+// lápides; a capacidade é arredondada para potência de 2 e nunca fica
+// abaixo do necessário para o fator de carga
+// Complexidade: O(n + m)
+func (m *OpenMap[K, V]) Rehash(capacity int) {
+	defer checkInvariants(m)
+	capacity = roundCapacity(capacity)
+	for m.exceedsAt(m.size, capacity) {
+		capacity *= 2
+	}
+	
+	old := m.slots
+	m.slots = make([]slot[K, V], capacity)
+	m.mask = uint64(capacity) - 1
+	m.tombstones = 0
+	m.rehashes++
+	m.counters.Resize(m.size)
+	for _, entry := range old {
+		if entry.state == slotOccupied {
+			m.place(entry)
+		}
+	}
+}
+
+// exceedsAt verifica se count chaves passariam do fator de carga (ou
+// encheriam a tabela) com a capacidade informada
+func (m *OpenMap[K, V]) exceedsAt(count, capacity int) bool {
+	return count >= capacity || float64(count) > m.maxLoadFactor*float64(capacity)
+}
+
+// Clear remove todas as chaves mantendo o número de slots
+// Complexidade: O(m)
+func (m *OpenMap[K, V]) Clear() {
+	defer checkInvariants(m)
+	clear(m.slots)
+	m.size = 0
+	m.tombstones = 0
+}
+
+// ============================================================================
+// CONSULTA E ITERAÇÃO
+// ============================================================================
+
+// Size retorna o número de chaves
+// Complexidade: Θ(1)
+func (m *OpenMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty verifica se o map está vazio
+// Complexidade: Θ(1)
+func (m *OpenMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Capacity retorna o número de slots
+func (m *OpenMap[K, V]) Capacity() int {
+	return m.capacity()
+}
+
+// LoadFactor retorna α = size / slots (sem contar as lápides)
+func (m *OpenMap[K, V]) LoadFactor() float64 {
+	return float64(m.size) / float64(m.capacity())
+}
+
+// Tombstones retorna quantos slots têm lápide
+func (m *OpenMap[K, V]) Tombstones() int {
+	return m.tombstones
+}
+
+// All percorre os pares na ordem dos slots
+// Não altere o map durante a iteração
+// Complexidade: O(m)
+func (m *OpenMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range m.slots {
+			if m.slots[i].state == slotOccupied && !yield(m.slots[i].key, m.slots[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys retorna as chaves na ordem dos slots
+// Complexidade: O(m)
+func (m *OpenMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	for key := range m.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Values retorna um iterador sobre os valores na ordem dos slots
+// Complexidade: O(m)
+func (m *OpenMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range m.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// GetStatistics retorna tamanho, capacidade, fator de carga, lápides e a
+// distribuição das sondagens: probeLengths[k] é quantas chaves são achadas
+// examinando exatamente k slots (1 = na posição de casa)
+// Complexidade: O(m)
+func (m *OpenMap[K, V]) GetStatistics() map[string]interface{} {
+	stats := m.statistics()
+	stats["strategy"] = "endereçamento aberto"
+	stats["probing"] = m.probing.String()
+	stats["tombstones"] = m.tombstones
+	
+	histogram := make(map[int]int)
+	longest, total := 0, 0
+	for i := range m.slots {
+		if m.slots[i].state == slotOccupied {
+			length := m.slots[i].distance + 1
+			histogram[length]++
+			longest = max(longest, length)
+			total += length
+		}
+	}
+	averageProbe := 0.0
+	if m.size > 0 {
+		averageProbe = float64(total) / float64(m.size)
+	}
+	stats["probeLengths"] = histogram
+	stats["longestProbe"] = longest
+	stats["averageProbe"] = averageProbe // Média de slots examinados numa busca com sucesso
+	return stats
+}
+
+// Layout desenha cada slot: chave, valor e distância de casa, ∅ para vazio e
+// † para lápide
+//
+//	[0] a:1 (+0)
+//	[1] q:17 (+1)
+//	[2] †
+//	[3] ∅
+func (m *OpenMap[K, V]) Layout() string {
+	var builder strings.Builder
+	for i := range m.slots {
+		current := &m.slots[i]
+		switch current.state {
+		case slotEmpty:
+			builder.WriteString(fmt.Sprintf("[%d] ∅\n", i))
+		case slotDeleted:
+			builder.WriteString(fmt.Sprintf("[%d] †\n", i))
+		default:
+			builder.WriteString(fmt.Sprintf("[%d] %v:%v (+%d)\n", i, current.key, current.value, current.distance))
+		}
+	}
+	return builder.String()
+}
+
+// String retorna os pares na ordem dos slots, o tamanho e o número de slots
+func (m *OpenMap[K, V]) String() string {
+	return fmt.Sprintf("OpenMap(%s)%s (%d/%d)", m.probing, formatPairs(m.All()), m.size, m.capacity())
+}
@@ -0,0 +1,115 @@
+package hashmap
+
+import "fmt"
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// Validate verifica as invariantes internas do ChainedMap
+// - o número de baldes é potência de 2 e corresponde à máscara
+// - cada nó está no balde do seu hash e o hash guardado é o da chave
+// - nenhuma chave se repete e o total de nós é igual a size
+// - o fator de carga não passa do limite
+// Complexidade: O(n + m)
+func (m *ChainedMap[K, V]) Validate() error {
+	if err := m.validateTable(len(m.buckets)); err != nil {
+		return fmt.Errorf("ChainedMap: %v", err)
+	}
+	seen := make(map[K]bool, m.size)
+	for i, node := range m.buckets {
+		for ; node != nil; node = node.next {
+			if m.home(node.hash) != i || m.hasher(node.key) != node.hash {
+				return fmt.Errorf("ChainedMap: chave %v no balde %d com hash do balde %d", node.key, i, m.home(node.hash))
+			}
+			if seen[node.key] {
+				return fmt.Errorf("ChainedMap: chave %v repetida", node.key)
+			}
+			seen[node.key] = true
+		}
+	}
+	if len(seen) != m.size {
+		return fmt.Errorf("ChainedMap: %d nós, size %d", len(seen), m.size)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas do OpenMap
+// - o número de slots é potência de 2 e corresponde à máscara
+// - ocupados e lápides batem com size e tombstones (Robin Hood sem lápides)
+// - cada chave está no passo distance da sua sondagem, sem slot vazio antes
+// - Robin Hood: o slot seguinte nunca está mais de um passo mais longe de casa
+// - ao menos um slot está vazio, para toda busca terminar
+// Complexidade: O(n·d + m) para sondagens de comprimento d
+func (m *OpenMap[K, V]) Validate() error {
+	if err := m.validateTable(len(m.slots)); err != nil {
+		return fmt.Errorf("OpenMap: %v", err)
+	}
+	occupied, deleted := 0, 0
+	seen := make(map[K]bool, m.size)
+	for index := range m.slots {
+		current := &m.slots[index]
+		switch current.state {
+		case slotDeleted:
+			deleted++
+			continue
+		case slotEmpty:
+			continue
+		}
+		occupied++
+		if seen[current.key] {
+			return fmt.Errorf("OpenMap: chave %v repetida", current.key)
+		}
+		seen[current.key] = true
+		if m.hasher(current.key) != current.hash {
+			return fmt.Errorf("OpenMap: hash guardado de %v não confere", current.key)
+		}
+		home := m.home(current.hash)
+		if m.probe(home, current.distance) != index {
+			return fmt.Errorf("OpenMap: chave %v no slot %d, mas o passo %d leva a %d", current.key, index, current.distance, m.probe(home, current.distance))
+		}
+		for step := 0; step < current.distance; step++ {
+			if m.slots[m.probe(home, step)].state == slotEmpty {
+				return fmt.Errorf("OpenMap: slot vazio no passo %d antes da chave %v", step, current.key)
+			}
+		}
+		if m.probing == RobinHood {
+			next := &m.slots[int(uint64(index+1)&m.mask)]
+			if next.state == slotOccupied && next.distance > current.distance+1 {
+				return fmt.Errorf("OpenMap: slot %d a distância %d seguido de distância %d", index, current.distance, next.distance)
+			}
+		}
+	}
+	if occupied != m.size || deleted != m.tombstones {
+		return fmt.Errorf("OpenMap: %d ocupados e %d lápides, esperado %d e %d", occupied, deleted, m.size, m.tombstones)
+	}
+	if m.probing == RobinHood && deleted > 0 {
+		return fmt.Errorf("OpenMap: Robin Hood com %d lápides", deleted)
+	}
+	if occupied+deleted >= len(m.slots) {
+		return fmt.Errorf("OpenMap: nenhum slot vazio (%d slots)", len(m.slots))
+	}
+	return nil
+}
+
+// validateTable confere capacidade, máscara e fator de carga
+func (t *table[K]) validateTable(length int) error {
+	if length == 0 || length&(length-1) != 0 || uint64(length)-1 != t.mask {
+		return fmt.Errorf("capacidade %d não é potência de 2 ou difere da máscara %d", length, t.mask)
+	}
+	if t.size < 0 || float64(t.size) > t.maxLoadFactor*float64(length) {
+		return fmt.Errorf("size %d fora do fator de carga %.2f com capacidade %d", t.size, t.maxLoadFactor, length)
+	}
+	return nil
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug)
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
@@ -0,0 +1,129 @@
+// Package instrument conta as operações elementares feitas pelas estruturas
+// de dados e ajusta curvas de crescimento para conferir, na prática, as
+// complexidades anotadas nos comentários (Θ(1), O(n), O(n log n)...).
+//
+// A instrumentação é opcional: cada estrutura tem um método SetCounters e
+// só contabiliza enquanto houver um *Counters associado. Sem contador, cada
+// ponto de medição custa apenas a verificação de ponteiro nil.
+package instrument
+
+import "fmt"
+
+// ============================================================================
+// CONTADORES DE OPERAÇÕES ELEMENTARES
+// ============================================================================
+
+// Counters acumula as operações elementares executadas pelas estruturas
+// O que cada contador mede:
+// - Comparisons: comparações entre elementos (==, <, comparador)
+// - Moves: escritas/cópias de elementos no armazenamento interno
+// - Allocations: nós ou arrays alocados
+// - Resizes: redimensionamentos do array interno
+// - Traversals: saltos de ponteiro (current = current.next/prev)
+//
+// Todos os métodos aceitam receptor nil e nesse caso não fazem nada,
+// o que permite deixar os pontos de medição sempre no código
+// Counters não é seguro para uso concorrente sem sincronização externa
+type Counters struct {
+	Comparisons int64
+	Moves       int64
+	Allocations int64
+	Resizes     int64
+	Traversals  int64
+}
+
+// Instrumented é implementada pelas estruturas que aceitam contadores
+type Instrumented interface {
+	SetCounters(counters *Counters)
+}
+
+// AddComparisons contabiliza n comparações entre elementos
+func (c *Counters) AddComparisons(n int) {
+	if c != nil {
+		c.Comparisons += int64(n)
+	}
+}
+
+// AddMoves contabiliza n escritas/cópias de elementos
+func (c *Counters) AddMoves(n int) {
+	if c != nil {
+		c.Moves += int64(n)
+	}
+}
+
+// AddAllocations contabiliza n alocações (nós ou arrays)
+func (c *Counters) AddAllocations(n int) {
+	if c != nil {
+		c.Allocations += int64(n)
+	}
+}
+
+// AddResizes contabiliza n redimensionamentos do array interno
+func (c *Counters) AddResizes(n int) {
+	if c != nil {
+		c.Resizes += int64(n)
+	}
+}
+
+// AddTraversals contabiliza n saltos de ponteiro
+func (c *Counters) AddTraversals(n int) {
+	if c != nil {
+		c.Traversals += int64(n)
+	}
+}
+
+// Resize contabiliza um redimensionamento que aloca um array novo e copia
+// moved elementos para ele
+func (c *Counters) Resize(moved int) {
+	if c != nil {
+		c.Resizes++
+		c.Allocations++
+		c.Moves += int64(moved)
+	}
+}
+
+// Reset zera todos os contadores
+func (c *Counters) Reset() {
+	if c != nil {
+		*c = Counters{}
+	}
+}
+
+// Total retorna a soma de todas as operações contabilizadas
+func (c Counters) Total() int64 {
+	return c.Comparisons + c.Moves + c.Allocations + c.Resizes + c.Traversals
+}
+
+// Sub retorna a diferença c - other, útil para medir um trecho de código
+func (c Counters) Sub(other Counters) Counters {
+	return Counters{
+		Comparisons: c.Comparisons - other.Comparisons,
+		Moves:       c.Moves - other.Moves,
+		Allocations: c.Allocations - other.Allocations,
+		Resizes:     c.Resizes - other.Resizes,
+		Traversals:  c.Traversals - other.Traversals,
+	}
+}
+
+// String retorna uma representação compacta dos contadores
+func (c Counters) String() string {
+	return fmt.Sprintf("comparações=%d movimentos=%d alocações=%d redimensionamentos=%d percursos=%d",
+		c.Comparisons, c.Moves, c.Allocations, c.Resizes, c.Traversals)
+}
+
+// ============================================================================
+// MÉTRICAS
+// ============================================================================
+
+// Metric extrai dos contadores o valor a ser analisado
+type Metric func(Counters) int64
+
+// Métricas prontas para cada contador e para o total
+var (
+	Comparisons Metric = func(c Counters) int64 { return c.Comparisons }
+	Moves       Metric = func(c Counters) int64 { return c.Moves }
+	Allocations Metric = func(c Counters) int64 { return c.Allocations }
+	Resizes     Metric = func(c Counters) int64 { return c.Resizes }
+	Traversals  Metric = func(c Counters) int64 { return c.Traversals }
+	Total       Metric = func(c Counters) int64 { return c.Total() }
+)
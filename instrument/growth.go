@@ -0,0 +1,197 @@
+package instrument
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// ============================================================================
+// CLASSES DE CRESCIMENTO
+// ============================================================================
+
+// Growth representa uma classe de crescimento assintótico
+type Growth int
+
+const (
+	Constant     Growth = iota // 1
+	Logarithmic                // log n
+	Linear                     // n
+	Linearithmic               // n log n
+	Quadratic                  // n²
+)
+
+// Growths lista as classes na ordem da mais simples para a mais cara
+var Growths = []Growth{Constant, Logarithmic, Linear, Linearithmic, Quadratic}
+
+// String retorna a notação da classe de crescimento
+func (g Growth) String() string {
+	switch g {
+	case Constant:
+		return "1"
+	case Logarithmic:
+		return "log n"
+	case Linear:
+		return "n"
+	case Linearithmic:
+		return "n log n"
+	case Quadratic:
+		return "n²"
+	default:
+		return fmt.Sprintf("Growth(%d)", int(g))
+	}
+}
+
+// Eval calcula g(n)
+func (g Growth) Eval(n int) float64 {
+	x := float64(n)
+	switch g {
+	case Logarithmic:
+		return math.Log2(x)
+	case Linear:
+		return x
+	case Linearithmic:
+		return x * math.Log2(x)
+	case Quadratic:
+		return x * x
+	default:
+		return 1
+	}
+}
+
+// ============================================================================
+// HARNESS DE MEDIÇÃO
+// ============================================================================
+
+// DefaultSizes são os tamanhos usados quando Measure recebe nil
+// Potências de dois evitam que o custo amortizado oscile com a duplicação
+var DefaultSizes = []int{64, 128, 256, 512, 1024, 2048, 4096}
+
+// Sample guarda o resultado de uma execução para um tamanho n
+type Sample struct {
+	N          int      // Tamanho da entrada
+	Operations int      // Quantas operações foram medidas (para custo amortizado)
+	Counters   Counters // Operações elementares contabilizadas
+}
+
+// PerOperation retorna o valor da métrica dividido pelo número de operações
+func (s Sample) PerOperation(metric Metric) float64 {
+	operations := s.Operations
+	if operations <= 0 {
+		operations = 1
+	}
+	return float64(metric(s.Counters)) / float64(operations)
+}
+
+// Run prepara e executa a operação medida para o tamanho n
+// Deve associar c à estrutura (SetCounters) apenas no trecho a ser medido
+// e retornar quantas operações foram executadas nesse trecho
+type Run func(n int, c *Counters) (operations int)
+
+// Measure executa run para cada tamanho e coleta os contadores
+// Complexidade: a soma dos custos de run para cada tamanho
+func Measure(sizes []int, run Run) []Sample {
+	if sizes == nil {
+		sizes = DefaultSizes
+	}
+	
+	samples := make([]Sample, 0, len(sizes))
+	for _, n := range sizes {
+		var counters Counters
+		operations := run(n, &counters)
+		samples = append(samples, Sample{N: n, Operations: operations, Counters: counters})
+	}
+	return samples
+}
+
+// ============================================================================
+// AJUSTE DE CURVA
+// ============================================================================
+
+// Fit é o resultado do ajuste de uma curva de crescimento às amostras
+type Fit struct {
+	Growth      Growth             // Classe que melhor explica o custo por operação
+	Coefficient float64            // c em custo ≈ c·g(n)
+	Errors      map[Growth]float64 // Erro de cada classe candidata (menor é melhor)
+}
+
+// String retorna o ajuste na forma "≈ c·g(n)"
+func (f Fit) String() string {
+	return fmt.Sprintf("≈ %.3g·%s", f.Coefficient, f.Growth)
+}
+
+// FitGrowth escolhe a classe de crescimento que melhor descreve o custo por
+// operação (métrica / Operations) em função de n
+// Pseudocódigo:
+// 1. Para cada classe g, calcular as razões r_i = custo_i / g(n_i)
+// 2. Se g for a classe certa, as razões ficam quase constantes (≈ c)
+// 3. Erro da classe = coeficiente de variação das razões (desvio / média)
+// 4. Escolher a classe de menor erro; empates favorecem a mais simples
+func FitGrowth(samples []Sample, metric Metric) Fit {
+	fit := Fit{Growth: Constant, Errors: make(map[Growth]float64)}
+	if len(samples) == 0 {
+		return fit
+	}
+	
+	best := math.Inf(1)
+	for _, growth := range Growths {
+		ratios := make([]float64, len(samples))
+		for i, sample := range samples {
+			ratios[i] = sample.PerOperation(metric) / growth.Eval(sample.N)
+		}
+		
+		mean, deviation := meanAndDeviation(ratios)
+		variation := 0.0
+		if mean != 0 {
+			variation = deviation / math.Abs(mean)
+		} else if deviation != 0 {
+			variation = math.Inf(1)
+		}
+		fit.Errors[growth] = variation
+		
+		// Tolerância pequena para que custo zero (ou exato) fique na classe mais simples
+		if variation < best-1e-9 {
+			best = variation
+			fit.Growth = growth
+			fit.Coefficient = mean
+		}
+	}
+	return fit
+}
+
+// CheckGrowth verifica se o custo medido cresce no máximo como expected
+// Retorna erro descrevendo o ajuste quando a classe encontrada é mais cara
+func CheckGrowth(samples []Sample, metric Metric, expected Growth) error {
+	fit := FitGrowth(samples, metric)
+	if fit.Growth > expected {
+		return fmt.Errorf("crescimento esperado O(%s), medido %s", expected, fit)
+	}
+	return nil
+}
+
+// Report formata uma tabela com o custo por operação de cada amostra e o ajuste
+func Report(name string, samples []Sample, metric Metric) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%s\n", name))
+	builder.WriteString(fmt.Sprintf("  %8s  %14s\n", "n", "custo/op"))
+	for _, sample := range samples {
+		builder.WriteString(fmt.Sprintf("  %8d  %14.2f\n", sample.N, sample.PerOperation(metric)))
+	}
+	builder.WriteString(fmt.Sprintf("  ajuste: %s\n", FitGrowth(samples, metric)))
+	return builder.String()
+}
+
+// meanAndDeviation calcula média e desvio padrão populacional
+func meanAndDeviation(values []float64) (float64, float64) {
+	sum := 0.0
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+	
+	squares := 0.0
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
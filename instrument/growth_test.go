@@ -0,0 +1,83 @@
+package instrument
+
+import (
+	"strings"
+	"testing"
+)
+
+// synthetic gera amostras cujo custo por operação é exatamente c·g(n)
+func synthetic(growth Growth, coefficient float64) []Sample {
+	return Measure(nil, func(n int, c *Counters) int {
+		c.AddComparisons(int(coefficient * growth.Eval(n)))
+		return 1
+	})
+}
+
+func TestFitGrowthRecognizesEachClass(t *testing.T) {
+	for _, growth := range Growths {
+		fit := FitGrowth(synthetic(growth, 3), Comparisons)
+		if fit.Growth != growth {
+			t.Errorf("custo 3·%s ajustado como %s (erros %v)", growth, fit, fit.Errors)
+		}
+	}
+}
+
+func TestFitGrowthZeroCostIsConstant(t *testing.T) {
+	fit := FitGrowth(synthetic(Linear, 1), Resizes)
+	if fit.Growth != Constant || fit.Coefficient != 0 {
+		t.Errorf("custo zero ajustado como %s", fit)
+	}
+}
+
+func TestFitGrowthAmortized(t *testing.T) {
+	// n operações com custo total 2n: amortizado O(1) mesmo com picos
+	samples := Measure(nil, func(n int, c *Counters) int {
+		for i := 1; i <= n; i++ {
+			c.AddMoves(1)
+			if i&(i-1) == 0 {
+				c.Resize(i) // Pico O(i) nas potências de dois
+			}
+		}
+		return n
+	})
+	if err := CheckGrowth(samples, Moves, Constant); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCheckGrowth(t *testing.T) {
+	samples := synthetic(Quadratic, 1)
+	err := CheckGrowth(samples, Comparisons, Linearithmic)
+	if err == nil || !strings.Contains(err.Error(), "n²") {
+		t.Errorf("CheckGrowth(n², esperado n log n) = %v, esperado erro citando n²", err)
+	}
+	if err := CheckGrowth(synthetic(Logarithmic, 1), Comparisons, Linear); err != nil {
+		t.Errorf("custo log n deveria passar no limite O(n): %v", err)
+	}
+}
+
+func TestNilCountersAreNoOps(t *testing.T) {
+	var c *Counters
+	c.AddComparisons(1)
+	c.AddMoves(1)
+	c.AddAllocations(1)
+	c.AddResizes(1)
+	c.AddTraversals(1)
+	c.Resize(10)
+	c.Reset()
+}
+
+func TestCountersSubAndTotal(t *testing.T) {
+	var c Counters
+	c.Resize(4)
+	before := c
+	c.AddComparisons(3)
+	c.AddTraversals(2)
+	diff := c.Sub(before)
+	if diff != (Counters{Comparisons: 3, Traversals: 2}) {
+		t.Errorf("Sub = %+v", diff)
+	}
+	if c.Total() != 1+1+4+3+2 {
+		t.Errorf("Total = %d, esperado 11", c.Total())
+	}
+}
@@ -0,0 +1,70 @@
+// Package links fotografa os ponteiros das estruturas encadeadas como estão
+// na memória, para desenhá-los (pacote export) ou inspecioná-los em testes.
+//
+// Diferente de ToSlice, que lista os valores na ordem lógica, um Snapshot
+// guarda para onde cada ponteiro next e prev aponta de verdade. Uma lista
+// com um ciclo, um prev errado ou um tail fora da cadeia aparece assim
+// como está, e a fotografia termina mesmo nesses casos: cada nó entra uma
+// única vez, então um ciclo vira uma seta de volta para um nó já visto.
+package links
+
+// Nil é o índice dos ponteiros nulos
+const Nil = -1
+
+// Snapshot é a fotografia dos nós de uma estrutura encadeada
+// Os nós são numerados na ordem em que foram encontrados: primeiro a
+// cadeia next a partir da primeira raiz (head, top ou front), depois
+// qualquer nó que só é alcançado pelas outras raízes ou por prev
+type Snapshot[T any] struct {
+	Values []T   // Valor de cada nó
+	Next   []int // Next[i] é o nó apontado pelo next do nó i (Nil = nil)
+	Prev   []int // Prev[i] é o nó apontado pelo prev do nó i; nil sem prev
+	Roots  []int // Nó apontado por cada raiz, na ordem recebida por Walk
+	Chain  int   // Nós da cadeia next a partir da primeira raiz, sem repetir
+}
+
+// Walk fotografa os nós alcançáveis a partir das raízes seguindo next e,
+// se prev não for nil, também prev
+// Complexidade: O(n) para n nós alcançáveis, com um mapa de nós visitados
+func Walk[N comparable, T any](roots []N, value func(N) T, next, prev func(N) N) Snapshot[T] {
+	var zero N
+	index := map[N]int{}
+	var nodes []N
+	visit := func(node N) int {
+		if node == zero {
+			return Nil
+		}
+		if i, seen := index[node]; seen {
+			return i
+		}
+		index[node] = len(nodes)
+		nodes = append(nodes, node)
+		return len(nodes) - 1
+	}
+	
+	// A cadeia da primeira raiz vem antes: os índices são as posições lógicas
+	var s Snapshot[T]
+	if len(roots) > 0 {
+		for node := roots[0]; node != zero; node = next(node) {
+			if _, seen := index[node]; seen {
+				break
+			}
+			visit(node)
+		}
+		s.Chain = len(nodes)
+	}
+	for _, root := range roots {
+		s.Roots = append(s.Roots, visit(root))
+	}
+	
+	// visit acrescenta em nodes os nós novos: o laço só para quando todos
+	// os ponteiros já levam a nós conhecidos
+	for i := 0; i < len(nodes); i++ {
+		s.Values = append(s.Values, value(nodes[i]))
+		s.Next = append(s.Next, visit(next(nodes[i])))
+		if prev != nil {
+			s.Prev = append(s.Prev, visit(prev(nodes[i])))
+		}
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+package links_test
+
+import (
+	"slices"
+	"testing"
+
+	"dca3503/links"
+)
+
+type node struct {
+	value      string
+	next, prev *node
+}
+
+func walk(roots ...*node) links.Snapshot[string] {
+	return links.Walk(roots,
+		func(n *node) string { return n.value },
+		func(n *node) *node { return n.next },
+		func(n *node) *node { return n.prev })
+}
+
+// chain liga os nós em sequência nos dois sentidos
+func chain(values ...string) []*node {
+	nodes := make([]*node, len(values))
+	for i, value := range values {
+		nodes[i] = &node{value: value}
+		if i > 0 {
+			nodes[i-1].next, nodes[i].prev = nodes[i], nodes[i-1]
+		}
+	}
+	return nodes
+}
+
+func TestWalkFollowsRealPointers(t *testing.T) {
+	nodes := chain("a", "b", "c")
+	s := walk(nodes[0], nodes[2])
+	if !slices.Equal(s.Values, []string{"a", "b", "c"}) || s.Chain != 3 {
+		t.Fatalf("lista bem formada: %+v", s)
+	}
+	if !slices.Equal(s.Next, []int{1, 2, links.Nil}) || !slices.Equal(s.Prev, []int{links.Nil, 0, 1}) || !slices.Equal(s.Roots, []int{0, 2}) {
+		t.Errorf("ponteiros: %+v", s)
+	}
+	
+	if s := walk(nil, nil); len(s.Values) != 0 || !slices.Equal(s.Roots, []int{links.Nil, links.Nil}) {
+		t.Errorf("estrutura vazia: %+v", s)
+	}
+	if s := links.Walk[*node, string](nil, nil, nil, nil); len(s.Values) != 0 || s.Roots != nil {
+		t.Errorf("sem raízes: %+v", s)
+	}
+}
+
+func TestWalkCorruptedStructures(t *testing.T) {
+	// Ciclo: c.next volta para a; a fotografia termina com a seta de volta
+	nodes := chain("a", "b", "c")
+	nodes[2].next = nodes[0]
+	s := walk(nodes[0])
+	if s.Chain != 3 || !slices.Equal(s.Next, []int{1, 2, 0}) {
+		t.Errorf("ciclo: %+v", s)
+	}
+	
+	// Autociclo sem prev
+	loop := &node{value: "x"}
+	loop.next = loop
+	if s := links.Walk([]*node{loop}, func(n *node) string { return n.value }, func(n *node) *node { return n.next }, nil); s.Prev != nil || !slices.Equal(s.Next, []int{0}) {
+		t.Errorf("autociclo: %+v", s)
+	}
+	
+	// tail fora da cadeia e um prev apontando para um nó já removido
+	nodes = chain("a", "b", "c")
+	removed := &node{value: "velho"}
+	nodes[1].prev = removed
+	tail := &node{value: "solto"}
+	s = walk(nodes[0], tail)
+	if !slices.Equal(s.Values, []string{"a", "b", "c", "solto", "velho"}) || s.Chain != 3 {
+		t.Fatalf("nós fora da cadeia: %+v", s)
+	}
+	if !slices.Equal(s.Roots, []int{0, 3}) || !slices.Equal(s.Prev, []int{links.Nil, 4, 1, links.Nil, links.Nil}) {
+		t.Errorf("raízes %v e prev %v", s.Roots, s.Prev)
+	}
+}
@@ -2,6 +2,11 @@ package list
 
 import (
 	"fmt"
+	"iter"
+
+	"dca3503/capacity"
+	"dca3503/errs"
+	"dca3503/instrument"
 )
 
 // ============================================================================
@@ -14,25 +19,40 @@ import (
 // - Inserção/remoção no final é rápida O(1) amortizado
 // - Inserção/remoção no meio é lenta O(n)
 // - Uso eficiente de memória (elementos contíguos)
-type ArrayList struct {
-	elements        []int // Array interno que armazena os elementos
-	size int              // Contador de elementos inseridos (tamanho lógico)
+// - Crescimento, redução e limite definidos por uma capacity.Policy
+type ArrayList[T comparable] struct {
+	elements []T                  // Array interno que armazena os elementos
+	size     int                  // Contador de elementos inseridos (tamanho lógico)
+	policy   capacity.Policy      // Crescimento, redução e limite (valor zero: dobra, nunca reduz)
+	gate     *capacity.Gate       // Sincronização do OverflowBlock (nil nos outros modos)
+	grows    int                  // Redimensionamentos para cima
+	shrinks  int                  // Redimensionamentos para baixo
+	err      error                // Primeiro Add recusado por OverflowError (ver Err)
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewArrayList cria uma nova instância de ArrayList com capacidade inicial
-func NewArrayList(initialCapacity int) *ArrayList {
-	return &ArrayList{
-		elements: make([]int, initialCapacity),
+func NewArrayList[T comparable](initialCapacity int) *ArrayList[T] {
+	return &ArrayList[T]{
+		elements: make([]T, initialCapacity),
 		size:     0,
 	}
 }
 
+// SetCounters associa contadores de operações à lista (nil desliga a contagem)
+// Conta movimentos (escritas e deslocamentos), comparações de busca e
+// redimensionamentos; a ordenação conta as chamadas ao comparador
+func (list *ArrayList[T]) SetCounters(counters *instrument.Counters) {
+	list.counters = counters
+}
+
 // Init inicializa o ArrayList com capacidade inicial
 // Pseudocódigo:
 // 1. Criar array interno com tamanho especificado
 // 2. Inicializar contador de elementos como 0
-func (l *ArrayList) Init(size int) {
-	l.elements = make([]int, size) // Aloca memória para 'size' elementos
+func (l *ArrayList[T]) Init(size int) {
+	defer checkInvariants(l)
+	l.elements = make([]T, size) // Aloca memória para 'size' elementos
 	l.size = 0          // Inicialmente não há elementos inseridos
 }
 
@@ -40,19 +60,19 @@ func (l *ArrayList) Init(size int) {
 // Complexidade: Θ(1) - Tempo constante
 // Pseudocódigo:
 // 1. Retornar valor do contador de elementos inseridos
-func (list *ArrayList) Size() int { // Θ(1)
+func (list *ArrayList[T]) Size() int { // Θ(1)
 	return list.size
 }
 
 // IsEmpty verifica se a lista está vazia
 // Complexidade: Θ(1)
-func (list *ArrayList) IsEmpty() bool {
+func (list *ArrayList[T]) IsEmpty() bool {
 	return list.size == 0
 }
 
 // Capacity retorna a capacidade atual do array interno
 // Complexidade: Θ(1)
-func (list *ArrayList) Capacity() int {
+func (list *ArrayList[T]) Capacity() int {
 	return len(list.elements)
 }
 
@@ -62,77 +82,162 @@ func (list *ArrayList) Capacity() int {
 // 1. Verificar se índice é válido (0 <= index < size)
 // 2. Se válido: retornar elemento na posição
 // 3. Se inválido: retornar erro
-func (list *ArrayList) Get(index int) (int, error) { // Θ(1)
+func (list *ArrayList[T]) Get(index int) (T, error) { // Θ(1)
 	if index >= 0 && index < list.size {
 		return list.elements[index], nil // Acesso direto O(1)
 	} else {
-		return -1, fmt.Errorf("index inválido: %d", index)
+		var zero T
+		return zero, errs.Index(index, list.size)
 	}
 }
 
 // Set define o valor do elemento na posição especificada
 // Complexidade: Θ(1)
-func (list *ArrayList) Set(index int, value int) error {
+func (list *ArrayList[T]) Set(index int, value T) error {
+	defer checkInvariants(list)
 	if index >= 0 && index < list.size {
 		list.elements[index] = value
+		list.counters.AddMoves(1)
 		return nil
 	} else {
-		return fmt.Errorf("index inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 }
 
 // resize redimensiona o array para uma nova capacidade
 // Complexidade: Θ(n)
-func (list *ArrayList) resize(newCapacity int) {
+func (list *ArrayList[T]) resize(newCapacity int) {
 	if newCapacity < list.size {
 		return // Não pode reduzir abaixo do tamanho atual
 	}
 	
-	newElements := make([]int, newCapacity)
+	newElements := make([]T, newCapacity)
 	for i := 0; i < list.size; i++ {
 		newElements[i] = list.elements[i]
 	}
+	if newCapacity > len(list.elements) {
+		list.grows++
+	} else {
+		list.shrinks++
+	}
 	list.elements = newElements
+	list.counters.Resize(list.size)
 }
 
-// doubleV dobra a capacidade do array interno quando necessário
+// grow aumenta a capacidade do array interno conforme a política (o padrão dobra)
 // Complexidade: Θ(n) - Precisa copiar todos os elementos
-func (list *ArrayList) doubleV() { // Θ(n)
-	list.resize(len(list.elements) * 2)
+func (list *ArrayList[T]) grow() { // Θ(n)
+	list.resize(list.policy.Grow(len(list.elements), list.size+1))
+}
+
+// shrink reduz a capacidade depois de uma remoção, se a política mandar
+func (list *ArrayList[T]) shrink() {
+	if next := list.policy.Shrink(len(list.elements), list.size); next < len(list.elements) {
+		list.resize(next)
+	}
+}
+
+// makeRoom trata uma inserção com a lista em MaxCapacity conforme o Overflow
+// Retorna quantas posições os elementos andaram para a esquerda (1 quando o
+// mais antigo, no índice 0, foi descartado) ou o erro errs.ErrFull
+// Complexidade: O(n) ao sobrescrever, O(1) caso contrário
+func (list *ArrayList[T]) makeRoom(wait bool) (int, error) {
+	if !list.policy.Full(list.size) {
+		return 0, nil
+	}
+	switch {
+	case list.policy.Overflow == capacity.OverflowOverwrite:
+		copy(list.elements, list.elements[1:list.size])
+		list.size--
+		list.counters.AddMoves(list.size)
+		return 1, nil
+	case list.policy.Overflow == capacity.OverflowBlock && wait:
+		for list.policy.Full(list.size) {
+			list.gate.Wait()
+		}
+		return 0, nil
+	}
+	return 0, errs.New(errs.ErrFull, "lista cheia: capacidade máxima %d", "full list: maximum capacity %d", list.policy.MaxCapacity)
 }
 
 // Add adiciona elemento no final da lista
+// Com MaxCapacity atingida segue o Overflow da política: OverflowError
+// descarta o elemento e guarda o erro em Err (use TryAdd para recebê-lo
+// na hora), OverflowBlock
+// espera uma remoção de outra goroutine e OverflowOverwrite descarta o
+// elemento mais antigo (índice 0)
 // Complexidade: O(n) pior caso, Ω(1) melhor caso, O(1) amortizado
 // Pseudocódigo:
-// 1. Se array está cheio: dobrar capacidade
+// 1. Se array está cheio: crescer conforme a política (dobrar, por padrão)
 // 2. Inserir elemento na próxima posição disponível
 // 3. Incrementar contador de elementos
-func (list *ArrayList) Add(value int) { // O(n), Ω(1)
+func (list *ArrayList[T]) Add(value T) { // O(n), Ω(1)
+	if err := list.add(value, true); err != nil && list.err == nil {
+		list.err = err
+	}
+}
+
+// TryAdd é o Add que nunca espera: com a lista no limite retorna um erro
+// errs.ErrFull, exceto em OverflowOverwrite, que descarta o índice 0
+func (list *ArrayList[T]) TryAdd(value T) error {
+	return list.add(value, false)
+}
+
+// Err retorna o erro do primeiro Add descartado por OverflowError (nil se
+// nenhum foi), para quem usa a lista pela interface List, sem TryAdd
+func (list *ArrayList[T]) Err() error {
+	return list.err
+}
+
+// add implementa Add e TryAdd; wait diz se OverflowBlock pode esperar
+func (list *ArrayList[T]) add(value T, wait bool) error {
+	list.gate.Lock()
+	defer list.gate.Unlock()
+	defer checkInvariants(list)
+	if _, err := list.makeRoom(wait); err != nil {
+		return err
+	}
+	
 	// Verifica se precisa expandir o array
 	if list.size == len(list.elements) {
-		list.doubleV() // O(n) apenas quando necessário
+		list.grow() // O(n) apenas quando necessário
 	}
 	
 	// Inserção no final é sempre O(1)
 	list.elements[list.size] = value
 	list.size++
+	list.counters.AddMoves(1)
+	return nil
 }
 
 // AddOnIndex adiciona elemento em posição específica
+// Com MaxCapacity atingida segue o Overflow como Add; ao sobrescrever, o
+// índice 0 sai e index continua se referindo à lista de antes da remoção
 // Complexidade: O(n) pior caso, Ω(1) melhor caso
 // Pseudocódigo:
 // 1. Validar índice (0 <= index <= inserted)
-// 2. Se array cheio: dobrar capacidade
+// 2. Se array cheio: crescer conforme a política
 // 3. Deslocar elementos à direita do índice uma posição para frente
 // 4. Inserir novo elemento na posição
 // 5. Incrementar contador
-func (list *ArrayList) AddOnIndex(val int, index int) error { // O(n), Ω(1)
+func (list *ArrayList[T]) AddOnIndex(val T, index int) error { // O(n), Ω(1)
+	list.gate.Lock()
+	defer list.gate.Unlock()
+	defer checkInvariants(list)
 	if index < 0 || index > list.size {
-		return fmt.Errorf("index inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 	
+	shifted, err := list.makeRoom(true)
+	if err != nil {
+		return err
+	}
+	index = max(index-shifted, 0)
+	if index > list.size {
+		return errs.Index(index, list.size) // Outra goroutine removeu enquanto esperava
+	}
 	if list.size == len(list.elements) {
-		list.doubleV()
+		list.grow()
 	}
 
 	for i := list.size; i > index; i-- {
@@ -141,6 +246,7 @@ func (list *ArrayList) AddOnIndex(val int, index int) error { // O(n), Ω(1)
 	
 	list.elements[index] = val
 	list.size++
+	list.counters.AddMoves(list.size - index) // Deslocamentos + a escrita
 	return nil
 }
 // Remove remove elemento de posição específica
@@ -149,23 +255,31 @@ func (list *ArrayList) AddOnIndex(val int, index int) error { // O(n), Ω(1)
 // 1. Validar índice (0 <= index < size)
 // 2. Deslocar elementos à direita do índice uma posição para esquerda
 // 3. Decrementar contador de elementos
-func (list *ArrayList) Remove(index int) error { // Ω(1), O(n)
+func (list *ArrayList[T]) Remove(index int) error { // Ω(1), O(n)
+	list.gate.Lock()
+	defer list.gate.Unlock()
+	defer checkInvariants(list)
 	if index >= 0 && index < list.size {
 		// Desloca elementos para a esquerda - O(n) no pior caso
 		for i := index; i < list.size-1; i++ {
 			list.elements[i] = list.elements[i+1]
 		}
+		list.counters.AddMoves(list.size - 1 - index)
 		list.size--
+		list.shrink()
+		list.gate.Signal()
 		return nil
 	} else {
-		return fmt.Errorf("index inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 }
 
 // RemoveValue remove a primeira ocorrência do valor especificado
 // Complexidade: O(n)
-func (list *ArrayList) RemoveValue(value int) bool {
+func (list *ArrayList[T]) RemoveValue(value T) bool {
+	defer checkInvariants(list)
 	for i := 0; i < list.size; i++ {
+		list.counters.AddComparisons(1)
 		if list.elements[i] == value {
 			list.Remove(i)
 			return true
@@ -176,14 +290,19 @@ func (list *ArrayList) RemoveValue(value int) bool {
 
 // Clear remove todos os elementos da lista
 // Complexidade: Θ(1)
-func (list *ArrayList) Clear() {
+func (list *ArrayList[T]) Clear() {
+	list.gate.Lock()
+	defer list.gate.Unlock()
+	defer checkInvariants(list)
 	list.size = 0
+	list.gate.Signal()
 }
 
 // Contains verifica se a lista contém o valor especificado
 // Complexidade: O(n)
-func (list *ArrayList) Contains(value int) bool {
+func (list *ArrayList[T]) Contains(value T) bool {
 	for i := 0; i < list.size; i++ {
+		list.counters.AddComparisons(1)
 		if list.elements[i] == value {
 			return true
 		}
@@ -193,8 +312,9 @@ func (list *ArrayList) Contains(value int) bool {
 
 // IndexOf retorna o índice da primeira ocorrência do valor
 // Complexidade: O(n)
-func (list *ArrayList) IndexOf(value int) int {
+func (list *ArrayList[T]) IndexOf(value T) int {
 	for i := 0; i < list.size; i++ {
+		list.counters.AddComparisons(1)
 		if list.elements[i] == value {
 			return i
 		}
@@ -204,8 +324,8 @@ func (list *ArrayList) IndexOf(value int) int {
 
 // ToSlice retorna uma cópia dos elementos como slice
 // Complexidade: Θ(n)
-func (list *ArrayList) ToSlice() []int {
-	result := make([]int, list.size)
+func (list *ArrayList[T]) ToSlice() []T {
+	result := make([]T, list.size)
 	for i := 0; i < list.size; i++ {
 		result[i] = list.elements[i]
 	}
@@ -215,7 +335,7 @@ func (list *ArrayList) ToSlice() []int {
 
 // String retorna uma representação em string da lista
 // Complexidade: O(n)
-func (list *ArrayList) String() string {
+func (list *ArrayList[T]) String() string {
 	if list.size == 0 {
 		return "[]"
 	}
@@ -225,28 +345,130 @@ func (list *ArrayList) String() string {
 		if i > 0 {
 			result += ", "
 		}
-		result += fmt.Sprintf("%d", list.elements[i])
+		result += fmt.Sprintf("%v", list.elements[i])
 	}
 	result += "]"
 	return result
 }
 
+// AddAll adiciona todos os elementos do slice fornecido no final
+// Complexidade: O(m) amortizado, onde m é o tamanho do slice
+func (list *ArrayList[T]) AddAll(elements []T) {
+	defer checkInvariants(list)
+	list.EnsureCapacity(list.size + len(elements))
+	for _, element := range elements {
+		list.Add(element)
+	}
+}
+
 // TrimToSize reduz a capacidade para o tamanho atual
 // Complexidade: Θ(n)
-func (list *ArrayList) TrimToSize() {
+func (list *ArrayList[T]) TrimToSize() {
+	defer checkInvariants(list)
 	if list.size < len(list.elements) {
 		list.resize(list.size)
 	}
 }
 
 // EnsureCapacity garante que a lista tenha pelo menos a capacidade especificada
+// Cresce em passos da política, sem passar de MaxCapacity
 // Complexidade: O(n) se precisar redimensionar, O(1) caso contrário
-func (list *ArrayList) EnsureCapacity(minCapacity int) {
+func (list *ArrayList[T]) EnsureCapacity(minCapacity int) {
+	defer checkInvariants(list)
 	if minCapacity > len(list.elements) {
-		newCapacity := len(list.elements)
-		for newCapacity < minCapacity {
-			newCapacity *= 2
+		if newCapacity := list.policy.Grow(len(list.elements), minCapacity); newCapacity > len(list.elements) {
+			list.resize(newCapacity)
+		}
+	}
+}
+
+// ============================================================================
+// POLÍTICA DE CAPACIDADE
+// ============================================================================
+
+// SetGrowthPolicy troca a política de capacidade da lista
+// Retorna erro se a política for inválida ou se os elementos atuais não
+// couberem em MaxCapacity; um array maior que MaxCapacity é reduzido
+// Com OverflowBlock, Add, AddOnIndex, Remove e Clear passam a ser
+// sincronizados para que outra goroutine possa abrir espaço; os demais
+// métodos continuam sem sincronização. Chame antes de compartilhar a lista
+func (list *ArrayList[T]) SetGrowthPolicy(policy capacity.Policy) error {
+	defer checkInvariants(list)
+	if err := policy.Validate(list.size); err != nil {
+		return err
+	}
+	list.policy = policy
+	list.gate = policy.NewGate()
+	if limit := policy.Clamp(len(list.elements)); limit < len(list.elements) {
+		list.resize(limit)
+	}
+	return nil
+}
+
+// GrowthPolicy retorna a política de capacidade atual
+func (list *ArrayList[T]) GrowthPolicy() capacity.Policy {
+	return list.policy
+}
+
+// IsFull informa se a lista atingiu o MaxCapacity da política
+// Complexidade: Θ(1)
+func (list *ArrayList[T]) IsFull() bool {
+	return list.policy.Full(list.size)
+}
+
+// GetStatistics retorna estatísticas do array interno
+// grows e shrinks contam os redimensionamentos para cima e para baixo
+func (list *ArrayList[T]) GetStatistics() map[string]interface{} {
+	utilization := 0.0
+	if len(list.elements) > 0 {
+		utilization = float64(list.size) / float64(len(list.elements)) * 100
+	}
+	return map[string]interface{}{
+		"size":        list.size,
+		"capacity":    len(list.elements),
+		"utilization": utilization,
+		"isEmpty":     list.size == 0,
+		"maxCapacity": list.policy.MaxCapacity,
+		"grows":       list.grows,
+		"shrinks":     list.shrinks,
+	}
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (índice, elemento) do início ao fim
+// Uso: for i, v := range list.All() { ... }
+// Complexidade: O(n) para a iteração completa, sem alocar cópias
+func (list *ArrayList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < list.size; i++ {
+			if !yield(i, list.elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início ao fim
+func (list *ArrayList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < list.size; i++ {
+			if !yield(list.elements[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward retorna um iterador sobre pares (índice, elemento) do fim ao início
+func (list *ArrayList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := list.size - 1; i >= 0; i-- {
+			if !yield(i, list.elements[i]) {
+				return
+			}
 		}
-		list.resize(newCapacity)
 	}
 }
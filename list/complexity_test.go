@@ -0,0 +1,105 @@
+package list_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"dca3503/instrument"
+	"dca3503/list"
+)
+
+// Cada caso confere, contando operações elementares, uma complexidade
+// anotada nos comentários das implementações
+func TestListComplexity(t *testing.T) {
+	cases := []struct {
+		name     string
+		metric   instrument.Metric
+		expected instrument.Growth
+		run      instrument.Run
+	}{
+		{"ArrayList.Add amortizado", instrument.Moves, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				l := list.NewArrayList[int](1)
+				l.SetCounters(c)
+				for i := 0; i < n; i++ {
+					l.Add(i)
+				}
+				return n
+			}},
+		{"ArrayList.AddOnIndex(0)", instrument.Moves, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				l := list.NewArrayList[int](n + 1)
+				l.SetCounters(c)
+				for i := 0; i < n; i++ {
+					l.AddOnIndex(i, 0)
+				}
+				return n
+			}},
+		{"ArrayList.Sort", instrument.Comparisons, instrument.Linearithmic,
+			func(n int, c *instrument.Counters) int {
+				rng := rand.New(rand.NewPCG(uint64(n), 1))
+				l := list.NewArrayList[int](n)
+				for i := 0; i < n; i++ {
+					l.Add(rng.IntN(n))
+				}
+				l.SetCounters(c)
+				l.Sort(list.Less[int])
+				return 1
+			}},
+		{"LinkedList.Get", instrument.Traversals, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				l := list.NewLinkedList[int]()
+				for i := 0; i < n; i++ {
+					l.Add(i)
+				}
+				l.SetCounters(c)
+				for i := 0; i < n; i++ {
+					l.Get(i)
+				}
+				return n
+			}},
+		{"LinkedList.Sort", instrument.Comparisons, instrument.Linearithmic,
+			func(n int, c *instrument.Counters) int {
+				rng := rand.New(rand.NewPCG(uint64(n), 2))
+				l := list.NewLinkedList[int]()
+				for i := 0; i < n; i++ {
+					l.Add(rng.IntN(n))
+				}
+				l.SetCounters(c)
+				l.Sort(list.Less[int])
+				return 1
+			}},
+		{"DoublyLinkedList.AddLast", instrument.Total, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				l := list.NewDoublyLinkedList[int]()
+				l.SetCounters(c)
+				for i := 0; i < n; i++ {
+					l.AddLast(i)
+				}
+				return n
+			}},
+		{"DoublyLinkedList.Get", instrument.Traversals, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				l := list.NewDoublyLinkedList[int]()
+				for i := 0; i < n; i++ {
+					l.AddLast(i)
+				}
+				l.SetCounters(c)
+				for i := 0; i < n; i++ {
+					l.Get(i)
+				}
+				return n
+			}},
+	}
+	
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := instrument.Measure(nil, tc.run)
+			fit := instrument.FitGrowth(samples, tc.metric)
+			if fit.Growth != tc.expected {
+				t.Errorf("esperado O(%s), medido %s\n%s", tc.expected, fit,
+					instrument.Report(tc.name, samples, tc.metric))
+			}
+		})
+	}
+}
@@ -1,8 +1,12 @@
 package list
 
 import (
-	"errors"
 	"fmt"
+	"iter"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/links"
 )
 
 // ============================================================================
@@ -11,58 +15,83 @@ import (
 
 // DoublyNode representa um nó na lista duplamente ligada
 // Cada nó contém um valor e ponteiros para o próximo e anterior nó
-type DoublyNode struct {
-	data int          // Valor armazenado no nó
-	next *DoublyNode  // Ponteiro para o próximo nó
-	prev *DoublyNode  // Ponteiro para o nó anterior
+type DoublyNode[T comparable] struct {
+	data T              // Valor armazenado no nó
+	next *DoublyNode[T] // Ponteiro para o próximo nó
+	prev *DoublyNode[T] // Ponteiro para o nó anterior
 }
 
 // NewDoublyNode cria um novo nó com o valor especificado
-func NewDoublyNode(value int) *DoublyNode {
-	return &DoublyNode{
+func NewDoublyNode[T comparable](value T) *DoublyNode[T] {
+	return &DoublyNode[T]{
 		data: value,
 		next: nil,
 		prev: nil,
 	}
 }
 
+// Value retorna o valor armazenado no nó
+// Permite usar o nó como "alça" (handle) para remoções O(1) fora do pacote
+func (node *DoublyNode[T]) Value() T {
+	return node.data
+}
+
 // DoublyLinkedList implementa uma lista usando nós duplamente ligados
 // Características:
 // - Navegação bidirecional O(1)
 // - Inserção/remoção em ambas extremidades O(1)
 // - Remoção por referência O(1)
 // - Maior uso de memória (ponteiro extra por nó)
-type DoublyLinkedList struct {
-	head *DoublyNode // Ponteiro para o primeiro nó
-	tail *DoublyNode // Ponteiro para o último nó
-	size int         // Contador de elementos
+type DoublyLinkedList[T comparable] struct {
+	head     *DoublyNode[T]       // Ponteiro para o primeiro nó
+	tail     *DoublyNode[T]       // Ponteiro para o último nó
+	size     int                  // Contador de elementos
+	modCount int                  // Contador de modificações estruturais (ver ListIterator)
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewDoublyLinkedList cria uma nova instância de DoublyLinkedList
-func NewDoublyLinkedList() *DoublyLinkedList {
-	return &DoublyLinkedList{
+func NewDoublyLinkedList[T comparable]() *DoublyLinkedList[T] {
+	return &DoublyLinkedList[T]{
 		head: nil,
 		tail: nil,
 		size: 0,
 	}
 }
 
+// SetCounters associa contadores de operações à lista (nil desliga a contagem)
+// Conta nós alocados, saltos de ponteiro, comparações de busca e chamadas
+// ao comparador na ordenação
+func (list *DoublyLinkedList[T]) SetCounters(counters *instrument.Counters) {
+	list.counters = counters
+}
+
 // Size retorna o número de elementos na lista
 // Complexidade: Θ(1)
-func (list *DoublyLinkedList) Size() int {
+func (list *DoublyLinkedList[T]) Size() int {
 	return list.size
 }
 
 // IsEmpty verifica se a lista está vazia
 // Complexidade: Θ(1)
-func (list *DoublyLinkedList) IsEmpty() bool {
+func (list *DoublyLinkedList[T]) IsEmpty() bool {
 	return list.size == 0
 }
 
 // AddFirst adiciona elemento no início da lista
 // Complexidade: Θ(1)
-func (list *DoublyLinkedList) AddFirst(element int) {
+func (list *DoublyLinkedList[T]) AddFirst(element T) {
+	list.AddFirstNode(element)
+}
+
+// AddFirstNode adiciona elemento no início e retorna o nó criado
+// Guardando o nó, RemoveNode e MoveToFront funcionam em O(1) depois
+// (é assim que um cache LRU evita percorrer a lista)
+// Complexidade: Θ(1)
+func (list *DoublyLinkedList[T]) AddFirstNode(element T) *DoublyNode[T] {
+	defer checkInvariants(list)
 	newNode := NewDoublyNode(element)
+	list.counters.AddAllocations(1)
 	
 	if list.head == nil {
 		// Lista vazia
@@ -76,12 +105,16 @@ func (list *DoublyLinkedList) AddFirst(element int) {
 	}
 	
 	list.size++
+	list.modCount++
+	return newNode
 }
 
 // AddLast adiciona elemento no final da lista
 // Complexidade: Θ(1) - Vantagem sobre LinkedList simples!
-func (list *DoublyLinkedList) AddLast(element int) {
+func (list *DoublyLinkedList[T]) AddLast(element T) {
+	defer checkInvariants(list)
 	newNode := NewDoublyNode(element)
+	list.counters.AddAllocations(1)
 	
 	if list.tail == nil {
 		// Lista vazia
@@ -95,21 +128,23 @@ func (list *DoublyLinkedList) AddLast(element int) {
 	}
 	
 	list.size++
+	list.modCount++
 }
 
 // Add é um alias para AddLast para compatibilidade com interface List
-func (list *DoublyLinkedList) Add(element int) {
+func (list *DoublyLinkedList[T]) Add(element T) {
 	list.AddLast(element)
 }
 
 // Get obtém elemento na posição especificada
 // Complexidade: O(n/2) - Otimizado para escolher direção mais próxima
-func (list *DoublyLinkedList) Get(index int) (int, error) {
+func (list *DoublyLinkedList[T]) Get(index int) (T, error) {
 	if index < 0 || index >= list.size {
-		return 0, fmt.Errorf("índice inválido: %d", index)
+		var zero T
+		return zero, errs.Index(index, list.size)
 	}
 	
-	var current *DoublyNode
+	var current *DoublyNode[T]
 	
 	// Otimização: escolher direção mais próxima
 	if index < list.size/2 {
@@ -125,18 +160,20 @@ func (list *DoublyLinkedList) Get(index int) (int, error) {
 			current = current.prev
 		}
 	}
+	list.counters.AddTraversals(min(index, list.size-1-index))
 	
 	return current.data, nil
 }
 
 // Set define o valor do elemento na posição especificada
 // Complexidade: O(n/2)
-func (list *DoublyLinkedList) Set(index int, value int) error {
+func (list *DoublyLinkedList[T]) Set(index int, value T) error {
+	defer checkInvariants(list)
 	if index < 0 || index >= list.size {
-		return fmt.Errorf("índice inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 	
-	var current *DoublyNode
+	var current *DoublyNode[T]
 	
 	// Otimização: escolher direção mais próxima
 	if index < list.size/2 {
@@ -150,20 +187,22 @@ func (list *DoublyLinkedList) Set(index int, value int) error {
 			current = current.prev
 		}
 	}
+	list.counters.AddTraversals(min(index, list.size-1-index))
 	
 	current.data = value
+	list.counters.AddMoves(1)
 	return nil
 }
 
 // GetNode obtém referência ao nó na posição especificada
 // Útil para operações que precisam da referência do nó
 // Complexidade: O(n/2)
-func (list *DoublyLinkedList) GetNode(index int) (*DoublyNode, error) {
+func (list *DoublyLinkedList[T]) GetNode(index int) (*DoublyNode[T], error) {
 	if index < 0 || index >= list.size {
-		return nil, fmt.Errorf("índice inválido: %d", index)
+		return nil, errs.Index(index, list.size)
 	}
 	
-	var current *DoublyNode
+	var current *DoublyNode[T]
 	
 	if index < list.size/2 {
 		current = list.head
@@ -176,15 +215,17 @@ func (list *DoublyLinkedList) GetNode(index int) (*DoublyNode, error) {
 			current = current.prev
 		}
 	}
+	list.counters.AddTraversals(min(index, list.size-1-index))
 	
 	return current, nil
 }
 
 // AddOnIndex adiciona elemento em posição específica
 // Complexidade: O(n/2) - Otimizado
-func (list *DoublyLinkedList) AddOnIndex(element int, index int) error {
+func (list *DoublyLinkedList[T]) AddOnIndex(element T, index int) error {
+	defer checkInvariants(list)
 	if index < 0 || index > list.size {
-		return fmt.Errorf("índice inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 	
 	if index == 0 {
@@ -198,7 +239,7 @@ func (list *DoublyLinkedList) AddOnIndex(element int, index int) error {
 	}
 	
 	// Encontrar posição
-	var current *DoublyNode
+	var current *DoublyNode[T]
 	if index < list.size/2 {
 		current = list.head
 		for i := 0; i < index; i++ {
@@ -210,23 +251,28 @@ func (list *DoublyLinkedList) AddOnIndex(element int, index int) error {
 			current = current.prev
 		}
 	}
+	list.counters.AddTraversals(min(index, list.size-1-index))
 	
 	// Inserir antes de current
 	newNode := NewDoublyNode(element)
+	list.counters.AddAllocations(1)
 	newNode.next = current
 	newNode.prev = current.prev
 	current.prev.next = newNode
 	current.prev = newNode
 	
 	list.size++
+	list.modCount++
 	return nil
 }
 
 // RemoveNode remove nó específico da lista
 // Complexidade: Θ(1) - GRANDE VANTAGEM da Doubly LinkedList!
-func (list *DoublyLinkedList) RemoveNode(node *DoublyNode) (int, error) {
+func (list *DoublyLinkedList[T]) RemoveNode(node *DoublyNode[T]) (T, error) {
+	defer checkInvariants(list)
 	if node == nil {
-		return 0, fmt.Errorf("nó inválido")
+		var zero T
+		return zero, errInvalidNode
 	}
 	
 	removedData := node.data
@@ -246,14 +292,46 @@ func (list *DoublyLinkedList) RemoveNode(node *DoublyNode) (int, error) {
 	}
 	
 	list.size--
+	list.modCount++
 	return removedData, nil
 }
 
+// MoveToFront move um nó desta lista para o início sem alocar
+// O nó deve pertencer à lista (obtido de AddFirstNode, GetNode ou FindNode)
+// Complexidade: Θ(1)
+func (list *DoublyLinkedList[T]) MoveToFront(node *DoublyNode[T]) error {
+	defer checkInvariants(list)
+	if node == nil {
+		return errInvalidNode
+	}
+	if node == list.head {
+		return nil
+	}
+	
+	// Desligar o nó (não é o head, então node.prev != nil)
+	node.prev.next = node.next
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		list.tail = node.prev
+	}
+	
+	// Religar no início
+	node.prev = nil
+	node.next = list.head
+	list.head.prev = node
+	list.head = node
+	list.modCount++
+	return nil
+}
+
 // RemoveFirst remove o primeiro elemento
 // Complexidade: Θ(1)
-func (list *DoublyLinkedList) RemoveFirst() (int, error) {
+func (list *DoublyLinkedList[T]) RemoveFirst() (T, error) {
+	defer checkInvariants(list)
 	if list.head == nil {
-		return 0, errors.New("Lista vazia")
+		var zero T
+		return zero, errEmptyList
 	}
 	
 	return list.RemoveNode(list.head)
@@ -261,9 +339,11 @@ func (list *DoublyLinkedList) RemoveFirst() (int, error) {
 
 // RemoveLast remove o último elemento
 // Complexidade: Θ(1) - Vantagem sobre LinkedList simples!
-func (list *DoublyLinkedList) RemoveLast() (int, error) {
+func (list *DoublyLinkedList[T]) RemoveLast() (T, error) {
+	defer checkInvariants(list)
 	if list.tail == nil {
-		return 0, errors.New("Lista vazia")
+		var zero T
+		return zero, errEmptyList
 	}
 	
 	return list.RemoveNode(list.tail)
@@ -271,9 +351,10 @@ func (list *DoublyLinkedList) RemoveLast() (int, error) {
 
 // Remove remove elemento de posição específica
 // Complexidade: O(n/2)
-func (list *DoublyLinkedList) Remove(index int) error {
+func (list *DoublyLinkedList[T]) Remove(index int) error {
+	defer checkInvariants(list)
 	if index < 0 || index >= list.size {
-		return errors.New(fmt.Sprintf("Índice inválido: %d", index))
+		return errs.Index(index, list.size)
 	}
 	
 	node, err := list.GetNode(index)
@@ -287,15 +368,18 @@ func (list *DoublyLinkedList) Remove(index int) error {
 
 // RemoveValue remove a primeira ocorrência do valor especificado
 // Complexidade: O(n)
-func (list *DoublyLinkedList) RemoveValue(value int) bool {
+func (list *DoublyLinkedList[T]) RemoveValue(value T) bool {
+	defer checkInvariants(list)
 	current := list.head
 	
 	for current != nil {
+		list.counters.AddComparisons(1)
 		if current.data == value {
 			list.RemoveNode(current)
 			return true
 		}
 		current = current.next
+		list.counters.AddTraversals(1)
 	}
 	
 	return false
@@ -303,29 +387,33 @@ func (list *DoublyLinkedList) RemoveValue(value int) bool {
 
 // Clear remove todos os elementos da lista
 // Complexidade: Θ(1)
-func (list *DoublyLinkedList) Clear() {
+func (list *DoublyLinkedList[T]) Clear() {
+	defer checkInvariants(list)
 	list.head = nil
 	list.tail = nil
 	list.size = 0
+	list.modCount++
 }
 
 // Contains verifica se a lista contém o valor especificado
 // Complexidade: O(n/2) - Busca bidirecional otimizada
-func (list *DoublyLinkedList) Contains(value int) bool {
+func (list *DoublyLinkedList[T]) Contains(value T) bool {
 	return list.FindNode(value) != nil
 }
 
 // IndexOf retorna o índice da primeira ocorrência do valor
 // Complexidade: O(n)
-func (list *DoublyLinkedList) IndexOf(value int) int {
+func (list *DoublyLinkedList[T]) IndexOf(value T) int {
 	current := list.head
 	index := 0
 	
 	for current != nil {
+		list.counters.AddComparisons(1)
 		if current.data == value {
 			return index
 		}
 		current = current.next
+		list.counters.AddTraversals(1)
 		index++
 	}
 	
@@ -334,7 +422,7 @@ func (list *DoublyLinkedList) IndexOf(value int) int {
 
 // FindNode encontra o primeiro nó com o valor especificado
 // Complexidade: O(n/2) - Busca bidirecional
-func (list *DoublyLinkedList) FindNode(value int) *DoublyNode {
+func (list *DoublyLinkedList[T]) FindNode(value T) *DoublyNode[T] {
 	if list.size == 0 {
 		return nil
 	}
@@ -344,6 +432,7 @@ func (list *DoublyLinkedList) FindNode(value int) *DoublyNode {
 	back := list.tail
 	
 	for front != nil && back != nil && front.prev != back {
+		list.counters.AddComparisons(2)
 		if front.data == value {
 			return front
 		}
@@ -357,9 +446,11 @@ func (list *DoublyLinkedList) FindNode(value int) *DoublyNode {
 		
 		front = front.next
 		back = back.prev
+		list.counters.AddTraversals(2)
 	}
 	
 	// Verificar nó do meio se necessário
+	list.counters.AddComparisons(1)
 	if front != nil && front.data == value {
 		return front
 	}
@@ -367,10 +458,25 @@ func (list *DoublyLinkedList) FindNode(value int) *DoublyNode {
 	return nil
 }
 
+// IndexOfNode retorna a posição de um nó desta lista (obtido de FindNode,
+// GetNode ou AddFirstNode), ou -1 se ele não pertencer a ela
+// Complexidade: O(n)
+func (list *DoublyLinkedList[T]) IndexOfNode(node *DoublyNode[T]) int {
+	index := 0
+	for current := list.head; current != nil; current = current.next {
+		if current == node {
+			return index
+		}
+		list.counters.AddTraversals(1)
+		index++
+	}
+	return -1
+}
+
 // ToSlice retorna uma cópia dos elementos como slice
 // Complexidade: Θ(n)
-func (list *DoublyLinkedList) ToSlice() []int {
-	result := make([]int, 0, list.size)
+func (list *DoublyLinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, list.size)
 	current := list.head
 	
 	for current != nil {
@@ -383,8 +489,8 @@ func (list *DoublyLinkedList) ToSlice() []int {
 
 // ToSliceReverse retorna uma cópia dos elementos em ordem reversa
 // Complexidade: Θ(n) - Vantagem da navegação bidirecional
-func (list *DoublyLinkedList) ToSliceReverse() []int {
-	result := make([]int, 0, list.size)
+func (list *DoublyLinkedList[T]) ToSliceReverse() []T {
+	result := make([]T, 0, list.size)
 	current := list.tail
 	
 	for current != nil {
@@ -395,9 +501,19 @@ func (list *DoublyLinkedList) ToSliceReverse() []int {
 	return result
 }
 
+// Links fotografa os nós como estão na memória, a partir do head e do
+// tail, com os ponteiros next e prev reais (ver links.Snapshot)
+// Complexidade: O(n)
+func (list *DoublyLinkedList[T]) Links() links.Snapshot[T] {
+	return links.Walk([]*DoublyNode[T]{list.head, list.tail},
+		func(node *DoublyNode[T]) T { return node.data },
+		func(node *DoublyNode[T]) *DoublyNode[T] { return node.next },
+		func(node *DoublyNode[T]) *DoublyNode[T] { return node.prev })
+}
+
 // String retorna uma representação em string da lista
 // Complexidade: O(n)
-func (list *DoublyLinkedList) String() string {
+func (list *DoublyLinkedList[T]) String() string {
 	if list.head == nil {
 		return "[]"
 	}
@@ -410,7 +526,7 @@ func (list *DoublyLinkedList) String() string {
 		if !first {
 			result += ", "
 		}
-		result += fmt.Sprintf("%d", current.data)
+		result += fmt.Sprintf("%v", current.data)
 		current = current.next
 		first = false
 	}
@@ -421,7 +537,7 @@ func (list *DoublyLinkedList) String() string {
 
 // StringReverse retorna representação em string em ordem reversa
 // Complexidade: O(n)
-func (list *DoublyLinkedList) StringReverse() string {
+func (list *DoublyLinkedList[T]) StringReverse() string {
 	if list.tail == nil {
 		return "[]"
 	}
@@ -434,7 +550,7 @@ func (list *DoublyLinkedList) StringReverse() string {
 		if !first {
 			result += ", "
 		}
-		result += fmt.Sprintf("%d", current.data)
+		result += fmt.Sprintf("%v", current.data)
 		current = current.prev
 		first = false
 	}
@@ -449,7 +565,8 @@ func (list *DoublyLinkedList) StringReverse() string {
 
 // Reverse inverte a ordem dos elementos na lista
 // Complexidade: O(n) - Mais simples que na LinkedList simples
-func (list *DoublyLinkedList) Reverse() {
+func (list *DoublyLinkedList[T]) Reverse() {
+	defer checkInvariants(list)
 	if list.head == nil {
 		return
 	}
@@ -466,13 +583,17 @@ func (list *DoublyLinkedList) Reverse() {
 	
 	// Trocar head e tail
 	list.head, list.tail = list.tail, list.head
+	list.modCount++
 }
 
 // GetMiddle retorna o elemento do meio da lista
+// Com tamanho par, é o primeiro dos dois do meio (posição MiddleIndex),
+// diferente de LinkedList.GetMiddle, que devolve o segundo
 // Complexidade: O(n/2)
-func (list *DoublyLinkedList) GetMiddle() (int, error) {
+func (list *DoublyLinkedList[T]) GetMiddle() (T, error) {
 	if list.head == nil {
-		return 0, errors.New("Lista vazia")
+		var zero T
+		return zero, errEmptyList
 	}
 	
 	// Usar navegação bidirecional para encontrar o meio
@@ -487,9 +608,20 @@ func (list *DoublyLinkedList) GetMiddle() (int, error) {
 	return front.data, nil
 }
 
+// MiddleIndex retorna a posição do elemento devolvido por GetMiddle
+// ((Size()-1)/2: front e back andam juntos até se encontrarem ou ficarem
+// vizinhos, e front fica na metade da esquerda), ou -1 se a lista estiver vazia
+// Complexidade: O(1)
+func (list *DoublyLinkedList[T]) MiddleIndex() int {
+	if list.size == 0 {
+		return -1
+	}
+	return (list.size - 1) / 2
+}
+
 // IsPalindrome verifica se a lista é um palíndromo
 // Complexidade: O(n/2) - Vantagem da navegação bidirecional
-func (list *DoublyLinkedList) IsPalindrome() bool {
+func (list *DoublyLinkedList[T]) IsPalindrome() bool {
 	if list.size <= 1 {
 		return true
 	}
@@ -510,7 +642,8 @@ func (list *DoublyLinkedList) IsPalindrome() bool {
 
 // RemoveDuplicates remove elementos duplicados da lista
 // Complexidade: O(n²) - versão simples
-func (list *DoublyLinkedList) RemoveDuplicates() {
+func (list *DoublyLinkedList[T]) RemoveDuplicates() {
+	defer checkInvariants(list)
 	if list.head == nil {
 		return
 	}
@@ -535,7 +668,8 @@ func (list *DoublyLinkedList) RemoveDuplicates() {
 
 // RotateLeft rotaciona a lista n posições para a esquerda
 // Complexidade: O(n)
-func (list *DoublyLinkedList) RotateLeft(positions int) {
+func (list *DoublyLinkedList[T]) RotateLeft(positions int) {
+	defer checkInvariants(list)
 	if list.size <= 1 || positions <= 0 {
 		return
 	}
@@ -561,11 +695,13 @@ func (list *DoublyLinkedList) RotateLeft(positions int) {
 	
 	list.head = newHead
 	list.tail = newTail
+	list.modCount++
 }
 
 // RotateRight rotaciona a lista n posições para a direita
 // Complexidade: O(n)
-func (list *DoublyLinkedList) RotateRight(positions int) {
+func (list *DoublyLinkedList[T]) RotateRight(positions int) {
+	defer checkInvariants(list)
 	if list.size <= 1 || positions <= 0 {
 		return
 	}
@@ -581,7 +717,8 @@ func (list *DoublyLinkedList) RotateRight(positions int) {
 
 // AddAll adiciona todos os elementos do slice fornecido no final
 // Complexidade: O(m) onde m é o tamanho do slice
-func (list *DoublyLinkedList) AddAll(elements []int) {
+func (list *DoublyLinkedList[T]) AddAll(elements []T) {
+	defer checkInvariants(list)
 	for _, element := range elements {
 		list.AddLast(element)
 	}
@@ -589,7 +726,8 @@ func (list *DoublyLinkedList) AddAll(elements []int) {
 
 // AddAllFirst adiciona todos os elementos do slice fornecido no início
 // Complexidade: O(m) onde m é o tamanho do slice
-func (list *DoublyLinkedList) AddAllFirst(elements []int) {
+func (list *DoublyLinkedList[T]) AddAllFirst(elements []T) {
+	defer checkInvariants(list)
 	// Adiciona em ordem reversa para manter a ordem original
 	for i := len(elements) - 1; i >= 0; i-- {
 		list.AddFirst(elements[i])
@@ -601,36 +739,37 @@ func (list *DoublyLinkedList) AddAllFirst(elements []int) {
 // ============================================================================
 
 // Iterator representa um iterador para DoublyLinkedList
-type DoublyIterator struct {
-	current *DoublyNode
-	list    *DoublyLinkedList
+type DoublyIterator[T comparable] struct {
+	current *DoublyNode[T]
+	list    *DoublyLinkedList[T]
 }
 
 // NewIterator cria um novo iterador começando do início
-func (list *DoublyLinkedList) NewIterator() *DoublyIterator {
-	return &DoublyIterator{
+func (list *DoublyLinkedList[T]) NewIterator() *DoublyIterator[T] {
+	return &DoublyIterator[T]{
 		current: list.head,
 		list:    list,
 	}
 }
 
 // NewReverseIterator cria um novo iterador começando do final
-func (list *DoublyLinkedList) NewReverseIterator() *DoublyIterator {
-	return &DoublyIterator{
+func (list *DoublyLinkedList[T]) NewReverseIterator() *DoublyIterator[T] {
+	return &DoublyIterator[T]{
 		current: list.tail,
 		list:    list,
 	}
 }
 
 // HasNext verifica se há próximo elemento
-func (iter *DoublyIterator) HasNext() bool {
+func (iter *DoublyIterator[T]) HasNext() bool {
 	return iter.current != nil
 }
 
 // Next retorna o próximo elemento
-func (iter *DoublyIterator) Next() (int, error) {
+func (iter *DoublyIterator[T]) Next() (T, error) {
 	if iter.current == nil {
-		return 0, errors.New("Não há próximo elemento")
+		var zero T
+		return zero, errs.New(ErrNoSuchElement, "não há próximo elemento", "no next element")
 	}
 	
 	value := iter.current.data
@@ -639,17 +778,57 @@ func (iter *DoublyIterator) Next() (int, error) {
 }
 
 // HasPrev verifica se há elemento anterior
-func (iter *DoublyIterator) HasPrev() bool {
+func (iter *DoublyIterator[T]) HasPrev() bool {
 	return iter.current != nil
 }
 
 // Prev retorna o elemento anterior
-func (iter *DoublyIterator) Prev() (int, error) {
+func (iter *DoublyIterator[T]) Prev() (T, error) {
 	if iter.current == nil {
-		return 0, errors.New("Não há elemento anterior")
+		var zero T
+		return zero, errs.New(ErrNoSuchElement, "não há elemento anterior", "no previous element")
 	}
 	
 	value := iter.current.data
 	iter.current = iter.current.prev
 	return value, nil
-}
\ No newline at end of file
+}
+
+// All retorna um iterador sobre pares (índice, elemento) do início ao fim
+// Alternativa sem alocação ao DoublyIterator: for i, v := range list.All()
+func (list *DoublyLinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for current := list.head; current != nil; current = current.next {
+			if !yield(index, current.data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início ao fim
+func (list *DoublyLinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := list.head; current != nil; current = current.next {
+			if !yield(current.data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward retorna um iterador sobre pares (índice, elemento) do fim ao início
+// Usa os ponteiros prev a partir do tail
+func (list *DoublyLinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := list.size - 1
+		for current := list.tail; current != nil; current = current.prev {
+			if !yield(index, current.data) {
+				return
+			}
+			index--
+		}
+	}
+}
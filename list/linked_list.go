@@ -2,6 +2,11 @@ package list
 
 import (
 	"fmt"
+	"iter"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/links"
 )
 
 // ============================================================================
@@ -10,14 +15,14 @@ import (
 
 // Node representa um nó na lista ligada
 // Cada nó contém um valor e um ponteiro para o próximo nó
-type Node struct {
-	value int   // Valor armazenado no nó
-	next  *Node // Ponteiro para o próximo nó (nil se for o último)
+type Node[T comparable] struct {
+	value T        // Valor armazenado no nó
+	next  *Node[T] // Ponteiro para o próximo nó (nil se for o último)
 }
 
 // NewNode cria um novo nó com o valor especificado
-func NewNode(value int) *Node {
-	return &Node{
+func NewNode[T comparable](value T) *Node[T] {
+	return &Node[T]{
 		value: value,
 		next:  nil,
 	}
@@ -29,28 +34,37 @@ func NewNode(value int) *Node {
 // - Acesso sequencial O(n)
 // - Uso dinâmico de memória (aloca conforme necessário)
 // - Não há desperdício de memória
-type LinkedList struct {
-	head   *Node // Ponteiro para o primeiro nó
-	size   int   // Contador de elementos (para Size() em O(1))
+type LinkedList[T comparable] struct {
+	head     *Node[T]             // Ponteiro para o primeiro nó
+	size     int                  // Contador de elementos (para Size() em O(1))
+	modCount int                  // Contador de modificações estruturais (ver ListIterator)
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewLinkedList cria uma nova instância de LinkedList
-func NewLinkedList() *LinkedList {
-	return &LinkedList{
+func NewLinkedList[T comparable]() *LinkedList[T] {
+	return &LinkedList[T]{
 		head: nil,
 		size: 0,
 	}
 }
 
+// SetCounters associa contadores de operações à lista (nil desliga a contagem)
+// Conta nós alocados, saltos de ponteiro, comparações de busca e chamadas
+// ao comparador na ordenação
+func (list *LinkedList[T]) SetCounters(counters *instrument.Counters) {
+	list.counters = counters
+}
+
 // Size retorna o número de elementos na lista
 // Complexidade: Θ(1) - Mantemos um contador
-func (list *LinkedList) Size() int { // Θ(1)
+func (list *LinkedList[T]) Size() int { // Θ(1)
 	return list.size
 }
 
 // IsEmpty verifica se a lista está vazia
 // Complexidade: Θ(1)
-func (list *LinkedList) IsEmpty() bool {
+func (list *LinkedList[T]) IsEmpty() bool {
 	return list.head == nil
 }
 
@@ -60,31 +74,36 @@ func (list *LinkedList) IsEmpty() bool {
 // 1. Validar índice
 // 2. Percorrer lista do início até a posição desejada
 // 3. Retornar valor do nó encontrado
-func (list *LinkedList) Get(index int) (int, error) { // O(n), Ω(1)
+func (list *LinkedList[T]) Get(index int) (T, error) { // O(n), Ω(1)
 	if index >= 0 && index < list.size {
 		aux := list.head
 		// Percorre a lista até a posição desejada
 		for i := 0; i < index; i++ {
 			aux = aux.next
 		}
+		list.counters.AddTraversals(index)
 		return aux.value, nil
 	} else {
-		return -1, fmt.Errorf("index inválido: %d", index)
+		var zero T
+		return zero, errs.Index(index, list.size)
 	}
 }
 
 // Set define o valor do elemento na posição especificada
 // Complexidade: O(n)
-func (list *LinkedList) Set(index int, value int) error {
+func (list *LinkedList[T]) Set(index int, value T) error {
+	defer checkInvariants(list)
 	if index >= 0 && index < list.size {
 		aux := list.head
 		for i := 0; i < index; i++ {
 			aux = aux.next
 		}
+		list.counters.AddTraversals(index)
+		list.counters.AddMoves(1)
 		aux.value = value
 		return nil
 	} else {
-		return fmt.Errorf("index inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 }
 
@@ -95,11 +114,14 @@ func (list *LinkedList) Set(index int, value int) error {
 // 2. Conectar novo nó ao head atual
 // 3. Atualizar head para o novo nó
 // 4. Incrementar contador
-func (list *LinkedList) AddFirst(val int) {
+func (list *LinkedList[T]) AddFirst(val T) {
+	defer checkInvariants(list)
 	newNode := NewNode(val)
+	list.counters.AddAllocations(1)
 	newNode.next = list.head
 	list.head = newNode
 	list.size++
+	list.modCount++
 }
 
 // Add adiciona elemento no final da lista
@@ -109,8 +131,10 @@ func (list *LinkedList) AddFirst(val int) {
 // 2. Se lista vazia: novo nó vira head
 // 3. Senão: percorrer até o último nó e conectar novo nó
 // 4. Incrementar contador
-func (list *LinkedList) Add(val int) {
+func (list *LinkedList[T]) Add(val T) {
+	defer checkInvariants(list)
 	newNode := NewNode(val)
+	list.counters.AddAllocations(1)
 	
 	if list.head == nil {
 		list.head = newNode // Lista vazia - novo nó vira o primeiro
@@ -120,10 +144,12 @@ func (list *LinkedList) Add(val int) {
 		for aux.next != nil {
 			aux = aux.next
 		}
+		list.counters.AddTraversals(list.size - 1)
 		// Conecta novo nó ao final
 		aux.next = newNode
 	}
 	list.size++
+	list.modCount++
 }
 
 // AddOnIndex adiciona elemento em posição específica
@@ -133,7 +159,8 @@ func (list *LinkedList) Add(val int) {
 // 2. Se índice 0: inserir no início
 // 3. Senão: percorrer até posição anterior e inserir
 // 4. Incrementar contador
-func (list *LinkedList) AddOnIndex(val int, index int) error {
+func (list *LinkedList[T]) AddOnIndex(val T, index int) error {
+	defer checkInvariants(list)
 	if index >= 0 && index <= list.size {
 		if index == 0 {
 			// Inserção no início - O(1)
@@ -142,32 +169,38 @@ func (list *LinkedList) AddOnIndex(val int, index int) error {
 		}
 		
 		newNode := NewNode(val)
+		list.counters.AddAllocations(1)
 		
 		// Percorre até a posição anterior
 		aux := list.head
 		for i := 0; i < index-1; i++ {
 			aux = aux.next
 		}
+		list.counters.AddTraversals(index - 1)
 		// Insere novo nó entre aux e aux.next
 		newNode.next = aux.next
 		aux.next = newNode
 		list.size++
+		list.modCount++
 		return nil
 	} else {
-		return fmt.Errorf("index inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 }
 
 // RemoveFirst remove o primeiro elemento da lista
 // Complexidade: Θ(1)
-func (list *LinkedList) RemoveFirst() (int, error) {
+func (list *LinkedList[T]) RemoveFirst() (T, error) {
+	defer checkInvariants(list)
 	if list.head == nil {
-		return 0, fmt.Errorf("lista vazia")
+		var zero T
+		return zero, errEmptyList
 	}
 	
 	removedValue := list.head.value
 	list.head = list.head.next
 	list.size--
+	list.modCount++
 	return removedValue, nil
 }
 
@@ -178,7 +211,8 @@ func (list *LinkedList) RemoveFirst() (int, error) {
 // 2. Se índice 0: remover primeiro nó
 // 3. Senão: percorrer até posição anterior e reconectar ponteiros
 // 4. Decrementar contador
-func (list *LinkedList) Remove(index int) error {
+func (list *LinkedList[T]) Remove(index int) error {
+	defer checkInvariants(list)
 	if index >= 0 && index < list.size {
 		if index == 0 {
 			// Remoção do primeiro nó - O(1)
@@ -190,24 +224,28 @@ func (list *LinkedList) Remove(index int) error {
 			for i := 0; i < index-1; i++ {
 				aux = aux.next
 			}
+			list.counters.AddTraversals(index - 1)
 			// Remove o nó reconectando os ponteiros
 			aux.next = aux.next.next
 			list.size--
+			list.modCount++
 			return nil
 		}
 	} else {
-		return fmt.Errorf("index inválido: %d", index)
+		return errs.Index(index, list.size)
 	}
 }
 
 // RemoveValue remove a primeira ocorrência do valor especificado
 // Complexidade: O(n)
-func (list *LinkedList) RemoveValue(value int) bool {
+func (list *LinkedList[T]) RemoveValue(value T) bool {
+	defer checkInvariants(list)
 	if list.head == nil {
 		return false
 	}
 	
 	// Caso especial: remover o primeiro nó
+	list.counters.AddComparisons(1)
 	if list.head.value == value {
 		list.RemoveFirst()
 		return true
@@ -216,12 +254,15 @@ func (list *LinkedList) RemoveValue(value int) bool {
 	// Procurar o valor nos nós seguintes
 	current := list.head
 	for current.next != nil {
+		list.counters.AddComparisons(1)
 		if current.next.value == value {
 			current.next = current.next.next
 			list.size--
+			list.modCount++
 			return true
 		}
 		current = current.next
+		list.counters.AddTraversals(1)
 	}
 	
 	return false
@@ -229,35 +270,41 @@ func (list *LinkedList) RemoveValue(value int) bool {
 
 // Clear remove todos os elementos da lista
 // Complexidade: Θ(1)
-func (list *LinkedList) Clear() {
+func (list *LinkedList[T]) Clear() {
+	defer checkInvariants(list)
 	list.head = nil
 	list.size = 0
+	list.modCount++
 }
 
 // Contains verifica se a lista contém o valor especificado
 // Complexidade: O(n)
-func (list *LinkedList) Contains(value int) bool {
+func (list *LinkedList[T]) Contains(value T) bool {
 	current := list.head
 	for current != nil {
+		list.counters.AddComparisons(1)
 		if current.value == value {
 			return true
 		}
 		current = current.next
+		list.counters.AddTraversals(1)
 	}
 	return false
 }
 
 // IndexOf retorna o índice da primeira ocorrência do valor
 // Complexidade: O(n)
-func (list *LinkedList) IndexOf(value int) int {
+func (list *LinkedList[T]) IndexOf(value T) int {
 	current := list.head
 	index := 0
 	
 	for current != nil {
+		list.counters.AddComparisons(1)
 		if current.value == value {
 			return index
 		}
 		current = current.next
+		list.counters.AddTraversals(1)
 		index++
 	}
 	
@@ -266,8 +313,8 @@ func (list *LinkedList) IndexOf(value int) int {
 
 // ToSlice retorna uma cópia dos elementos como slice
 // Complexidade: Θ(n)
-func (list *LinkedList) ToSlice() []int {
-	result := make([]int, 0, list.size)
+func (list *LinkedList[T]) ToSlice() []T {
+	result := make([]T, 0, list.size)
 	current := list.head
 	
 	for current != nil {
@@ -278,9 +325,19 @@ func (list *LinkedList) ToSlice() []int {
 	return result
 }
 
+// Links fotografa os nós como estão na memória, a partir do head, com os
+// ponteiros next reais (ver links.Snapshot); usado pelo pacote export
+// Complexidade: O(n)
+func (list *LinkedList[T]) Links() links.Snapshot[T] {
+	return links.Walk([]*Node[T]{list.head},
+		func(node *Node[T]) T { return node.value },
+		func(node *Node[T]) *Node[T] { return node.next },
+		nil)
+}
+
 // String retorna uma representação em string da lista
 // Complexidade: O(n)
-func (list *LinkedList) String() string {
+func (list *LinkedList[T]) String() string {
 	if list.head == nil {
 		return "[]"
 	}
@@ -293,7 +350,7 @@ func (list *LinkedList) String() string {
 		if !first {
 			result += ", "
 		}
-		result += fmt.Sprintf("%d", current.value)
+		result += fmt.Sprintf("%v", current.value)
 		current = current.next
 		first = false
 	}
@@ -304,12 +361,13 @@ func (list *LinkedList) String() string {
 
 // Reverse inverte a ordem dos elementos na lista
 // Complexidade: O(n)
-func (list *LinkedList) Reverse() {
+func (list *LinkedList[T]) Reverse() {
+	defer checkInvariants(list)
 	if list.head == nil || list.head.next == nil {
 		return // Lista vazia ou com um elemento
 	}
 	
-	var prev *Node = nil
+	var prev *Node[T] = nil
 	current := list.head
 	
 	for current != nil {
@@ -320,14 +378,17 @@ func (list *LinkedList) Reverse() {
 	}
 	
 	list.head = prev
+	list.modCount++
 }
 
 // GetMiddle retorna o elemento do meio da lista
+// Com tamanho par, é o segundo dos dois do meio (posição MiddleIndex)
 // Complexidade: O(n)
 // Usa algoritmo "tortoise and hare" (Floyd's algorithm)
-func (list *LinkedList) GetMiddle() (int, error) {
+func (list *LinkedList[T]) GetMiddle() (T, error) {
 	if list.head == nil {
-		return 0, fmt.Errorf("lista vazia")
+		var zero T
+		return zero, errEmptyList
 	}
 	
 	slow := list.head
@@ -342,10 +403,21 @@ func (list *LinkedList) GetMiddle() (int, error) {
 	return slow.value, nil
 }
 
+// MiddleIndex retorna a posição do elemento devolvido por GetMiddle
+// (Size()/2: o fast chega ao fim depois de Size()/2 passos do slow), ou -1
+// se a lista estiver vazia
+// Complexidade: O(1)
+func (list *LinkedList[T]) MiddleIndex() int {
+	if list.size == 0 {
+		return -1
+	}
+	return list.size / 2
+}
+
 // HasCycle detecta se há um ciclo na lista
 // Complexidade: O(n)
 // Usa algoritmo de Floyd (tortoise and hare)
-func (list *LinkedList) HasCycle() bool {
+func (list *LinkedList[T]) HasCycle() bool {
 	if list.head == nil {
 		return false
 	}
@@ -367,7 +439,8 @@ func (list *LinkedList) HasCycle() bool {
 
 // RemoveDuplicates remove elementos duplicados da lista
 // Complexidade: O(n²) - versão simples
-func (list *LinkedList) RemoveDuplicates() {
+func (list *LinkedList[T]) RemoveDuplicates() {
+	defer checkInvariants(list)
 	if list.head == nil {
 		return
 	}
@@ -382,6 +455,7 @@ func (list *LinkedList) RemoveDuplicates() {
 			if runner.next.value == current.value {
 				runner.next = runner.next.next
 				list.size--
+				list.modCount++
 			} else {
 				runner = runner.next
 			}
@@ -393,7 +467,8 @@ func (list *LinkedList) RemoveDuplicates() {
 
 // AddAll adiciona todos os elementos do slice fornecido no final
 // Complexidade: O(n + m) onde n é o tamanho atual e m é o tamanho do slice
-func (list *LinkedList) AddAll(elements []int) {
+func (list *LinkedList[T]) AddAll(elements []T) {
+	defer checkInvariants(list)
 	for _, element := range elements {
 		list.Add(element)
 	}
@@ -401,9 +476,40 @@ func (list *LinkedList) AddAll(elements []int) {
 
 // AddAllFirst adiciona todos os elementos do slice fornecido no início
 // Complexidade: O(m) onde m é o tamanho do slice
-func (list *LinkedList) AddAllFirst(elements []int) {
+func (list *LinkedList[T]) AddAllFirst(elements []T) {
+	defer checkInvariants(list)
 	// Adiciona em ordem reversa para manter a ordem original
 	for i := len(elements) - 1; i >= 0; i-- {
 		list.AddFirst(elements[i])
 	}
-}
\ No newline at end of file
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (índice, elemento) do início ao fim
+// Percorre os nós diretamente: O(n) no total, em vez de O(n²) com Get(i)
+// Não há Backward, pois os nós só apontam para o próximo
+func (list *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for current := list.head; current != nil; current = current.next {
+			if !yield(index, current.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início ao fim
+func (list *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := list.head; current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package list_test
+
+import (
+	"testing"
+
+	"dca3503/capacity"
+	"dca3503/list"
+	"dca3503/list/listtest"
+)
+
+func TestArrayListConformance(t *testing.T) {
+	listtest.Run(t, func() list.List[int] { return list.NewArrayList[int](2) })
+}
+
+func TestArrayListZeroCapacityConformance(t *testing.T) {
+	listtest.Run(t, func() list.List[int] { return list.NewArrayList[int](0) })
+}
+
+func TestArrayListGrowthPolicyConformance(t *testing.T) {
+	// Crescimento linear e redução automática: muitos redimensionamentos
+	policy := capacity.Policy{Growth: capacity.Increment{Step: 3}, ShrinkAt: 0.25}
+	listtest.Run(t, func() list.List[int] {
+		l := list.NewArrayList[int](0)
+		if err := l.SetGrowthPolicy(policy); err != nil {
+			t.Fatal(err)
+		}
+		return l
+	})
+}
+
+func TestLinkedListConformance(t *testing.T) {
+	listtest.Run(t, func() list.List[int] { return list.NewLinkedList[int]() })
+}
+
+func TestDoublyLinkedListConformance(t *testing.T) {
+	listtest.Run(t, func() list.List[int] { return list.NewDoublyLinkedList[int]() })
+}
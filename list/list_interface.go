@@ -1,6 +1,10 @@
 package list
 
-import "fmt"
+import (
+	"fmt"
+
+	"dca3503/errs"
+)
 
 // ============================================================================
 // INTERFACE LIST - TIPO ABSTRATO DE DADOS
@@ -9,38 +13,90 @@ import "fmt"
 // List define o contrato que todas as implementações de lista devem seguir
 // Esta é uma abstração que permite polimorfismo - diferentes implementações
 // podem ser usadas de forma intercambiável
-type List interface {
+// O parâmetro de tipo T precisa ser comparable para que Contains/IndexOf
+// possam usar o operador ==
+type List[T comparable] interface {
 	// Operações de consulta
-	Size() int                    // Retorna o número de elementos na lista
-	IsEmpty() bool               // Verifica se a lista está vazia
-	Get(index int) (int, error)  // Obtém elemento em uma posição específica
+	Size() int                  // Retorna o número de elementos na lista
+	IsEmpty() bool              // Verifica se a lista está vazia
+	Get(index int) (T, error)   // Obtém elemento em uma posição específica
 	
 	// Operações de modificação
-	Add(element int)                          // Adiciona elemento no final da lista
-	AddOnIndex(element int, index int) error  // Adiciona elemento em posição específica
-	Remove(index int) error                   // Remove elemento de posição específica
-	Clear()                                   // Remove todos os elementos
+	Add(element T)                          // Adiciona elemento no final da lista
+	AddOnIndex(element T, index int) error  // Adiciona elemento em posição específica
+	Remove(index int) error                 // Remove elemento de posição específica
+	Clear()                                 // Remove todos os elementos
 	
 	// Operações de busca
-	Contains(element int) bool // Verifica se contém elemento
-	IndexOf(element int) int   // Encontra posição do elemento
+	Contains(element T) bool // Verifica se contém elemento
+	IndexOf(element T) int   // Encontra posição do elemento
 	
 	// Operações de conversão
-	ToSlice() []int // Converte para slice
+	ToSlice() []T   // Converte para slice
 	String() string // Representação em string
 }
 
+// Ordered agrupa os tipos que suportam os operadores < e >
+// Usado pelas funções que precisam comparar elementos (ex: SortList)
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// ============================================================================
+// ERROS
+// ============================================================================
+
+// Erros das listas; índices inválidos viram *errs.IndexError
+// Use errors.Is(err, errs.ErrEmpty) e errors.Is(err, errs.ErrIndexOutOfRange)
+var (
+	errEmptyList   = errs.New(errs.ErrEmpty, "lista vazia", "empty list")
+	errInvalidNode = errs.Define("nó inválido", "invalid node")
+)
+
+// ============================================================================
+// ALIASES DE COMPATIBILIDADE PARA LISTAS DE INTEIROS
+// ============================================================================
+
+// IntList é a lista de inteiros usada antes da versão genérica
+type IntList = List[int]
+
+// IntArrayList é o ArrayList de inteiros
+type IntArrayList = ArrayList[int]
+
+// IntLinkedList é o LinkedList de inteiros
+type IntLinkedList = LinkedList[int]
+
+// IntDoublyLinkedList é o DoublyLinkedList de inteiros
+type IntDoublyLinkedList = DoublyLinkedList[int]
+
+// NewIntArrayList cria um ArrayList de inteiros
+func NewIntArrayList(initialCapacity int) *IntArrayList {
+	return NewArrayList[int](initialCapacity)
+}
+
+// NewIntLinkedList cria um LinkedList de inteiros
+func NewIntLinkedList() *IntLinkedList {
+	return NewLinkedList[int]()
+}
+
+// NewIntDoublyLinkedList cria um DoublyLinkedList de inteiros
+func NewIntDoublyLinkedList() *IntDoublyLinkedList {
+	return NewDoublyLinkedList[int]()
+}
+
 // ============================================================================
 // FUNÇÕES UTILITÁRIAS QUE TRABALHAM COM A INTERFACE
 // ============================================================================
 
 // PrintList imprime uma lista usando a interface
-func PrintList(list List, name string) {
+func PrintList[T comparable](list List[T], name string) {
 	fmt.Printf("%s: %s (tamanho: %d)\n", name, list.String(), list.Size())
 }
 
 // CopyList copia elementos de uma lista para outra
-func CopyList(source List, destination List) {
+func CopyList[T comparable](source List[T], destination List[T]) {
 	destination.Clear()
 	for i := 0; i < source.Size(); i++ {
 		value, _ := source.Get(i)
@@ -49,7 +105,7 @@ func CopyList(source List, destination List) {
 }
 
 // ReverseList inverte os elementos de uma lista usando apenas a interface
-func ReverseList(list List) {
+func ReverseList[T comparable](list List[T]) {
 	size := list.Size()
 	for i := 0; i < size/2; i++ {
 		// Trocar elementos nas posições i e size-1-i
@@ -64,8 +120,17 @@ func ReverseList(list List) {
 	}
 }
 
-// SortList ordena os elementos de uma lista usando o algoritmo de seleção
-func SortList(list List) {
+// SortList ordena os elementos em ordem crescente
+// Delega para SortListFunc, que escolhe o algoritmo pelo tipo concreto
+// Complexidade: O(n log n)
+func SortList[T Ordered](list List[T]) {
+	SortListFunc(list, Less[T])
+}
+
+// SelectionSortList ordena usando o algoritmo de seleção apenas pela interface
+// Mantido como referência didática: em LinkedList custa O(n³), pois cada
+// Get/Remove/AddOnIndex percorre a lista
+func SelectionSortList[T Ordered](list List[T]) {
 	size := list.Size()
 	for i := 0; i < size-1; i++ {
 		minIndex := i
@@ -90,4 +155,166 @@ func SortList(list List) {
 			list.AddOnIndex(currentValue, minIndex)
 		}
 	}
-}
\ No newline at end of file
+}
+// ============================================================================
+// ALGORITMOS GENÉRICOS SOBRE A INTERFACE
+// ============================================================================
+
+// Number agrupa os tipos numéricos que suportam soma e divisão
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// FindMax encontra o maior elemento da lista
+// Complexidade: O(n) acessos via Get
+func FindMax[T Ordered](list List[T]) (T, error) {
+	if list.IsEmpty() {
+		var zero T
+		return zero, errEmptyList
+	}
+	
+	max, _ := list.Get(0)
+	for i := 1; i < list.Size(); i++ {
+		value, _ := list.Get(i)
+		if value > max {
+			max = value
+		}
+	}
+	return max, nil
+}
+
+// FindMin encontra o menor elemento da lista
+// Complexidade: O(n) acessos via Get
+func FindMin[T Ordered](list List[T]) (T, error) {
+	if list.IsEmpty() {
+		var zero T
+		return zero, errEmptyList
+	}
+	
+	min, _ := list.Get(0)
+	for i := 1; i < list.Size(); i++ {
+		value, _ := list.Get(i)
+		if value < min {
+			min = value
+		}
+	}
+	return min, nil
+}
+
+// Sum calcula a soma de todos os elementos da lista
+func Sum[T Number](list List[T]) T {
+	var sum T
+	for _, value := range list.ToSlice() {
+		sum += value
+	}
+	return sum
+}
+
+// Average calcula a média dos elementos (0 para lista vazia)
+func Average[T Number](list List[T]) float64 {
+	if list.IsEmpty() {
+		return 0
+	}
+	return float64(Sum(list)) / float64(list.Size())
+}
+
+// IsSorted verifica se a lista está em ordem crescente
+func IsSorted[T Ordered](list List[T]) bool {
+	for i := 1; i < list.Size(); i++ {
+		previous, _ := list.Get(i - 1)
+		current, _ := list.Get(i)
+		if current < previous {
+			return false
+		}
+	}
+	return true
+}
+
+// BubbleSort ordena a lista trocando elementos vizinhos fora de ordem
+// Complexidade: O(n²) comparações, para quando uma passada não faz trocas
+func BubbleSort[T Ordered](list List[T]) {
+	size := list.Size()
+	for i := 0; i < size-1; i++ {
+		swapped := false
+		for j := 0; j < size-1-i; j++ {
+			left, _ := list.Get(j)
+			right, _ := list.Get(j + 1)
+			if left > right {
+				// Trocar elementos j e j+1
+				list.Remove(j)
+				list.AddOnIndex(right, j)
+				list.Remove(j + 1)
+				list.AddOnIndex(left, j+1)
+				swapped = true
+			}
+		}
+		if !swapped {
+			return
+		}
+	}
+}
+
+// BinarySearch procura um elemento em uma lista ordenada
+// Retorna o índice encontrado ou -1
+// Complexidade: O(log n) chamadas a Get
+func BinarySearch[T Ordered](list List[T], target T) int {
+	left := 0
+	right := list.Size() - 1
+	
+	for left <= right {
+		middle := (left + right) / 2
+		value, _ := list.Get(middle)
+		
+		if value == target {
+			return middle
+		} else if value < target {
+			left = middle + 1
+		} else {
+			right = middle - 1
+		}
+	}
+	
+	return -1
+}
+
+// MergeSorted mescla duas listas ordenadas em result (que é limpa antes)
+// Complexidade: O(n + m) chamadas a Get
+func MergeSorted[T Ordered](list1 List[T], list2 List[T], result List[T]) {
+	result.Clear()
+	i, j := 0, 0
+	
+	for i < list1.Size() && j < list2.Size() {
+		a, _ := list1.Get(i)
+		b, _ := list2.Get(j)
+		if a <= b {
+			result.Add(a)
+			i++
+		} else {
+			result.Add(b)
+			j++
+		}
+	}
+	
+	// Copia o que sobrou de cada lista
+	for ; i < list1.Size(); i++ {
+		value, _ := list1.Get(i)
+		result.Add(value)
+	}
+	for ; j < list2.Size(); j++ {
+		value, _ := list2.Get(j)
+		result.Add(value)
+	}
+}
+
+// RemoveAll remove todas as ocorrências de um elemento
+// Retorna quantos elementos foram removidos
+func RemoveAll[T comparable](list List[T], element T) int {
+	removed := 0
+	for index := list.IndexOf(element); index != -1; index = list.IndexOf(element) {
+		list.Remove(index)
+		removed++
+	}
+	return removed
+}
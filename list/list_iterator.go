@@ -0,0 +1,387 @@
+package list
+
+import "dca3503/errs"
+
+// ============================================================================
+// LISTITERATOR - ITERADOR FAIL-FAST COM EDIÇÃO NO CURSOR
+// ============================================================================
+
+// Erros retornados pelos ListIterators
+var (
+	// ErrConcurrentModification indica que a lista foi alterada por fora do iterador
+	ErrConcurrentModification = errs.Define("lista modificada fora do iterador", "list modified outside the iterator")
+	// ErrNoSuchElement indica que não há elemento na direção pedida
+	ErrNoSuchElement = errs.Define("não há mais elementos", "no more elements")
+	// ErrIllegalState indica Set/Remove sem um Next/Previous válido antes
+	ErrIllegalState = errs.Define("nenhum elemento retornado para Set/Remove", "no element returned for Set/Remove")
+)
+
+// ListIterator define um iterador que permite editar a lista durante o percurso
+// O cursor fica sempre entre dois elementos (como no java.util.ListIterator):
+//
+//	elementos:  A   B   C
+//	cursores: ^   ^   ^   ^
+//	          0   1   2   3
+//
+// - Next retorna o elemento à direita do cursor e avança
+// - Add insere na posição do cursor (antes do que Next retornaria)
+// - Set/Remove atuam sobre o último elemento retornado por Next/Previous
+//
+// O iterador é fail-fast: qualquer alteração estrutural feita na lista sem
+// passar por ele faz as operações seguintes retornarem ErrConcurrentModification
+type ListIterator[T comparable] interface {
+	HasNext() bool
+	Next() (T, error)
+	NextIndex() int
+	Set(value T) error
+	Add(value T) error
+	Remove() error
+}
+
+// ============================================================================
+// LINKEDLIST - ITERADOR SOMENTE PARA FRENTE
+// ============================================================================
+
+// LinkedListIterator implementa ListIterator para LinkedList
+// Como os nós só apontam para o próximo, o iterador guarda também o nó
+// anterior ao último retornado: assim Remove religa em O(1) sem percorrer
+// a lista. Não há Previous pelo mesmo motivo.
+type LinkedListIterator[T comparable] struct {
+	list             *LinkedList[T]
+	next             *Node[T] // Nó à direita do cursor (nil no fim)
+	previous         *Node[T] // Nó à esquerda do cursor (nil no início)
+	lastReturned     *Node[T] // Último nó retornado por Next (nil após Add/Remove)
+	beforeLast       *Node[T] // Nó anterior a lastReturned (nil se for o head)
+	index            int      // Posição do cursor
+	expectedModCount int      // modCount da lista conhecido pelo iterador
+}
+
+// NewListIterator cria um ListIterator com o cursor no início da lista
+// Complexidade: Θ(1)
+func (list *LinkedList[T]) NewListIterator() *LinkedListIterator[T] {
+	return &LinkedListIterator[T]{
+		list:             list,
+		next:             list.head,
+		expectedModCount: list.modCount,
+	}
+}
+
+// NewListIteratorAt cria um ListIterator com o cursor antes do elemento index
+// index pode ir de 0 a Size() (cursor no fim)
+// Complexidade: O(n)
+func (list *LinkedList[T]) NewListIteratorAt(index int) (*LinkedListIterator[T], error) {
+	if index < 0 || index > list.size {
+		return nil, errs.Index(index, list.size)
+	}
+	
+	it := list.NewListIterator()
+	for i := 0; i < index; i++ {
+		it.previous = it.next
+		it.next = it.next.next
+	}
+	list.counters.AddTraversals(index)
+	it.index = index
+	return it, nil
+}
+
+// HasNext verifica se há elemento à direita do cursor
+func (it *LinkedListIterator[T]) HasNext() bool {
+	return it.index < it.list.size
+}
+
+// Next retorna o elemento à direita do cursor e avança o cursor
+// Complexidade: Θ(1)
+func (it *LinkedListIterator[T]) Next() (T, error) {
+	var zero T
+	if err := it.checkModification(); err != nil {
+		return zero, err
+	}
+	if it.next == nil {
+		return zero, ErrNoSuchElement
+	}
+	
+	it.beforeLast = it.previous
+	it.lastReturned = it.next
+	it.previous = it.next
+	it.next = it.next.next
+	it.list.counters.AddTraversals(1)
+	it.index++
+	return it.lastReturned.value, nil
+}
+
+// NextIndex retorna o índice do elemento que Next retornaria
+func (it *LinkedListIterator[T]) NextIndex() int {
+	return it.index
+}
+
+// Set substitui o valor do último elemento retornado por Next
+// Não é uma modificação estrutural: outros iteradores continuam válidos
+// Complexidade: Θ(1)
+func (it *LinkedListIterator[T]) Set(value T) error {
+	defer checkInvariants(it.list)
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+	if it.lastReturned == nil {
+		return ErrIllegalState
+	}
+	
+	it.lastReturned.value = value
+	return nil
+}
+
+// Add insere o valor na posição do cursor; o cursor fica depois do novo elemento
+// Uma chamada seguinte a Next retorna o mesmo elemento que retornaria antes
+// Complexidade: Θ(1)
+func (it *LinkedListIterator[T]) Add(value T) error {
+	defer checkInvariants(it.list)
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+	
+	newNode := NewNode(value)
+	it.list.counters.AddAllocations(1)
+	newNode.next = it.next
+	if it.previous == nil {
+		it.list.head = newNode
+	} else {
+		it.previous.next = newNode
+	}
+	
+	it.previous = newNode
+	it.lastReturned = nil
+	it.index++
+	it.list.size++
+	it.list.modCount++
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// Remove remove o último elemento retornado por Next
+// Só pode ser chamado uma vez por Next e não logo após Add
+// Complexidade: Θ(1)
+func (it *LinkedListIterator[T]) Remove() error {
+	defer checkInvariants(it.list)
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+	if it.lastReturned == nil {
+		return ErrIllegalState
+	}
+	
+	// Religa beforeLast -> next, pulando lastReturned
+	if it.beforeLast == nil {
+		it.list.head = it.lastReturned.next
+	} else {
+		it.beforeLast.next = it.lastReturned.next
+	}
+	
+	it.previous = it.beforeLast
+	it.lastReturned = nil
+	it.index--
+	it.list.size--
+	it.list.modCount++
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// checkModification detecta alterações estruturais feitas fora do iterador
+func (it *LinkedListIterator[T]) checkModification() error {
+	if it.list.modCount != it.expectedModCount {
+		return ErrConcurrentModification
+	}
+	return nil
+}
+
+// ============================================================================
+// DOUBLYLINKEDLIST - ITERADOR BIDIRECIONAL
+// ============================================================================
+
+// DoublyListIterator implementa ListIterator para DoublyLinkedList
+// Além das operações de ListIterator, percorre a lista nos dois sentidos
+// Diferente do DoublyIterator (somente leitura), permite Set/Add/Remove em O(1)
+type DoublyListIterator[T comparable] struct {
+	list             *DoublyLinkedList[T]
+	next             *DoublyNode[T] // Nó à direita do cursor (nil no fim)
+	lastReturned     *DoublyNode[T] // Último nó retornado (nil após Add/Remove)
+	index            int            // Posição do cursor
+	expectedModCount int            // modCount da lista conhecido pelo iterador
+}
+
+// NewListIterator cria um ListIterator com o cursor no início da lista
+// Complexidade: Θ(1)
+func (list *DoublyLinkedList[T]) NewListIterator() *DoublyListIterator[T] {
+	return &DoublyListIterator[T]{
+		list:             list,
+		next:             list.head,
+		expectedModCount: list.modCount,
+	}
+}
+
+// NewListIteratorAt cria um ListIterator com o cursor antes do elemento index
+// index pode ir de 0 a Size(); com Size() o cursor fica no fim, pronto
+// para percorrer de trás para frente com Previous
+// Complexidade: O(n/2)
+func (list *DoublyLinkedList[T]) NewListIteratorAt(index int) (*DoublyListIterator[T], error) {
+	if index < 0 || index > list.size {
+		return nil, errs.Index(index, list.size)
+	}
+	
+	it := list.NewListIterator()
+	it.index = index
+	if index == list.size {
+		it.next = nil
+		return it, nil
+	}
+	
+	node, err := list.GetNode(index)
+	if err != nil {
+		return nil, err
+	}
+	it.next = node
+	return it, nil
+}
+
+// HasNext verifica se há elemento à direita do cursor
+func (it *DoublyListIterator[T]) HasNext() bool {
+	return it.index < it.list.size
+}
+
+// Next retorna o elemento à direita do cursor e avança o cursor
+// Complexidade: Θ(1)
+func (it *DoublyListIterator[T]) Next() (T, error) {
+	var zero T
+	if err := it.checkModification(); err != nil {
+		return zero, err
+	}
+	if it.next == nil {
+		return zero, ErrNoSuchElement
+	}
+	
+	it.lastReturned = it.next
+	it.next = it.next.next
+	it.list.counters.AddTraversals(1)
+	it.index++
+	return it.lastReturned.data, nil
+}
+
+// HasPrevious verifica se há elemento à esquerda do cursor
+func (it *DoublyListIterator[T]) HasPrevious() bool {
+	return it.index > 0
+}
+
+// Previous retorna o elemento à esquerda do cursor e recua o cursor
+// Complexidade: Θ(1)
+func (it *DoublyListIterator[T]) Previous() (T, error) {
+	var zero T
+	if err := it.checkModification(); err != nil {
+		return zero, err
+	}
+	if it.index == 0 {
+		return zero, ErrNoSuchElement
+	}
+	
+	// No fim da lista o elemento à esquerda é o tail
+	if it.next == nil {
+		it.next = it.list.tail
+	} else {
+		it.next = it.next.prev
+	}
+	it.list.counters.AddTraversals(1)
+	it.lastReturned = it.next
+	it.index--
+	return it.lastReturned.data, nil
+}
+
+// NextIndex retorna o índice do elemento que Next retornaria
+func (it *DoublyListIterator[T]) NextIndex() int {
+	return it.index
+}
+
+// PreviousIndex retorna o índice do elemento que Previous retornaria (-1 no início)
+func (it *DoublyListIterator[T]) PreviousIndex() int {
+	return it.index - 1
+}
+
+// Set substitui o valor do último elemento retornado por Next ou Previous
+// Não é uma modificação estrutural: outros iteradores continuam válidos
+// Complexidade: Θ(1)
+func (it *DoublyListIterator[T]) Set(value T) error {
+	defer checkInvariants(it.list)
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+	if it.lastReturned == nil {
+		return ErrIllegalState
+	}
+	
+	it.lastReturned.data = value
+	return nil
+}
+
+// Add insere o valor na posição do cursor; o cursor fica depois do novo elemento
+// Next continua retornando o mesmo elemento; Previous retornaria o novo
+// Complexidade: Θ(1)
+func (it *DoublyListIterator[T]) Add(value T) error {
+	defer checkInvariants(it.list)
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+	
+	if it.next == nil {
+		it.list.AddLast(value)
+	} else {
+		// Inserir antes de next
+		newNode := NewDoublyNode(value)
+		it.list.counters.AddAllocations(1)
+		newNode.next = it.next
+		newNode.prev = it.next.prev
+		if it.next.prev == nil {
+			it.list.head = newNode
+		} else {
+			it.next.prev.next = newNode
+		}
+		it.next.prev = newNode
+		it.list.size++
+		it.list.modCount++
+	}
+	
+	it.lastReturned = nil
+	it.index++
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// Remove remove o último elemento retornado por Next ou Previous
+// Só pode ser chamado uma vez por Next/Previous e não logo após Add
+// Complexidade: Θ(1)
+func (it *DoublyListIterator[T]) Remove() error {
+	defer checkInvariants(it.list)
+	if err := it.checkModification(); err != nil {
+		return err
+	}
+	if it.lastReturned == nil {
+		return ErrIllegalState
+	}
+	
+	if it.lastReturned == it.next {
+		// Veio de Previous: o cursor já está antes do removido
+		it.next = it.lastReturned.next
+	} else {
+		// Veio de Next: o removido estava à esquerda do cursor
+		it.index--
+	}
+	
+	it.list.RemoveNode(it.lastReturned)
+	it.lastReturned = nil
+	it.expectedModCount = it.list.modCount
+	return nil
+}
+
+// checkModification detecta alterações estruturais feitas fora do iterador
+func (it *DoublyListIterator[T]) checkModification() error {
+	if it.list.modCount != it.expectedModCount {
+		return ErrConcurrentModification
+	}
+	return nil
+}
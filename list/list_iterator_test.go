@@ -0,0 +1,276 @@
+package list_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/list"
+)
+
+// iteratorCase reúne um ListIterator e a lista que ele percorre
+// previous é nil para a LinkedList, que só anda para frente
+type iteratorCase struct {
+	name     string
+	it       list.ListIterator[int]
+	previous func() (int, error)
+	validate func() error
+	toSlice  func() []int
+}
+
+func newIteratorCases(elements []int) []iteratorCase {
+	linked := list.NewLinkedList[int]()
+	linked.AddAll(elements)
+	doubly := list.NewDoublyLinkedList[int]()
+	doubly.AddAll(elements)
+	doublyIt := doubly.NewListIterator()
+	return []iteratorCase{
+		{"LinkedList", linked.NewListIterator(), nil, linked.Validate, linked.ToSlice},
+		{"DoublyLinkedList", doublyIt, doublyIt.Previous, doubly.Validate, doubly.ToSlice},
+	}
+}
+
+// O modelo é um slice com o cursor e o índice do último elemento retornado
+// (-1 quando Set/Remove não são permitidos), como no java.util.ListIterator
+func TestListIteratorMatchesModel(t *testing.T) {
+	for _, c := range newIteratorCases([]int{1, 2, 3, 4, 5}) {
+		t.Run(c.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(3, 11))
+			model := []int{1, 2, 3, 4, 5}
+			cursor, last := 0, -1
+			
+			for step := 0; step < 3000; step++ {
+				value := rng.IntN(100)
+				switch rng.IntN(10) {
+				case 0, 1:
+					got, err := c.it.Next()
+					if cursor == len(model) {
+						if !errors.Is(err, list.ErrNoSuchElement) {
+							t.Fatalf("passo %d: Next no fim: (%d, %v)", step, got, err)
+						}
+						break
+					}
+					if err != nil || got != model[cursor] {
+						t.Fatalf("passo %d: Next = (%d, %v), esperado %d", step, got, err, model[cursor])
+					}
+					last = cursor
+					cursor++
+				case 2, 3:
+					if c.previous == nil {
+						continue
+					}
+					got, err := c.previous()
+					if cursor == 0 {
+						if !errors.Is(err, list.ErrNoSuchElement) {
+							t.Fatalf("passo %d: Previous no início: (%d, %v)", step, got, err)
+						}
+						break
+					}
+					cursor--
+					if err != nil || got != model[cursor] {
+						t.Fatalf("passo %d: Previous = (%d, %v), esperado %d", step, got, err, model[cursor])
+					}
+					last = cursor
+				case 4:
+					err := c.it.Set(value)
+					if last < 0 {
+						if !errors.Is(err, list.ErrIllegalState) {
+							t.Fatalf("passo %d: Set sem elemento retornado: %v", step, err)
+						}
+						break
+					}
+					if err != nil {
+						t.Fatalf("passo %d: Set: %v", step, err)
+					}
+					model[last] = value
+				case 5, 6:
+					if err := c.it.Add(value); err != nil {
+						t.Fatalf("passo %d: Add: %v", step, err)
+					}
+					model = slices.Insert(model, cursor, value)
+					cursor++
+					last = -1
+				case 7, 8, 9:
+					err := c.it.Remove()
+					if last < 0 {
+						if !errors.Is(err, list.ErrIllegalState) {
+							t.Fatalf("passo %d: Remove sem elemento retornado: %v", step, err)
+						}
+						break
+					}
+					if err != nil {
+						t.Fatalf("passo %d: Remove: %v", step, err)
+					}
+					model = slices.Delete(model, last, last+1)
+					if last < cursor {
+						cursor--
+					}
+					last = -1
+				}
+				
+				if err := c.validate(); err != nil {
+					t.Fatalf("passo %d: %v", step, err)
+				}
+				if got := c.toSlice(); !slices.Equal(got, model) {
+					t.Fatalf("passo %d: lista %v, esperado %v", step, got, model)
+				}
+				if c.it.NextIndex() != cursor || c.it.HasNext() != (cursor < len(model)) {
+					t.Fatalf("passo %d: NextIndex = %d, HasNext = %v, cursor %d de %d", step, c.it.NextIndex(), c.it.HasNext(), cursor, len(model))
+				}
+			}
+		})
+	}
+}
+
+func TestListIteratorIllegalState(t *testing.T) {
+	for _, c := range newIteratorCases([]int{1, 2, 3}) {
+		t.Run(c.name, func(t *testing.T) {
+			// Antes do primeiro Next não há elemento para Set/Remove
+			if err := c.it.Set(9); !errors.Is(err, list.ErrIllegalState) {
+				t.Errorf("Set antes de Next: %v", err)
+			}
+			if err := c.it.Remove(); !errors.Is(err, list.ErrIllegalState) {
+				t.Errorf("Remove antes de Next: %v", err)
+			}
+			
+			// Add invalida o último elemento retornado
+			c.it.Next()
+			if err := c.it.Add(7); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.it.Set(9); !errors.Is(err, list.ErrIllegalState) {
+				t.Errorf("Set depois de Add: %v", err)
+			}
+			if err := c.it.Remove(); !errors.Is(err, list.ErrIllegalState) {
+				t.Errorf("Remove depois de Add: %v", err)
+			}
+			
+			// Remove só uma vez por Next
+			c.it.Next()
+			if err := c.it.Remove(); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.it.Remove(); !errors.Is(err, list.ErrIllegalState) {
+				t.Errorf("segundo Remove: %v", err)
+			}
+			if got := c.toSlice(); !slices.Equal(got, []int{1, 7, 3}) || c.validate() != nil {
+				t.Errorf("lista %v, esperado [1 7 3]: %v", got, c.validate())
+			}
+		})
+	}
+}
+
+func TestListIteratorConcurrentModification(t *testing.T) {
+	linked := list.NewLinkedList[int]()
+	linked.AddAll([]int{1, 2, 3})
+	doubly := list.NewDoublyLinkedList[int]()
+	doubly.AddAll([]int{1, 2, 3})
+	
+	cases := []struct {
+		name   string
+		it     list.ListIterator[int]
+		other  list.ListIterator[int]
+		modify func()
+	}{
+		{"LinkedList", linked.NewListIterator(), linked.NewListIterator(), func() { linked.Add(4) }},
+		{"DoublyLinkedList", doubly.NewListIterator(), doubly.NewListIterator(), func() { doubly.Remove(0) }},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			// Set não é estrutural: o outro iterador continua válido
+			c.it.Next()
+			if err := c.it.Set(10); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := c.other.Next(); err != nil || got != 10 {
+				t.Fatalf("outro iterador depois de Set: (%d, %v)", got, err)
+			}
+			
+			// Add pelo iterador invalida só os outros iteradores
+			if err := c.it.Add(5); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := c.other.Next(); !errors.Is(err, list.ErrConcurrentModification) {
+				t.Errorf("outro iterador depois de Add: %v", err)
+			}
+			if _, err := c.it.Next(); err != nil {
+				t.Errorf("o próprio iterador depois de Add: %v", err)
+			}
+			
+			// Alteração direta na lista invalida todas as operações
+			c.modify()
+			if _, err := c.it.Next(); !errors.Is(err, list.ErrConcurrentModification) {
+				t.Errorf("Next: %v", err)
+			}
+			if err := c.it.Set(0); !errors.Is(err, list.ErrConcurrentModification) {
+				t.Errorf("Set: %v", err)
+			}
+			if err := c.it.Add(0); !errors.Is(err, list.ErrConcurrentModification) {
+				t.Errorf("Add: %v", err)
+			}
+			if err := c.it.Remove(); !errors.Is(err, list.ErrConcurrentModification) {
+				t.Errorf("Remove: %v", err)
+			}
+		})
+	}
+	if _, err := doubly.NewListIterator().Previous(); !errors.Is(err, list.ErrNoSuchElement) {
+		t.Errorf("Previous no início: %v", err)
+	}
+	if got := linked.ToSlice(); !slices.Equal(got, []int{10, 5, 2, 3, 4}) {
+		t.Errorf("LinkedList %v", got)
+	}
+	if got := doubly.ToSlice(); !slices.Equal(got, []int{5, 2, 3}) {
+		t.Errorf("DoublyLinkedList %v", got)
+	}
+}
+
+func TestNewListIteratorAt(t *testing.T) {
+	linked := list.NewLinkedList[int]()
+	linked.AddAll([]int{1, 2, 3})
+	doubly := list.NewDoublyLinkedList[int]()
+	doubly.AddAll([]int{1, 2, 3})
+	
+	for _, index := range []int{-1, 4} {
+		var indexErr *errs.IndexError
+		if it, err := linked.NewListIteratorAt(index); it != nil || !errors.As(err, &indexErr) || *indexErr != (errs.IndexError{Index: index, Size: 3}) {
+			t.Errorf("LinkedList.NewListIteratorAt(%d): %v", index, err)
+		}
+		if it, err := doubly.NewListIteratorAt(index); it != nil || !errors.Is(err, errs.ErrIndexOutOfRange) {
+			t.Errorf("DoublyLinkedList.NewListIteratorAt(%d): %v", index, err)
+		}
+	}
+	
+	for index := 0; index <= 3; index++ {
+		it, err := linked.NewListIteratorAt(index)
+		if err != nil || it.NextIndex() != index || it.HasNext() != (index < 3) {
+			t.Fatalf("LinkedList.NewListIteratorAt(%d): %v", index, err)
+		}
+		if got, err := it.Next(); index < 3 && (err != nil || got != index+1) {
+			t.Errorf("LinkedList: Next a partir de %d = (%d, %v)", index, got, err)
+		}
+		
+		dit, err := doubly.NewListIteratorAt(index)
+		if err != nil || dit.NextIndex() != index || dit.PreviousIndex() != index-1 || dit.HasPrevious() != (index > 0) {
+			t.Fatalf("DoublyLinkedList.NewListIteratorAt(%d): %v", index, err)
+		}
+		if got, err := dit.Previous(); index > 0 && (err != nil || got != index) {
+			t.Errorf("DoublyLinkedList: Previous a partir de %d = (%d, %v)", index, got, err)
+		}
+	}
+	
+	// Com o cursor no fim, Add acrescenta depois do último elemento
+	it, _ := linked.NewListIteratorAt(3)
+	dit, _ := doubly.NewListIteratorAt(3)
+	if it.Add(4) != nil || dit.Add(4) != nil {
+		t.Fatal("Add no fim falhou")
+	}
+	want := []int{1, 2, 3, 4}
+	if !slices.Equal(linked.ToSlice(), want) || !slices.Equal(doubly.ToSlice(), want) {
+		t.Errorf("depois de Add no fim: %v e %v", linked.ToSlice(), doubly.ToSlice())
+	}
+	if got := doubly.ToSliceReverse(); !slices.Equal(got, []int{4, 3, 2, 1}) || doubly.Validate() != nil {
+		t.Errorf("DoublyLinkedList de trás para frente: %v, %v", got, doubly.Validate())
+	}
+}
@@ -0,0 +1,403 @@
+// Package listtest implementa uma suíte de conformidade para list.List
+//
+// Qualquer implementação da interface pode ser validada com uma chamada:
+//
+//	func TestMinhaLista(t *testing.T) {
+//		listtest.Run(t, func() list.List[int] { return NovaMinhaLista() })
+//	}
+//
+// A suíte verifica os contratos da interface (erros em lista vazia, limites
+// de índice, ordem de inserção, concordância entre ToSlice e String) e
+// executa sequências aleatórias de operações comparando a lista com um
+// modelo simples baseado em slice. Se a implementação tiver
+// um método Validate() error, ele é chamado depois de cada passo, e os
+// iteradores All, Values e Backward que ela tiver são conferidos contra ToSlice.
+package listtest
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/list"
+)
+
+// Factory cria uma lista nova e vazia a cada chamada
+type Factory func() list.List[int]
+
+// RandomSeeds são as sementes usadas nas sequências aleatórias
+// Fixas para que uma falha seja sempre reproduzível
+var RandomSeeds = []uint64{1, 2, 3, 42, 2024}
+
+// RandomOperations é o número de operações por sequência aleatória
+var RandomOperations = 2000
+
+// Run executa toda a suíte de conformidade sobre listas criadas por newList
+func Run(t *testing.T, newList Factory) {
+	t.Helper()
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, newList()) })
+	t.Run("AddPreservesOrder", func(t *testing.T) { testAddOrder(t, newList()) })
+	t.Run("IndexBounds", func(t *testing.T) { testIndexBounds(t, newList()) })
+	t.Run("AddOnIndex", func(t *testing.T) { testAddOnIndex(t, newList()) })
+	t.Run("Remove", func(t *testing.T) { testRemove(t, newList()) })
+	t.Run("ContainsIndexOf", func(t *testing.T) { testContainsIndexOf(t, newList()) })
+	t.Run("Clear", func(t *testing.T) { testClear(t, newList()) })
+	t.Run("ToSliceIsCopy", func(t *testing.T) { testToSliceIsCopy(t, newList()) })
+	t.Run("Randomized", func(t *testing.T) {
+		for _, seed := range RandomSeeds {
+			t.Run("seed="+strconv.FormatUint(seed, 10), func(t *testing.T) {
+				testRandomized(t, newList(), seed)
+			})
+		}
+	})
+}
+
+// ============================================================================
+// CONTRATOS
+// ============================================================================
+
+func testEmpty(t *testing.T, l list.List[int]) {
+	if l.Size() != 0 || !l.IsEmpty() {
+		t.Fatalf("lista nova: Size()=%d IsEmpty()=%v, esperado 0 e true", l.Size(), l.IsEmpty())
+	}
+	_, err := l.Get(0)
+	checkIndexError(t, "Get(0) em lista vazia", err, 0, 0)
+	checkIndexError(t, "Remove(0) em lista vazia", l.Remove(0), 0, 0)
+	if l.Contains(0) {
+		t.Errorf("Contains(0) em lista vazia retornou true")
+	}
+	if got := l.IndexOf(0); got != -1 {
+		t.Errorf("IndexOf(0) em lista vazia = %d, esperado -1", got)
+	}
+	if got := l.ToSlice(); len(got) != 0 {
+		t.Errorf("ToSlice() em lista vazia = %v", got)
+	}
+	checkString(t, l)
+}
+
+func testAddOrder(t *testing.T, l list.List[int]) {
+	want := []int{5, -3, 8, 0, 5, 13, 21}
+	for _, v := range want {
+		l.Add(v)
+	}
+	checkState(t, l, want)
+	for i, v := range want {
+		got, err := l.Get(i)
+		if err != nil || got != v {
+			t.Errorf("Get(%d) = (%d, %v), esperado (%d, nil)", i, got, err, v)
+		}
+	}
+}
+
+func testIndexBounds(t *testing.T, l list.List[int]) {
+	want := []int{1, 2, 3}
+	for _, v := range want {
+		l.Add(v)
+	}
+	for _, index := range []int{-1, 3, 100} {
+		_, err := l.Get(index)
+		checkIndexError(t, "Get("+strconv.Itoa(index)+") com Size()=3", err, index, 3)
+		checkIndexError(t, "Remove("+strconv.Itoa(index)+") com Size()=3", l.Remove(index), index, 3)
+	}
+	for _, index := range []int{-1, 4} {
+		checkIndexError(t, "AddOnIndex(99, "+strconv.Itoa(index)+") com Size()=3", l.AddOnIndex(99, index), index, 3)
+	}
+	// Operações inválidas não podem alterar a lista
+	checkState(t, l, want)
+}
+
+func testAddOnIndex(t *testing.T, l list.List[int]) {
+	steps := []struct {
+		value, index int
+		want         []int
+	}{
+		{10, 0, []int{10}},
+		{30, 1, []int{10, 30}},
+		{20, 1, []int{10, 20, 30}},
+		{0, 0, []int{0, 10, 20, 30}},
+		{40, 4, []int{0, 10, 20, 30, 40}},
+	}
+	for _, step := range steps {
+		if err := l.AddOnIndex(step.value, step.index); err != nil {
+			t.Fatalf("AddOnIndex(%d, %d): %v", step.value, step.index, err)
+		}
+		checkState(t, l, step.want)
+	}
+}
+
+func testRemove(t *testing.T, l list.List[int]) {
+	for _, v := range []int{0, 1, 2, 3, 4} {
+		l.Add(v)
+	}
+	steps := []struct {
+		index int
+		want  []int
+	}{
+		{2, []int{0, 1, 3, 4}},
+		{0, []int{1, 3, 4}},
+		{2, []int{1, 3}},
+		{1, []int{1}},
+		{0, []int{}},
+	}
+	for _, step := range steps {
+		if err := l.Remove(step.index); err != nil {
+			t.Fatalf("Remove(%d): %v", step.index, err)
+		}
+		checkState(t, l, step.want)
+	}
+}
+
+func testContainsIndexOf(t *testing.T, l list.List[int]) {
+	for _, v := range []int{7, 3, 7, 9} {
+		l.Add(v)
+	}
+	if got := l.IndexOf(7); got != 0 {
+		t.Errorf("IndexOf(7) = %d, esperado a primeira ocorrência (0)", got)
+	}
+	if got := l.IndexOf(9); got != 3 {
+		t.Errorf("IndexOf(9) = %d, esperado 3", got)
+	}
+	if got := l.IndexOf(4); got != -1 {
+		t.Errorf("IndexOf(4) = %d, esperado -1", got)
+	}
+	if !l.Contains(3) || l.Contains(4) {
+		t.Errorf("Contains(3)=%v Contains(4)=%v, esperado true e false", l.Contains(3), l.Contains(4))
+	}
+}
+
+func testClear(t *testing.T, l list.List[int]) {
+	for i := 0; i < 20; i++ {
+		l.Add(i)
+	}
+	l.Clear()
+	checkState(t, l, []int{})
+	
+	// A lista continua utilizável depois de Clear
+	l.Add(1)
+	l.Add(2)
+	checkState(t, l, []int{1, 2})
+}
+
+func testToSliceIsCopy(t *testing.T, l list.List[int]) {
+	l.Add(1)
+	l.Add(2)
+	slice := l.ToSlice()
+	slice[0] = 100
+	if got, _ := l.Get(0); got != 1 {
+		t.Errorf("alterar o resultado de ToSlice modificou a lista: Get(0) = %d", got)
+	}
+}
+
+// ============================================================================
+// SEQUÊNCIAS ALEATÓRIAS CONTRA UM MODELO
+// ============================================================================
+
+// testRandomized aplica operações aleatórias na lista e num slice (modelo)
+// e compara os dois depois de cada passo
+func testRandomized(t *testing.T, l list.List[int], seed uint64) {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	model := []int{}
+	
+	for step := 0; step < RandomOperations; step++ {
+		value := rng.IntN(50)
+		// Índices podem cair fora dos limites de propósito
+		index := rng.IntN(len(model)+3) - 1
+		
+		var op string
+		switch rng.IntN(10) {
+		case 0, 1, 2:
+			op = "Add(" + strconv.Itoa(value) + ")"
+			l.Add(value)
+			model = append(model, value)
+		case 3, 4:
+			op = "AddOnIndex(" + strconv.Itoa(value) + ", " + strconv.Itoa(index) + ")"
+			err := l.AddOnIndex(value, index)
+			valid := index >= 0 && index <= len(model)
+			if valid {
+				model = slices.Insert(model, index, value)
+			}
+			checkError(t, step, op, err, valid)
+		case 5, 6:
+			op = "Remove(" + strconv.Itoa(index) + ")"
+			err := l.Remove(index)
+			valid := index >= 0 && index < len(model)
+			if valid {
+				model = slices.Delete(model, index, index+1)
+			}
+			checkError(t, step, op, err, valid)
+		case 7:
+			op = "Get(" + strconv.Itoa(index) + ")"
+			got, err := l.Get(index)
+			valid := index >= 0 && index < len(model)
+			checkError(t, step, op, err, valid)
+			if valid && got != model[index] {
+				t.Fatalf("passo %d: %s = %d, modelo tem %d", step, op, got, model[index])
+			}
+		case 8:
+			op = "IndexOf(" + strconv.Itoa(value) + ")"
+			if got, want := l.IndexOf(value), slices.Index(model, value); got != want {
+				t.Fatalf("passo %d: %s = %d, modelo tem %d", step, op, got, want)
+			}
+			if got, want := l.Contains(value), slices.Contains(model, value); got != want {
+				t.Fatalf("passo %d: Contains(%d) = %v, modelo tem %v", step, value, got, want)
+			}
+		case 9:
+			// Clear raro para deixar a lista crescer
+			if rng.IntN(20) == 0 {
+				op = "Clear()"
+				l.Clear()
+				model = model[:0]
+			}
+		}
+		
+		if err := validate(l); err != nil {
+			t.Fatalf("passo %d (%s): %v", step, op, err)
+		}
+		
+		if l.Size() != len(model) || !slices.Equal(l.ToSlice(), model) {
+			t.Fatalf("passo %d (%s): lista %v (Size()=%d), modelo %v",
+				step, op, l.ToSlice(), l.Size(), model)
+		}
+		if l.IsEmpty() != (len(model) == 0) {
+			t.Fatalf("passo %d (%s): IsEmpty()=%v com %d elementos", step, op, l.IsEmpty(), len(model))
+		}
+	}
+	checkString(t, l)
+}
+
+// ============================================================================
+// AUXILIARES
+// ============================================================================
+
+// checkState compara tamanho, ToSlice e String com o conteúdo esperado
+func checkState(t *testing.T, l list.List[int], want []int) {
+	t.Helper()
+	if err := validate(l); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if l.Size() != len(want) {
+		t.Fatalf("Size() = %d, esperado %d", l.Size(), len(want))
+	}
+	if l.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("IsEmpty() = %v com %d elementos", l.IsEmpty(), len(want))
+	}
+	if got := l.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, esperado %v", got, want)
+	}
+	checkIterators(t, l)
+	checkString(t, l)
+}
+
+// checkIndexError verifica se err é um *errs.IndexError com o índice e o
+// tamanho esperados (e, portanto, satisfaz errors.Is(err, errs.ErrIndexOutOfRange))
+func checkIndexError(t *testing.T, op string, err error, index, size int) {
+	t.Helper()
+	var indexErr *errs.IndexError
+	if !errors.As(err, &indexErr) || !errors.Is(err, errs.ErrIndexOutOfRange) {
+		t.Errorf("%s: erro %v, esperado *errs.IndexError", op, err)
+		return
+	}
+	if indexErr.Index != index || indexErr.Size != size {
+		t.Errorf("%s: IndexError{Index: %d, Size: %d}, esperado {%d, %d}", op, indexErr.Index, indexErr.Size, index, size)
+	}
+}
+
+// checkError verifica se a operação falhou exatamente quando deveria
+func checkError(t *testing.T, step int, op string, err error, valid bool) {
+	t.Helper()
+	if valid && err != nil {
+		t.Fatalf("passo %d: %s retornou erro inesperado: %v", step, op, err)
+	}
+	if !valid && err == nil {
+		t.Fatalf("passo %d: %s deveria retornar erro", step, op)
+	}
+}
+
+// validate roda Validate() quando a implementação oferece o verificador de
+// invariantes estruturais; implementações sem ele passam direto
+func validate(structure list.List[int]) error {
+	if validator, ok := structure.(interface{ Validate() error }); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// checkIterators confere os iteradores All, Values e Backward que a
+// implementação oferecer: percorrem os elementos de ToSlice na mesma ordem
+// (Backward na inversa, com as mesmas posições) e param assim que yield
+// retorna false
+func checkIterators(t *testing.T, l list.List[int]) {
+	t.Helper()
+	want := l.ToSlice()
+	positions := make([]int, len(want))
+	for i := range positions {
+		positions[i] = i
+	}
+	if it, ok := l.(interface{ All() iter.Seq2[int, int] }); ok {
+		checkSeq(t, "All", it.All(), positions, want)
+	}
+	if it, ok := l.(interface{ Values() iter.Seq[int] }); ok {
+		// Numera os valores para conferir como os pares de All
+		numbered := func(yield func(int, int) bool) {
+			i := 0
+			for value := range it.Values() {
+				if !yield(i, value) {
+					return
+				}
+				i++
+			}
+		}
+		checkSeq(t, "Values", numbered, positions, want)
+	}
+	if it, ok := l.(interface{ Backward() iter.Seq2[int, int] }); ok {
+		slices.Reverse(positions)
+		backward := slices.Clone(want)
+		slices.Reverse(backward)
+		checkSeq(t, "Backward", it.Backward(), positions, backward)
+	}
+}
+
+// checkSeq percorre seq inteiro comparando com os pares esperados e depois
+// de novo, parando no meio: seq não pode chamar yield depois de um false
+func checkSeq(t *testing.T, name string, seq iter.Seq2[int, int], positions, want []int) {
+	t.Helper()
+	gotPositions, gotValues := []int{}, []int{}
+	for position, value := range seq {
+		gotPositions = append(gotPositions, position)
+		gotValues = append(gotValues, value)
+	}
+	if !slices.Equal(gotPositions, positions) || !slices.Equal(gotValues, want) {
+		t.Fatalf("%s() = posições %v, valores %v; esperado %v e %v", name, gotPositions, gotValues, positions, want)
+	}
+	
+	stop := len(want)/2 + 1
+	calls := 0
+	seq(func(int, int) bool {
+		calls++
+		return calls < stop
+	})
+	if len(want) > 0 && calls != stop {
+		t.Fatalf("%s(): yield chamado %d vezes, mas retornou false na chamada %d", name, calls, stop)
+	}
+}
+
+var numberPattern = regexp.MustCompile(`-?\d+`)
+
+// checkString verifica se String mostra os mesmos números, na mesma ordem, que ToSlice
+// O formato (colchetes, separadores, rótulos) fica livre para cada implementação
+func checkString(t *testing.T, l list.List[int]) {
+	t.Helper()
+	got := []int{}
+	for _, match := range numberPattern.FindAllString(l.String(), -1) {
+		number, _ := strconv.Atoi(match)
+		got = append(got, number)
+	}
+	if want := l.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("String() = %q não concorda com ToSlice() = %v", l.String(), want)
+	}
+}
@@ -0,0 +1,146 @@
+package list
+
+import (
+	"fmt"
+
+	"dca3503/serial"
+)
+
+// ============================================================================
+// SERIALIZAÇÃO (JSON, BINÁRIO E GOB)
+// ============================================================================
+
+// As listas são gravadas do índice 0 ao último (a ordem de ToSlice), em JSON
+// como um array e em binário no formato do pacote serial. Os três tipos de
+// lista leem os dados uns dos outros. Unmarshal substitui todo o conteúdo e
+// funciona também com o valor zero (var l ArrayList[int]); a capacidade não
+// é gravada. A ArrayList mantém a política de capacidade e rejeita com
+// errs.ErrFull dados que passem de MaxCapacity
+// Complexidade: Θ(n) em todas as operações
+
+// MarshalJSON implementa json.Marshaler
+func (list *ArrayList[T]) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(list.elements[:list.size])
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (list *ArrayList[T]) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[T](data)
+	if err != nil {
+		return fmt.Errorf("ArrayList: %w", err)
+	}
+	return list.load(values)
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (list *ArrayList[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.List, list.elements[:list.size])
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (list *ArrayList[T]) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[T](serial.List, data)
+	if err != nil {
+		return fmt.Errorf("ArrayList: %w", err)
+	}
+	return list.load(values)
+}
+
+// load troca o conteúdo pelos valores, reaproveitando o array se couber
+func (list *ArrayList[T]) load(values []T) error {
+	defer checkInvariants(list)
+	if err := list.policy.Validate(len(values)); err != nil {
+		return fmt.Errorf("ArrayList: %w", err)
+	}
+	if len(values) > len(list.elements) {
+		list.elements = make([]T, len(values))
+	}
+	copy(list.elements, values)
+	if list.size > len(values) {
+		clear(list.elements[len(values):list.size]) // Solta referências antigas
+	}
+	list.size = len(values)
+	list.counters.AddMoves(len(values))
+	return nil
+}
+
+// MarshalJSON implementa json.Marshaler
+func (list *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(list.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (list *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[T](data)
+	if err != nil {
+		return fmt.Errorf("LinkedList: %w", err)
+	}
+	list.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (list *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.List, list.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (list *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[T](serial.List, data)
+	if err != nil {
+		return fmt.Errorf("LinkedList: %w", err)
+	}
+	list.load(values)
+	return nil
+}
+
+// load troca o conteúdo por uma nova cadeia de nós com os valores
+// Monta a cadeia de trás para frente para não precisar de ponteiro de cauda
+func (list *LinkedList[T]) load(values []T) {
+	defer checkInvariants(list)
+	list.head = nil
+	for i := len(values) - 1; i >= 0; i-- {
+		node := NewNode(values[i])
+		node.next = list.head
+		list.head = node
+	}
+	list.size = len(values)
+	list.modCount++
+	list.counters.AddAllocations(len(values))
+}
+
+// MarshalJSON implementa json.Marshaler
+func (list *DoublyLinkedList[T]) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(list.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (list *DoublyLinkedList[T]) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[T](data)
+	if err != nil {
+		return fmt.Errorf("DoublyLinkedList: %w", err)
+	}
+	list.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (list *DoublyLinkedList[T]) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.List, list.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (list *DoublyLinkedList[T]) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[T](serial.List, data)
+	if err != nil {
+		return fmt.Errorf("DoublyLinkedList: %w", err)
+	}
+	list.load(values)
+	return nil
+}
+
+// load troca o conteúdo por uma nova cadeia de nós com os valores
+func (list *DoublyLinkedList[T]) load(values []T) {
+	list.Clear()
+	list.AddAll(values)
+}
@@ -0,0 +1,251 @@
+package list
+
+// ============================================================================
+// BUSCA COM SENTINELA
+// ============================================================================
+
+// A busca sequencial comum faz dois testes por elemento: "acabou a lista?" e
+// "é o valor?". Com uma sentinela (uma cópia do valor procurado colocada logo
+// depois do último elemento) a busca sempre para, e o laço só testa o valor;
+// no fim, parar na sentinela significa "não encontrado"
+//
+// LinkedList não tem sentinela: sem ponteiro para o último nó, chegar ao fim
+// para pendurá-la custaria os mesmos n testes que ela economiza
+
+// SentinelIndexOf retorna o índice da primeira ocorrência do valor usando
+// uma sentinela, ou -1
+// Com capacidade sobrando a sentinela vai na primeira posição livre; com o
+// array cheio, o último elemento é trocado pela sentinela e restaurado no fim
+// Complexidade: O(n), com um teste por elemento em vez de dois
+// Pseudocódigo:
+// 1. Guardar o conteúdo da posição da sentinela e escrever o valor nela
+// 2. i = 0; enquanto elements[i] != valor: i++
+// 3. Restaurar a posição da sentinela
+// 4. Se i parou antes da sentinela (ou o último era o valor), achou
+func (list *ArrayList[T]) SentinelIndexOf(value T) int {
+	if list.size == 0 {
+		return -1
+	}
+	
+	sentinel := list.size // Primeira posição livre
+	if sentinel == len(list.elements) {
+		sentinel = list.size - 1 // Array cheio: usa a última posição
+	}
+	saved := list.elements[sentinel]
+	list.elements[sentinel] = value
+	
+	i := 0
+	for {
+		list.counters.AddComparisons(1)
+		if list.elements[i] == value {
+			break
+		}
+		i++
+	}
+	list.elements[sentinel] = saved
+	
+	if i < sentinel {
+		return i
+	}
+	if sentinel < list.size {
+		// A sentinela ocupava o último elemento: ele ainda precisa ser testado
+		list.counters.AddComparisons(1)
+		if saved == value {
+			return sentinel
+		}
+	}
+	return -1
+}
+
+// SentinelIndexOf retorna o índice da primeira ocorrência do valor usando
+// um nó sentinela pendurado no tail, ou -1
+// A lista volta ao estado original antes de retornar
+// Complexidade: O(n), com um teste por nó em vez de dois
+func (list *DoublyLinkedList[T]) SentinelIndexOf(value T) int {
+	if list.size == 0 {
+		return -1
+	}
+	
+	sentinel := NewDoublyNode(value)
+	list.counters.AddAllocations(1)
+	list.tail.next = sentinel
+	
+	current := list.head
+	index := 0
+	for {
+		list.counters.AddComparisons(1)
+		if current.data == value {
+			break
+		}
+		current = current.next
+		list.counters.AddTraversals(1)
+		index++
+	}
+	list.tail.next = nil
+	
+	if current == sentinel {
+		return -1
+	}
+	return index
+}
+
+// ============================================================================
+// LISTAS AUTO-ORGANIZÁVEIS
+// ============================================================================
+
+// Quando alguns valores são procurados muito mais que outros, vale a pena
+// aproximá-los do início a cada busca bem-sucedida:
+// - Mover para a frente (move-to-front): o valor encontrado vai para a
+// posição 0; adapta-se rápido, mas um acesso raro empurra todos para trás
+// - Transposição: o valor troca de lugar com o anterior; adapta-se devagar,
+// mas só valores procurados com frequência chegam ao início
+//
+// Os métodos retornam o índice em que o valor estava (o custo da busca) ou
+// -1; as listas ligadas religam o nó encontrado, sem alocar nem copiar
+
+// FindAndMoveToFront procura o valor e move a primeira ocorrência para o início
+// Complexidade: O(i) para encontrar e O(1) para religar
+func (list *LinkedList[T]) FindAndMoveToFront(value T) int {
+	defer checkInvariants(list)
+	var previous *Node[T]
+	current := list.head
+	index := 0
+	
+	for current != nil {
+		list.counters.AddComparisons(1)
+		if current.value == value {
+			if previous != nil {
+				previous.next = current.next
+				current.next = list.head
+				list.head = current
+				list.modCount++
+			}
+			return index
+		}
+		previous = current
+		current = current.next
+		list.counters.AddTraversals(1)
+		index++
+	}
+	return -1
+}
+
+// FindAndTranspose procura o valor e troca a primeira ocorrência de lugar
+// com o nó anterior
+// Na lista simples é preciso lembrar dois nós para trás para religar
+// Complexidade: O(i) para encontrar e O(1) para religar
+func (list *LinkedList[T]) FindAndTranspose(value T) int {
+	defer checkInvariants(list)
+	var beforePrevious, previous *Node[T]
+	current := list.head
+	index := 0
+	
+	for current != nil {
+		list.counters.AddComparisons(1)
+		if current.value == value {
+			if previous != nil {
+				// beforePrevious → previous → current  vira  beforePrevious → current → previous
+				previous.next = current.next
+				current.next = previous
+				if beforePrevious != nil {
+					beforePrevious.next = current
+				} else {
+					list.head = current
+				}
+				list.modCount++
+			}
+			return index
+		}
+		beforePrevious, previous = previous, current
+		current = current.next
+		list.counters.AddTraversals(1)
+		index++
+	}
+	return -1
+}
+
+// FindAndMoveToFront procura o valor e move a primeira ocorrência para o início
+// Complexidade: O(i) para encontrar e O(1) para religar (MoveToFront)
+func (list *DoublyLinkedList[T]) FindAndMoveToFront(value T) int {
+	node, index := list.findFirst(value)
+	if node != nil {
+		list.MoveToFront(node)
+	}
+	return index
+}
+
+// FindAndTranspose procura o valor e troca a primeira ocorrência de lugar
+// com o nó anterior
+// Complexidade: O(i) para encontrar e O(1) para religar
+func (list *DoublyLinkedList[T]) FindAndTranspose(value T) int {
+	defer checkInvariants(list)
+	node, index := list.findFirst(value)
+	if node == nil || node.prev == nil {
+		return index
+	}
+	
+	// before → previous → node → after  vira  before → node → previous → after
+	previous := node.prev
+	before, after := previous.prev, node.next
+	if before != nil {
+		before.next = node
+	} else {
+		list.head = node
+	}
+	if after != nil {
+		after.prev = previous
+	} else {
+		list.tail = previous
+	}
+	node.prev, node.next = before, previous
+	previous.prev, previous.next = node, after
+	list.modCount++
+	return index
+}
+
+// findFirst retorna o primeiro nó com o valor e seu índice (nil, -1 se não houver)
+// Diferente de FindNode, percorre só a partir do head para achar a
+// primeira ocorrência
+func (list *DoublyLinkedList[T]) findFirst(value T) (*DoublyNode[T], int) {
+	index := 0
+	for current := list.head; current != nil; current = current.next {
+		list.counters.AddComparisons(1)
+		if current.data == value {
+			return current, index
+		}
+		list.counters.AddTraversals(1)
+		index++
+	}
+	return nil, -1
+}
+
+// FindAndMoveToFront procura o valor e move a primeira ocorrência para o início
+// No array isso desloca os i elementos anteriores uma posição à direita
+// Complexidade: O(i)
+func (list *ArrayList[T]) FindAndMoveToFront(value T) int {
+	defer checkInvariants(list)
+	index := list.IndexOf(value)
+	if index <= 0 {
+		return index
+	}
+	
+	copy(list.elements[1:index+1], list.elements[:index])
+	list.elements[0] = value
+	list.counters.AddMoves(index + 1)
+	return index
+}
+
+// FindAndTranspose procura o valor e troca a primeira ocorrência de lugar
+// com o elemento anterior
+// Complexidade: O(i) para encontrar e O(1) para trocar
+func (list *ArrayList[T]) FindAndTranspose(value T) int {
+	defer checkInvariants(list)
+	index := list.IndexOf(value)
+	if index <= 0 {
+		return index
+	}
+	
+	list.elements[index-1], list.elements[index] = list.elements[index], list.elements[index-1]
+	list.counters.AddMoves(2)
+	return index
+}
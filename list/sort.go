@@ -0,0 +1,314 @@
+package list
+
+import "dca3503/instrument"
+
+// ============================================================================
+// ORDENAÇÃO - ESTRATÉGIAS ESPECÍFICAS PARA CADA IMPLEMENTAÇÃO
+// ============================================================================
+
+// Comparator define a ordem usada na ordenação
+// Retorna true se a deve vir antes de b
+type Comparator[T any] func(a, b T) bool
+
+// Less é o comparador da ordem crescente natural
+func Less[T Ordered](a, b T) bool {
+	return a < b
+}
+
+// Greater é o comparador da ordem decrescente natural
+func Greater[T Ordered](a, b T) bool {
+	return a > b
+}
+
+// countingComparator envolve o comparador para contar cada chamada
+// Sem contadores devolve o próprio comparador, sem custo extra
+func countingComparator[T any](less Comparator[T], counters *instrument.Counters) Comparator[T] {
+	if counters == nil {
+		return less
+	}
+	return func(a, b T) bool {
+		counters.AddComparisons(1)
+		return less(a, b)
+	}
+}
+
+// SortListFunc ordena a lista com o comparador fornecido
+// Escolhe a melhor estratégia para o tipo concreto:
+// - ArrayList: introsort direto no array interno, O(n log n), não estável
+// - LinkedList/DoublyLinkedList: merge sort religando os nós, O(n log n), estável
+// - Outras implementações: copia para slice, ordena e reconstrói a lista
+func SortListFunc[T comparable](list List[T], less Comparator[T]) {
+	switch concrete := list.(type) {
+	case *ArrayList[T]:
+		concrete.Sort(less)
+	case *LinkedList[T]:
+		concrete.Sort(less)
+	case *DoublyLinkedList[T]:
+		concrete.Sort(less)
+	default:
+		elements := list.ToSlice()
+		introSort(elements, less)
+		list.Clear()
+		for _, element := range elements {
+			list.Add(element)
+		}
+	}
+}
+
+// ============================================================================
+// ARRAYLIST - INTROSORT
+// ============================================================================
+
+// Sort ordena o ArrayList com introsort sobre o array interno
+// Complexidade: O(n log n) no pior caso, O(log n) de espaço extra
+func (list *ArrayList[T]) Sort(less Comparator[T]) {
+	defer checkInvariants(list)
+	introSort(list.elements[:list.size], countingComparator(less, list.counters))
+}
+
+// insertionSortThreshold é o tamanho abaixo do qual a ordenação por
+// inserção é mais rápida que continuar particionando
+const insertionSortThreshold = 16
+
+// introSort combina quicksort, heapsort e ordenação por inserção
+// Pseudocódigo:
+// 1. Limite de profundidade = 2 * floor(log2(n))
+// 2. Quicksort com pivô mediana-de-três enquanto houver profundidade
+// 3. Se o limite estourar: heapsort no trecho (garante O(n log n))
+// 4. Trechos pequenos: ordenação por inserção
+func introSort[T any](elements []T, less Comparator[T]) {
+	depthLimit := 0
+	for n := len(elements); n > 1; n /= 2 {
+		depthLimit++
+	}
+	introSortRange(elements, 0, len(elements), 2*depthLimit, less)
+}
+
+// introSortRange ordena elements[low:high]
+func introSortRange[T any](elements []T, low, high, depthLimit int, less Comparator[T]) {
+	for high-low > insertionSortThreshold {
+		if depthLimit == 0 {
+			heapSortRange(elements, low, high, less)
+			return
+		}
+		depthLimit--
+		
+		pivot := partition(elements, low, high, less)
+		
+		// Recursão no lado menor e laço no maior: pilha O(log n)
+		if pivot-low < high-pivot-1 {
+			introSortRange(elements, low, pivot, depthLimit, less)
+			low = pivot + 1
+		} else {
+			introSortRange(elements, pivot+1, high, depthLimit, less)
+			high = pivot
+		}
+	}
+	insertionSortRange(elements, low, high, less)
+}
+
+// partition particiona elements[low:high] (esquema de Lomuto)
+// usando a mediana de três como pivô; retorna a posição final do pivô
+func partition[T any](elements []T, low, high int, less Comparator[T]) int {
+	middle := low + (high-low)/2
+	last := high - 1
+	
+	// Ordena low, middle e last; a mediana fica em middle
+	if less(elements[middle], elements[low]) {
+		elements[middle], elements[low] = elements[low], elements[middle]
+	}
+	if less(elements[last], elements[low]) {
+		elements[last], elements[low] = elements[low], elements[last]
+	}
+	if less(elements[last], elements[middle]) {
+		elements[last], elements[middle] = elements[middle], elements[last]
+	}
+	
+	// Move o pivô para o final
+	elements[middle], elements[last] = elements[last], elements[middle]
+	pivot := elements[last]
+	
+	store := low
+	for i := low; i < last; i++ {
+		if less(elements[i], pivot) {
+			elements[i], elements[store] = elements[store], elements[i]
+			store++
+		}
+	}
+	elements[store], elements[last] = elements[last], elements[store]
+	return store
+}
+
+// insertionSortRange ordena elements[low:high] por inserção
+// Complexidade: O(k²) para k elementos, mas muito rápida para k pequeno
+func insertionSortRange[T any](elements []T, low, high int, less Comparator[T]) {
+	for i := low + 1; i < high; i++ {
+		current := elements[i]
+		j := i - 1
+		for j >= low && less(current, elements[j]) {
+			elements[j+1] = elements[j]
+			j--
+		}
+		elements[j+1] = current
+	}
+}
+
+// heapSortRange ordena elements[low:high] com heapsort
+// Complexidade: O(k log k), sem memória extra
+func heapSortRange[T any](elements []T, low, high int, less Comparator[T]) {
+	n := high - low
+	
+	// Constrói max-heap (segundo less) de baixo para cima
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDownRange(elements, low, i, n, less)
+	}
+	
+	// Move o máximo para o final e reduz o heap
+	for end := n - 1; end > 0; end-- {
+		elements[low], elements[low+end] = elements[low+end], elements[low]
+		siftDownRange(elements, low, 0, end, less)
+	}
+}
+
+// siftDownRange desce o nó root no heap elements[offset:offset+n]
+func siftDownRange[T any](elements []T, offset, root, n int, less Comparator[T]) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && less(elements[offset+child], elements[offset+child+1]) {
+			child++
+		}
+		if !less(elements[offset+root], elements[offset+child]) {
+			return
+		}
+		elements[offset+root], elements[offset+child] = elements[offset+child], elements[offset+root]
+		root = child
+	}
+}
+
+// ============================================================================
+// LINKEDLIST - MERGE SORT RELIGANDO NÓS
+// ============================================================================
+
+// Sort ordena a LinkedList com merge sort estável
+// Nenhum nó é alocado: apenas os ponteiros next são religados
+// Complexidade: O(n log n) de tempo, O(log n) de pilha de recursão
+func (list *LinkedList[T]) Sort(less Comparator[T]) {
+	defer checkInvariants(list)
+	list.head = mergeSortNodes(list.head, countingComparator(less, list.counters))
+	list.modCount++
+}
+
+// mergeSortNodes ordena a cadeia que começa em head e retorna a nova cabeça
+// Pseudocódigo:
+// 1. Cadeia com 0 ou 1 nó já está ordenada
+// 2. Dividir no meio (tortoise and hare)
+// 3. Ordenar cada metade recursivamente
+// 4. Intercalar as metades
+func mergeSortNodes[T comparable](head *Node[T], less Comparator[T]) *Node[T] {
+	if head == nil || head.next == nil {
+		return head
+	}
+	
+	// slow para no fim da primeira metade
+	slow := head
+	fast := head.next
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	second := slow.next
+	slow.next = nil
+	
+	return mergeNodes(mergeSortNodes(head, less), mergeSortNodes(second, less), less)
+}
+
+// mergeNodes intercala duas cadeias ordenadas
+// Em caso de empate o nó da esquerda vem primeiro (estabilidade)
+func mergeNodes[T comparable](left, right *Node[T], less Comparator[T]) *Node[T] {
+	var dummy Node[T]
+	tail := &dummy
+	
+	for left != nil && right != nil {
+		if less(right.value, left.value) {
+			tail.next = right
+			right = right.next
+		} else {
+			tail.next = left
+			left = left.next
+		}
+		tail = tail.next
+	}
+	
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return dummy.next
+}
+
+// ============================================================================
+// DOUBLYLINKEDLIST - MERGE SORT RELIGANDO NÓS
+// ============================================================================
+
+// Sort ordena a DoublyLinkedList com merge sort estável
+// Ordena usando apenas next e reconstrói prev/tail em uma passada final
+// Complexidade: O(n log n) de tempo, O(log n) de pilha de recursão
+func (list *DoublyLinkedList[T]) Sort(less Comparator[T]) {
+	defer checkInvariants(list)
+	list.head = mergeSortDoublyNodes(list.head, countingComparator(less, list.counters))
+	
+	// Reconstrói os ponteiros prev e o tail
+	var prev *DoublyNode[T]
+	for current := list.head; current != nil; current = current.next {
+		current.prev = prev
+		prev = current
+	}
+	list.tail = prev
+	list.modCount++
+}
+
+// mergeSortDoublyNodes ordena a cadeia (pelos ponteiros next) a partir de head
+func mergeSortDoublyNodes[T comparable](head *DoublyNode[T], less Comparator[T]) *DoublyNode[T] {
+	if head == nil || head.next == nil {
+		return head
+	}
+	
+	slow := head
+	fast := head.next
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	second := slow.next
+	slow.next = nil
+	
+	return mergeDoublyNodes(mergeSortDoublyNodes(head, less), mergeSortDoublyNodes(second, less), less)
+}
+
+// mergeDoublyNodes intercala duas cadeias ordenadas (apenas next)
+func mergeDoublyNodes[T comparable](left, right *DoublyNode[T], less Comparator[T]) *DoublyNode[T] {
+	var dummy DoublyNode[T]
+	tail := &dummy
+	
+	for left != nil && right != nil {
+		if less(right.data, left.data) {
+			tail.next = right
+			right = right.next
+		} else {
+			tail.next = left
+			left = left.next
+		}
+		tail = tail.next
+	}
+	
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return dummy.next
+}
@@ -0,0 +1,124 @@
+package list
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// keyed é um elemento com chave de ordenação e posição original,
+// para conferir a estabilidade
+type keyed struct {
+	key, seq int
+}
+
+func byKey(a, b keyed) bool {
+	return a.key < b.key
+}
+
+// wrappedList esconde o tipo concreto para SortListFunc usar o caminho genérico
+type wrappedList[T comparable] struct {
+	List[T]
+}
+
+func TestIntroSortHeapsortFallback(t *testing.T) {
+	for _, n := range []int{17, 100, 1000} {
+		reversed := make([]int, n)
+		for i := range reversed {
+			reversed[i] = n - i
+		}
+		want := slices.Sorted(slices.Values(reversed))
+		
+		// Sem profundidade o trecho inteiro vai direto para o heapsort;
+		// com profundidade 1 cada metade da partição também
+		for _, depthLimit := range []int{0, 1} {
+			elements := slices.Clone(reversed)
+			introSortRange(elements, 0, n, depthLimit, Less[int])
+			if !slices.Equal(elements, want) {
+				t.Errorf("n = %d, profundidade %d: %v", n, depthLimit, elements)
+			}
+		}
+		
+		// Só um subintervalo: o resto do slice não pode ser tocado
+		elements := slices.Clone(reversed)
+		heapSortRange(elements, 5, n-5, Less[int])
+		if !slices.IsSorted(elements[5:n-5]) || !slices.Equal(elements[:5], reversed[:5]) || !slices.Equal(elements[n-5:], reversed[n-5:]) {
+			t.Errorf("n = %d: heapsort em [5:%d] = %v", n, n-5, elements)
+		}
+		
+		// Todos iguais: o particionamento de Lomuto degenera e esgota a profundidade
+		equal := make([]int, n)
+		introSort(equal, Less[int])
+		introSort(reversed, Greater[int])
+		if !slices.Equal(equal, make([]int, n)) || !slices.IsSortedFunc(reversed, func(a, b int) int { return b - a }) {
+			t.Errorf("n = %d: iguais %v, decrescente %v", n, equal, reversed)
+		}
+	}
+}
+
+func TestSortListFuncRandom(t *testing.T) {
+	rng := rand.New(rand.NewPCG(4, 4))
+	lists := []struct {
+		name    string
+		newList func() List[int]
+	}{
+		{"ArrayList", func() List[int] { return NewArrayList[int](0) }},
+		{"LinkedList", func() List[int] { return NewLinkedList[int]() }},
+		{"DoublyLinkedList", func() List[int] { return NewDoublyLinkedList[int]() }},
+		{"genérica", func() List[int] { return wrappedList[int]{NewArrayList[int](0)} }},
+	}
+	for _, n := range []int{0, 1, 2, 15, 16, 17, 100, 1000} {
+		elements := make([]int, n)
+		for i := range elements {
+			elements[i] = rng.IntN(n/10 + 2) // Muitas repetições
+		}
+		want := slices.Sorted(slices.Values(elements))
+		
+		for _, l := range lists {
+			list := l.newList()
+			for _, element := range elements {
+				list.Add(element)
+			}
+			SortListFunc(list, Less[int])
+			if got := list.ToSlice(); !slices.Equal(got, want) {
+				t.Errorf("%s com %d elementos: %v", l.name, n, got)
+			}
+			if validator, ok := list.(interface{ Validate() error }); ok {
+				if err := validator.Validate(); err != nil {
+					t.Errorf("%s com %d elementos: %v", l.name, n, err)
+				}
+			}
+		}
+	}
+}
+
+func TestLinkedSortIsStable(t *testing.T) {
+	rng := rand.New(rand.NewPCG(9, 2))
+	elements := make([]keyed, 500)
+	for i := range elements {
+		elements[i] = keyed{key: rng.IntN(8), seq: i}
+	}
+	want := slices.Clone(elements)
+	slices.SortStableFunc(want, func(a, b keyed) int { return a.key - b.key })
+	
+	linked := NewLinkedList[keyed]()
+	linked.AddAll(elements)
+	linked.Sort(byKey)
+	doubly := NewDoublyLinkedList[keyed]()
+	doubly.AddAll(elements)
+	doubly.Sort(byKey)
+	
+	if !slices.Equal(linked.ToSlice(), want) || linked.Validate() != nil {
+		t.Errorf("LinkedList não é estável: %v (%v)", linked.ToSlice()[:10], linked.Validate())
+	}
+	if !slices.Equal(doubly.ToSlice(), want) || doubly.Validate() != nil {
+		t.Errorf("DoublyLinkedList não é estável: %v (%v)", doubly.ToSlice()[:10], doubly.Validate())
+	}
+	
+	// prev e tail reconstruídos: o percurso de trás para frente é o inverso
+	reversed := doubly.ToSliceReverse()
+	slices.Reverse(reversed)
+	if !slices.Equal(reversed, want) {
+		t.Error("DoublyLinkedList: ToSliceReverse não é o inverso de ToSlice depois de Sort")
+	}
+}
@@ -0,0 +1,117 @@
+package list
+
+import "fmt"
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// Validate verifica as invariantes internas do ArrayList
+// - 0 <= size <= capacidade do array interno
+// - capacidade <= MaxCapacity da política, quando houver limite
+// Complexidade: Θ(1)
+func (list *ArrayList[T]) Validate() error {
+	if list.size < 0 {
+		return fmt.Errorf("ArrayList: size negativo (%d)", list.size)
+	}
+	if list.size > len(list.elements) {
+		return fmt.Errorf("ArrayList: size %d maior que a capacidade %d", list.size, len(list.elements))
+	}
+	if limit := list.policy.Clamp(len(list.elements)); limit < len(list.elements) {
+		return fmt.Errorf("ArrayList: capacidade %d acima do máximo da política (%d)", len(list.elements), limit)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da LinkedList
+// - head == nil se e somente se size == 0
+// - a cadeia de nós não tem ciclo e termina em nil (último.next == nil)
+// - o contador size é igual ao número de nós
+// Complexidade: O(n)
+func (list *LinkedList[T]) Validate() error {
+	if (list.head == nil) != (list.size == 0) {
+		return fmt.Errorf("LinkedList: head nil = %v com size %d", list.head == nil, list.size)
+	}
+	if list.HasCycle() {
+		return fmt.Errorf("LinkedList: a cadeia de nós contém um ciclo")
+	}
+	
+	count := 0
+	for current := list.head; current != nil; current = current.next {
+		count++
+	}
+	if count != list.size {
+		return fmt.Errorf("LinkedList: size %d, mas a cadeia tem %d nós", list.size, count)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da DoublyLinkedList
+// - head, tail e size concordam sobre a lista estar vazia
+// - head.prev == nil e tail.next == nil
+// - para cada nó, node.next.prev == node (percursos nos dois sentidos concordam)
+// - o percurso para frente termina no tail e o para trás no head, ambos com size nós
+// Complexidade: O(n)
+func (list *DoublyLinkedList[T]) Validate() error {
+	if list.size < 0 {
+		return fmt.Errorf("DoublyLinkedList: size negativo (%d)", list.size)
+	}
+	if (list.head == nil) != (list.tail == nil) || (list.head == nil) != (list.size == 0) {
+		return fmt.Errorf("DoublyLinkedList: head nil = %v, tail nil = %v com size %d",
+			list.head == nil, list.tail == nil, list.size)
+	}
+	if list.head == nil {
+		return nil
+	}
+	if list.head.prev != nil {
+		return fmt.Errorf("DoublyLinkedList: head.prev deveria ser nil")
+	}
+	if list.tail.next != nil {
+		return fmt.Errorf("DoublyLinkedList: tail.next deveria ser nil")
+	}
+	
+	// Para frente: no máximo size nós, sempre com o prev do próximo apontando de volta
+	count := 1
+	current := list.head
+	for current.next != nil {
+		if current.next.prev != current {
+			return fmt.Errorf("DoublyLinkedList: no índice %d, next.prev não aponta de volta", count-1)
+		}
+		current = current.next
+		count++
+		if count > list.size {
+			return fmt.Errorf("DoublyLinkedList: mais nós que size (%d) ou ciclo em next", list.size)
+		}
+	}
+	if current != list.tail {
+		return fmt.Errorf("DoublyLinkedList: o percurso para frente não termina no tail")
+	}
+	if count != list.size {
+		return fmt.Errorf("DoublyLinkedList: size %d, mas o percurso para frente tem %d nós", list.size, count)
+	}
+	
+	// Para trás: como next.prev já foi conferido, basta chegar no head com size nós
+	count = 1
+	for current = list.tail; current.prev != nil; current = current.prev {
+		count++
+		if count > list.size {
+			return fmt.Errorf("DoublyLinkedList: mais nós que size (%d) ou ciclo em prev", list.size)
+		}
+	}
+	if current != list.head {
+		return fmt.Errorf("DoublyLinkedList: o percurso para trás não termina no head")
+	}
+	return nil
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug);
+// uma invariante quebrada vira panic, apontando a operação culpada no stack trace
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
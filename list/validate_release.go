@@ -0,0 +1,6 @@
+//go:build !debug
+
+package list
+
+// debugValidate desliga checkInvariants (compilação normal)
+const debugValidate = false
@@ -1,9 +1,14 @@
-package main
+package queue
 
 import (
 	"fmt"
-	"errors"
+	"iter"
+	"slices"
 	"strings"
+
+	"dca3503/capacity"
+	"dca3503/errs"
+	"dca3503/instrument"
 )
 
 // ============================================================================
@@ -15,16 +20,24 @@ import (
 // - Enqueue/Dequeue são operações O(1)
 // - Uso eficiente do espaço (reutiliza posições)
 // - Evita necessidade de mover elementos
-// - Capacidade fixa (pode ser redimensionada)
+// - Capacidade fixa (redimensionada conforme a política de capacidade)
 type ArrayQueue struct {
-	data     []int // Array interno que armazena os elementos
-	front    int   // Índice do primeiro elemento
-	rear     int   // Índice da próxima posição livre
-	size     int   // Número atual de elementos
-	capacity int   // Capacidade máxima do array
+	data     []int                // Array interno que armazena os elementos
+	front    int                  // Índice do primeiro elemento
+	rear     int                  // Índice da próxima posição livre
+	size     int                  // Número atual de elementos
+	capacity int                  // Capacidade máxima do array
+	policy   capacity.Policy      // Crescimento, redução e limite
+	gate     *capacity.Gate       // Sincronização do OverflowBlock (nil nos outros modos)
+	grows    int                  // Redimensionamentos para cima
+	shrinks  int                  // Redimensionamentos para baixo
+	err      error                // Primeiro Enqueue recusado por OverflowError (ver Err)
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewArrayQueue cria uma nova instância de ArrayQueue com capacidade inicial
+// A política começa como capacity.Shrinking: dobra ao encher e reduz à
+// metade quando só 1/4 do array está em uso
 func NewArrayQueue(initialCapacity int) *ArrayQueue {
 	if initialCapacity <= 0 {
 		initialCapacity = 10 // Capacidade padrão
@@ -35,41 +48,106 @@ func NewArrayQueue(initialCapacity int) *ArrayQueue {
 		rear:     0,
 		size:     0,
 		capacity: initialCapacity,
+		policy:   capacity.Shrinking,
 	}
 }
 
+// SetCounters associa contadores de operações à fila (nil desliga a contagem)
+// Conta escritas no array, comparações de busca e redimensionamentos
+func (q *ArrayQueue) SetCounters(counters *instrument.Counters) {
+	q.counters = counters
+}
+
 // ============================================================================
 // IMPLEMENTAÇÃO DA INTERFACE QUEUE
 // ============================================================================
 
 // Enqueue adiciona um elemento no final da fila
+// Com MaxCapacity atingida segue o Overflow da política: OverflowError
+// descarta o elemento e guarda o erro em Err (use TryEnqueue para recebê-lo
+// na hora), OverflowBlock
+// espera um Dequeue de outra goroutine e OverflowOverwrite descarta o
+// elemento da frente
 // Complexidade: O(1) - pode ser O(n) se redimensionar
 func (q *ArrayQueue) Enqueue(element int) {
+	if err := q.enqueue(element, true); err != nil && q.err == nil {
+		q.err = err
+	}
+}
+
+// TryEnqueue é o Enqueue que nunca espera: com a fila no limite retorna um
+// erro errs.ErrFull, exceto em OverflowOverwrite, que descarta a frente
+func (q *ArrayQueue) TryEnqueue(element int) error {
+	return q.enqueue(element, false)
+}
+
+// Err retorna o erro do primeiro Enqueue descartado por OverflowError (nil
+// se nenhum foi), para quem usa a fila pela interface Queue, sem TryEnqueue
+func (q *ArrayQueue) Err() error {
+	return q.err
+}
+
+// enqueue implementa Enqueue e TryEnqueue; wait diz se OverflowBlock pode esperar
+func (q *ArrayQueue) enqueue(element int, wait bool) error {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
+	if q.policy.Full(q.size) {
+		switch {
+		case q.policy.Overflow == capacity.OverflowOverwrite:
+			q.evictFront() // A frente é o elemento mais antigo
+		case q.policy.Overflow == capacity.OverflowBlock && wait:
+			for q.policy.Full(q.size) {
+				q.gate.Wait()
+			}
+		default:
+			return errs.New(errs.ErrFull, "fila cheia: capacidade máxima %d", "full queue: maximum capacity %d", q.policy.MaxCapacity)
+		}
+	}
+	q.push(element)
+	return nil
+}
+
+// push grava o elemento no final, crescendo o array se estiver cheio
+func (q *ArrayQueue) push(element int) {
 	// Verifica se precisa redimensionar
-	if q.IsFull() {
-		q.resize(q.capacity * 2)
+	if q.size == q.capacity {
+		q.resize(q.policy.Grow(q.capacity, q.capacity+1))
 	}
 	
 	q.data[q.rear] = element
 	q.rear = (q.rear + 1) % q.capacity
 	q.size++
+	q.counters.AddMoves(1)
+}
+
+// evictFront descarta e retorna o elemento da frente, sem reduzir o array
+func (q *ArrayQueue) evictFront() int {
+	element := q.data[q.front]
+	q.front = (q.front + 1) % q.capacity
+	q.size--
+	return element
 }
 
 // Dequeue remove e retorna o elemento do início da fila
 // Complexidade: O(1)
 func (q *ArrayQueue) Dequeue() (int, error) {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não é possível fazer dequeue")
+		return 0, errDequeueEmpty
 	}
 	
 	element := q.data[q.front]
 	q.front = (q.front + 1) % q.capacity
 	q.size--
 	
-	// Redimensiona para baixo se necessário (economiza memória)
-	if q.size > 0 && q.size == q.capacity/4 {
-		q.resize(q.capacity / 2)
+	// Redimensiona para baixo conforme a política (economiza memória)
+	if next := q.policy.Shrink(q.capacity, q.size); next < q.capacity {
+		q.resize(next)
 	}
+	q.gate.Signal()
 	
 	return element, nil
 }
@@ -78,7 +156,7 @@ func (q *ArrayQueue) Dequeue() (int, error) {
 // Complexidade: O(1)
 func (q *ArrayQueue) Front() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento na frente")
+		return 0, errFrontEmpty
 	}
 	return q.data[q.front], nil
 }
@@ -87,7 +165,7 @@ func (q *ArrayQueue) Front() (int, error) {
 // Complexidade: O(1)
 func (q *ArrayQueue) Rear() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento no final")
+		return 0, errRearEmpty
 	}
 	// rear aponta para próxima posição livre, então elemento atual está em rear-1
 	rearIndex := (q.rear - 1 + q.capacity) % q.capacity
@@ -107,14 +185,18 @@ func (q *ArrayQueue) IsEmpty() bool {
 }
 
 // IsFull verifica se a fila está cheia
+// Só acontece com MaxCapacity na política: sem limite o array sempre cresce
 // Complexidade: O(1)
 func (q *ArrayQueue) IsFull() bool {
-	return q.size == q.capacity
+	return q.policy.Full(q.size)
 }
 
 // Clear remove todos os elementos da fila
 // Complexidade: O(1)
 func (q *ArrayQueue) Clear() {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
 	q.front = 0
 	q.rear = 0
 	q.size = 0
@@ -122,6 +204,7 @@ func (q *ArrayQueue) Clear() {
 	if q.capacity > 10 {
 		q.resize(10)
 	}
+	q.gate.Signal()
 }
 
 // ToSlice converte a fila para um slice (do início para o final)
@@ -184,14 +267,45 @@ func (q *ArrayQueue) resize(newCapacity int) {
 		newData[i] = q.data[oldIndex]
 	}
 	
+	if newCapacity > q.capacity {
+		q.grows++
+	} else {
+		q.shrinks++
+	}
 	q.data = newData
 	q.front = 0
-	q.rear = q.size
+	q.rear = q.size % newCapacity // Array cheio: rear volta para 0
 	q.capacity = newCapacity
+	q.counters.Resize(q.size)
+}
+
+// SetGrowthPolicy troca a política de capacidade da fila
+// Retorna erro se a política for inválida ou se os elementos atuais não
+// couberem em MaxCapacity; um array maior que MaxCapacity é reduzido
+// Com OverflowBlock, Enqueue, Dequeue e Clear passam a ser sincronizados
+// para que outra goroutine possa abrir espaço; os demais métodos continuam
+// sem sincronização. Chame antes de compartilhar a fila
+func (q *ArrayQueue) SetGrowthPolicy(policy capacity.Policy) error {
+	defer checkInvariants(q)
+	if err := policy.Validate(q.size); err != nil {
+		return err
+	}
+	q.policy = policy
+	q.gate = policy.NewGate()
+	if limit := policy.Clamp(q.capacity); limit < q.capacity {
+		q.resize(limit)
+	}
+	return nil
+}
+
+// GrowthPolicy retorna a política de capacidade atual
+func (q *ArrayQueue) GrowthPolicy() capacity.Policy {
+	return q.policy
 }
 
 // TrimToSize reduz a capacidade para o tamanho atual (economiza memória)
 func (q *ArrayQueue) TrimToSize() {
+	defer checkInvariants(q)
 	if q.capacity > q.size {
 		newCapacity := q.size
 		if newCapacity == 0 {
@@ -202,7 +316,10 @@ func (q *ArrayQueue) TrimToSize() {
 }
 
 // EnsureCapacity garante que a fila tenha pelo menos a capacidade especificada
+// Não passa de MaxCapacity
 func (q *ArrayQueue) EnsureCapacity(minCapacity int) {
+	defer checkInvariants(q)
+	minCapacity = q.policy.Clamp(minCapacity)
 	if q.capacity < minCapacity {
 		q.resize(minCapacity)
 	}
@@ -210,6 +327,7 @@ func (q *ArrayQueue) EnsureCapacity(minCapacity int) {
 
 // EnqueueAll adiciona múltiplos elementos de uma vez
 func (q *ArrayQueue) EnqueueAll(elements []int) {
+	defer checkInvariants(q)
 	// Garante capacidade suficiente
 	q.EnsureCapacity(q.size + len(elements))
 	
@@ -220,12 +338,13 @@ func (q *ArrayQueue) EnqueueAll(elements []int) {
 
 // DequeueMultiple remove e retorna múltiplos elementos do início
 func (q *ArrayQueue) DequeueMultiple(count int) ([]int, error) {
+	defer checkInvariants(q)
 	if count <= 0 {
 		return []int{}, nil
 	}
 	
 	if count > q.size {
-		return nil, fmt.Errorf("não há elementos suficientes: solicitado %d, disponível %d", count, q.size)
+		return nil, errs.NotEnough(count, q.size)
 	}
 	
 	result := make([]int, count)
@@ -242,6 +361,7 @@ func (q *ArrayQueue) DequeueMultiple(count int) ([]int, error) {
 func (q *ArrayQueue) Contains(element int) bool {
 	for i := 0; i < q.size; i++ {
 		index := (q.front + i) % q.capacity
+		q.counters.AddComparisons(1)
 		if q.data[index] == element {
 			return true
 		}
@@ -255,6 +375,7 @@ func (q *ArrayQueue) Contains(element int) bool {
 func (q *ArrayQueue) IndexOf(element int) int {
 	for i := 0; i < q.size; i++ {
 		index := (q.front + i) % q.capacity
+		q.counters.AddComparisons(1)
 		if q.data[index] == element {
 			return i
 		}
@@ -265,9 +386,11 @@ func (q *ArrayQueue) IndexOf(element int) int {
 // Clone cria uma cópia independente da fila
 func (q *ArrayQueue) Clone() *ArrayQueue {
 	newQueue := NewArrayQueue(q.capacity)
+	newQueue.policy = q.policy
+	newQueue.gate = q.policy.NewGate()
 	newQueue.size = q.size
 	newQueue.front = 0
-	newQueue.rear = q.size
+	newQueue.rear = q.size % q.capacity // Fila cheia: rear volta para 0
 	
 	// Copia elementos na ordem correta
 	for i := 0; i < q.size; i++ {
@@ -295,6 +418,60 @@ func (q *ArrayQueue) Equals(other *ArrayQueue) bool {
 	return true
 }
 
+// ============================================================================
+// USO COMO BUFFER CIRCULAR
+// ============================================================================
+
+// Overwrite adiciona um elemento no final como num buffer circular: com
+// MaxCapacity atingida descarta o elemento da frente, qualquer que seja o
+// Overflow da política, e o retorna com ok == true
+// Sem MaxCapacity nunca descarta nada: a fila cresce como no Enqueue
+// Complexidade: O(1) - pode ser O(n) se redimensionar
+func (q *ArrayQueue) Overwrite(element int) (evicted int, ok bool) {
+	q.gate.Lock()
+	defer q.gate.Unlock()
+	defer checkInvariants(q)
+	if q.policy.Full(q.size) {
+		evicted, ok = q.evictFront(), true
+	}
+	q.push(element)
+	return evicted, ok
+}
+
+// At retorna o elemento na posição index a partir do início (0 é a frente)
+// Complexidade: O(1)
+func (q *ArrayQueue) At(index int) (int, error) {
+	if index < 0 || index >= q.size {
+		return 0, errs.Index(index, q.size)
+	}
+	return q.data[(q.front+index)%q.capacity], nil
+}
+
+// AtBack retorna o elemento na posição index a partir do final (0 é o final)
+// Complexidade: O(1)
+func (q *ArrayQueue) AtBack(index int) (int, error) {
+	if index < 0 || index >= q.size {
+		return 0, errs.Index(index, q.size)
+	}
+	return q.data[(q.front+q.size-1-index)%q.capacity], nil
+}
+
+// Snapshot copia os elementos (do início para o final) para dst e retorna o
+// slice resultante, como append(dst[:0], q.ToSlice()...)
+// Reaproveita o array de dst quando ele tem capacidade suficiente: chamado
+// sempre com o mesmo slice, não aloca depois da primeira vez
+// Complexidade: O(n), no máximo duas cópias contíguas
+func (q *ArrayQueue) Snapshot(dst []int) []int {
+	dst = slices.Grow(dst[:0], q.size)[:q.size]
+	if end := q.front + q.size; end <= q.capacity {
+		copy(dst, q.data[q.front:end])
+	} else {
+		n := copy(dst, q.data[q.front:])
+		copy(dst[n:], q.data[:end-q.capacity])
+	}
+	return dst
+}
+
 // ============================================================================
 // MÉTODOS DE ANÁLISE E ESTATÍSTICAS
 // ============================================================================
@@ -308,6 +485,7 @@ func (q *ArrayQueue) GetMemoryUsage() (int, int, float64) {
 }
 
 // GetStatistics retorna estatísticas da fila
+// grows e shrinks contam os redimensionamentos para cima e para baixo
 func (q *ArrayQueue) GetStatistics() map[string]interface{} {
 	if q.IsEmpty() {
 		return map[string]interface{}{
@@ -315,6 +493,8 @@ func (q *ArrayQueue) GetStatistics() map[string]interface{} {
 			"capacity":     q.capacity,
 			"utilization": 0.0,
 			"isEmpty":      true,
+			"grows":        q.grows,
+			"shrinks":      q.shrinks,
 		}
 	}
 	
@@ -350,6 +530,8 @@ func (q *ArrayQueue) GetStatistics() map[string]interface{} {
 		"max":          max,
 		"frontIndex":   q.front,
 		"rearIndex":    q.rear,
+		"grows":        q.grows,
+		"shrinks":      q.shrinks,
 	}
 }
 
@@ -359,6 +541,7 @@ func (q *ArrayQueue) GetStatistics() map[string]interface{} {
 
 // Rotate rotaciona a fila k posições para a esquerda
 func (q *ArrayQueue) Rotate(k int) {
+	defer checkInvariants(q)
 	if q.IsEmpty() || k <= 0 {
 		return
 	}
@@ -374,6 +557,7 @@ func (q *ArrayQueue) Rotate(k int) {
 
 // Reverse inverte a ordem dos elementos na fila
 func (q *ArrayQueue) Reverse() {
+	defer checkInvariants(q)
 	if q.size <= 1 {
 		return
 	}
@@ -450,4 +634,42 @@ func (q *ArrayQueue) GetInternalState() map[string]interface{} {
 		"capacity": q.capacity,
 		"data":     q.data,
 	}
-}
\ No newline at end of file
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) do início para o final
+// Percorre o array circular sem copiar os elementos
+func (q *ArrayQueue) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(i, q.data[(q.front+i)%q.capacity]) {
+				return
+			}
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início para o final
+func (q *ArrayQueue) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.data[(q.front+i)%q.capacity]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward retorna um iterador sobre pares (posição, elemento) do final para o início
+func (q *ArrayQueue) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := q.size - 1; i >= 0; i-- {
+			if !yield(i, q.data[(q.front+i)%q.capacity]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"sync"
+	"time"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// BLOCKINGQUEUE - FILA LIMITADA E SEGURA PARA USO CONCORRENTE
+// ============================================================================
+
+// ErrQueueClosed é retornado por operações em uma BlockingQueue fechada
+var ErrQueueClosed = errs.Define("fila fechada", "queue closed")
+
+// BlockingQueue implementa uma fila limitada para várias goroutines
+// (produtores e consumidores) usando o array circular do ArrayQueue
+// Características:
+// - Put bloqueia enquanto a fila está cheia, Take enquanto está vazia
+// - Esperas podem ser canceladas por context ou por timeout
+// - Close acorda todos os que estão esperando
+// - Um único mutex protege o buffer; canais fazem o papel de variáveis de condição
+type BlockingQueue struct {
+	mutex    sync.Mutex
+	buffer   *ArrayQueue   // Array circular com os elementos
+	capacity int           // Limite máximo de elementos
+	notFull  chan struct{} // Fechado (e recriado) quando sai um elemento
+	notEmpty chan struct{} // Fechado (e recriado) quando entra um elemento
+	closed   bool          // true após Close
+}
+
+// NewBlockingQueue cria uma fila bloqueante com capacidade máxima fixa
+func NewBlockingQueue(capacity int) *BlockingQueue {
+	if capacity <= 0 {
+		capacity = 10 // Capacidade padrão
+	}
+	return &BlockingQueue{
+		buffer:   NewArrayQueue(capacity),
+		capacity: capacity,
+		notFull:  make(chan struct{}),
+		notEmpty: make(chan struct{}),
+	}
+}
+
+// SetCounters associa contadores de operações ao buffer interno
+// A contagem acontece sob o mutex da fila, então o mesmo *Counters não deve
+// ser compartilhado com outras estruturas usadas em paralelo
+func (q *BlockingQueue) SetCounters(counters *instrument.Counters) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.buffer.SetCounters(counters)
+}
+
+// ============================================================================
+// OPERAÇÕES BLOQUEANTES
+// ============================================================================
+
+// Put adiciona um elemento no final, esperando enquanto a fila estiver cheia
+// Retorna ctx.Err() se o contexto for cancelado e ErrQueueClosed se a fila fechar
+// Complexidade: O(1) amortizado, mais o tempo de espera
+func (q *BlockingQueue) Put(ctx context.Context, element int) error {
+	for {
+		q.mutex.Lock()
+		if q.closed {
+			q.mutex.Unlock()
+			return ErrQueueClosed
+		}
+		if q.buffer.Size() < q.capacity {
+			q.buffer.Enqueue(element)
+			q.signal(&q.notEmpty)
+			q.mutex.Unlock()
+			return nil
+		}
+		wait := q.notFull
+		q.mutex.Unlock()
+		
+		select {
+		case <-wait:
+			// Saiu algum elemento (ou a fila fechou): tenta de novo
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Take remove e retorna o elemento do início, esperando enquanto a fila estiver vazia
+// Depois de Close, continua entregando os elementos restantes e só então
+// retorna ErrQueueClosed
+// Complexidade: O(1), mais o tempo de espera
+func (q *BlockingQueue) Take(ctx context.Context) (int, error) {
+	for {
+		q.mutex.Lock()
+		if !q.buffer.IsEmpty() {
+			value, _ := q.buffer.Dequeue()
+			q.signal(&q.notFull)
+			q.mutex.Unlock()
+			return value, nil
+		}
+		if q.closed {
+			q.mutex.Unlock()
+			return 0, ErrQueueClosed
+		}
+		wait := q.notEmpty
+		q.mutex.Unlock()
+		
+		select {
+		case <-wait:
+			// Entrou algum elemento (ou a fila fechou): tenta de novo
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// Offer tenta adicionar um elemento esperando no máximo timeout
+// Retorna false se o tempo acabar ou se a fila estiver fechada
+// Com timeout <= 0 a tentativa não bloqueia
+func (q *BlockingQueue) Offer(element int, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return q.Put(ctx, element) == nil
+}
+
+// Poll tenta remover um elemento esperando no máximo timeout
+// Retorna (0, false) se o tempo acabar ou se a fila estiver fechada e vazia
+// Com timeout <= 0 a tentativa não bloqueia
+func (q *BlockingQueue) Poll(timeout time.Duration) (int, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	value, err := q.Take(ctx)
+	return value, err == nil
+}
+
+// signal acorda todas as goroutines esperando no canal e prepara um novo
+// Deve ser chamado com o mutex travado
+func (q *BlockingQueue) signal(channel *chan struct{}) {
+	close(*channel)
+	*channel = make(chan struct{})
+}
+
+// ============================================================================
+// ENCERRAMENTO E DRENAGEM
+// ============================================================================
+
+// Close fecha a fila e acorda todos os produtores e consumidores bloqueados
+// Novos Put falham; Take entrega o que sobrou e depois falha
+// Chamar Close mais de uma vez não tem efeito
+func (q *BlockingQueue) Close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.signal(&q.notFull)
+	q.signal(&q.notEmpty)
+}
+
+// IsClosed verifica se a fila foi fechada
+func (q *BlockingQueue) IsClosed() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.closed
+}
+
+// Drain remove e retorna, de uma vez, todos os elementos disponíveis
+// Não bloqueia; retorna slice vazio se não houver elementos
+// Complexidade: O(n)
+func (q *BlockingQueue) Drain() []int {
+	return q.DrainTo(-1)
+}
+
+// DrainTo remove no máximo maxElements elementos (todos se maxElements < 0)
+// Os elementos saem em ordem FIFO e a operação é atômica
+// Complexidade: O(k) onde k é o número de elementos removidos
+func (q *BlockingQueue) DrainTo(maxElements int) []int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	
+	count := q.buffer.Size()
+	if maxElements >= 0 && maxElements < count {
+		count = maxElements
+	}
+	
+	result, _ := q.buffer.DequeueMultiple(count)
+	if count > 0 {
+		q.signal(&q.notFull)
+	}
+	return result
+}
+
+// ============================================================================
+// MÉTODOS DE CONSULTA
+// ============================================================================
+
+// Size retorna o número de elementos no momento da chamada
+func (q *BlockingQueue) Size() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.buffer.Size()
+}
+
+// IsEmpty verifica se a fila está vazia no momento da chamada
+func (q *BlockingQueue) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// IsFull verifica se a fila atingiu a capacidade máxima
+func (q *BlockingQueue) IsFull() bool {
+	return q.Size() == q.capacity
+}
+
+// Capacity retorna a capacidade máxima da fila
+func (q *BlockingQueue) Capacity() int {
+	return q.capacity
+}
+
+// RemainingCapacity retorna quantos elementos ainda cabem sem bloquear
+func (q *BlockingQueue) RemainingCapacity() int {
+	return q.capacity - q.Size()
+}
+
+// ToSlice retorna uma cópia dos elementos (do início para o final)
+func (q *BlockingQueue) ToSlice() []int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.buffer.ToSlice()
+}
+
+// String retorna uma representação em string da fila
+func (q *BlockingQueue) String() string {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return fmt.Sprintf("%s (%d/%d)", q.buffer.String(), q.buffer.Size(), q.capacity)
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre uma cópia dos elementos (do início para o final)
+// A cópia é tirada com o mutex travado, então a iteração não bloqueia a fila
+// nem vê alterações feitas depois da chamada
+func (q *BlockingQueue) All() iter.Seq2[int, int] {
+	snapshot := q.ToSlice()
+	return func(yield func(int, int) bool) {
+		for i, value := range snapshot {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
+
+// Values retorna um iterador sobre uma cópia dos elementos
+func (q *BlockingQueue) Values() iter.Seq[int] {
+	snapshot := q.ToSlice()
+	return func(yield func(int) bool) {
+		for _, value := range snapshot {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward retorna um iterador sobre uma cópia dos elementos, do final para o início
+func (q *BlockingQueue) Backward() iter.Seq2[int, int] {
+	snapshot := q.ToSlice()
+	return func(yield func(int, int) bool) {
+		for i := len(snapshot) - 1; i >= 0; i-- {
+			if !yield(i, snapshot[i]) {
+				return
+			}
+		}
+	}
+}
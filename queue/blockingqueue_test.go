@@ -0,0 +1,169 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueueFIFO(t *testing.T) {
+	q := NewBlockingQueue(3)
+	ctx := context.Background()
+
+	for i := 1; i <= 3; i++ {
+		if err := q.Put(ctx, i); err != nil {
+			t.Fatalf("Put(%d): %v", i, err)
+		}
+	}
+	if !q.IsFull() {
+		t.Fatalf("fila deveria estar cheia: %s", q)
+	}
+	for i := 1; i <= 3; i++ {
+		value, err := q.Take(ctx)
+		if err != nil || value != i {
+			t.Fatalf("Take = %d, %v; esperado %d", value, err, i)
+		}
+	}
+}
+
+func TestBlockingQueueTimeouts(t *testing.T) {
+	q := NewBlockingQueue(1)
+
+	if _, ok := q.Poll(10 * time.Millisecond); ok {
+		t.Fatal("Poll em fila vazia deveria expirar")
+	}
+	if !q.Offer(1, 0) {
+		t.Fatal("Offer com espaço livre deveria funcionar sem esperar")
+	}
+	if q.Offer(2, 10*time.Millisecond) {
+		t.Fatal("Offer em fila cheia deveria expirar")
+	}
+	if value, ok := q.Poll(0); !ok || value != 1 {
+		t.Fatalf("Poll = %d, %t; esperado 1, true", value, ok)
+	}
+}
+
+func TestBlockingQueueContextCancel(t *testing.T) {
+	q := NewBlockingQueue(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error)
+	go func() {
+		_, err := q.Take(ctx)
+		done <- err
+	}()
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Take cancelado retornou %v", err)
+	}
+}
+
+func TestBlockingQueueCloseWakesWaiters(t *testing.T) {
+	q := NewBlockingQueue(1)
+	ctx := context.Background()
+	q.Put(ctx, 42)
+
+	producer := make(chan error)
+	go func() { producer <- q.Put(ctx, 43) }()
+
+	empty := NewBlockingQueue(1)
+	consumer := make(chan error)
+	go func() {
+		_, err := empty.Take(ctx)
+		consumer <- err
+	}()
+
+	q.Close()
+	empty.Close()
+
+	if err := <-producer; !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Put bloqueado retornou %v", err)
+	}
+	if err := <-consumer; !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Take bloqueado retornou %v", err)
+	}
+
+	// Elementos restantes ainda podem ser retirados
+	if value, err := q.Take(ctx); err != nil || value != 42 {
+		t.Fatalf("Take após Close = %d, %v", value, err)
+	}
+	if _, err := q.Take(ctx); !errors.Is(err, ErrQueueClosed) {
+		t.Fatalf("Take em fila fechada e vazia retornou %v", err)
+	}
+}
+
+func TestBlockingQueueDrain(t *testing.T) {
+	q := NewBlockingQueue(5)
+	for i := 0; i < 5; i++ {
+		q.Offer(i, 0)
+	}
+
+	if got := q.DrainTo(2); len(got) != 2 || got[0] != 0 || got[1] != 1 {
+		t.Fatalf("DrainTo(2) = %v", got)
+	}
+	if got := q.Drain(); len(got) != 3 || got[0] != 2 {
+		t.Fatalf("Drain() = %v", got)
+	}
+	if !q.IsEmpty() {
+		t.Fatal("fila deveria estar vazia após Drain")
+	}
+}
+
+// TestBlockingQueueConcurrent roda vários produtores e consumidores sobre
+// uma fila pequena; use go test -race para verificar a sincronização
+func TestBlockingQueueConcurrent(t *testing.T) {
+	const producers, consumers, perProducer = 8, 8, 2000
+	q := NewBlockingQueue(4)
+	ctx := context.Background()
+
+	var producersDone sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producersDone.Add(1)
+		go func(p int) {
+			defer producersDone.Done()
+			for i := 0; i < perProducer; i++ {
+				if err := q.Put(ctx, p*perProducer+i); err != nil {
+					t.Errorf("Put: %v", err)
+					return
+				}
+			}
+		}(p)
+	}
+
+	results := make(chan []int, consumers)
+	for c := 0; c < consumers; c++ {
+		go func() {
+			var taken []int
+			for {
+				value, err := q.Take(ctx)
+				if errors.Is(err, ErrQueueClosed) {
+					results <- taken
+					return
+				}
+				taken = append(taken, value)
+			}
+		}()
+	}
+
+	producersDone.Wait()
+	q.Close()
+
+	var all []int
+	for c := 0; c < consumers; c++ {
+		all = append(all, <-results...)
+	}
+	sort.Ints(all)
+
+	if len(all) != producers*perProducer {
+		t.Fatalf("consumidos %d elementos, esperado %d", len(all), producers*perProducer)
+	}
+	for i, value := range all {
+		if value != i {
+			t.Fatalf("elemento %d perdido ou duplicado", i)
+		}
+	}
+}
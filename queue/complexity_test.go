@@ -0,0 +1,98 @@
+package queue_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"dca3503/instrument"
+	"dca3503/queue"
+)
+
+// Cada caso confere, contando operações elementares, uma complexidade
+// anotada nos comentários das implementações
+func TestQueueComplexity(t *testing.T) {
+	cases := []struct {
+		name     string
+		metric   instrument.Metric
+		expected instrument.Growth
+		run      instrument.Run
+	}{
+		{"ArrayQueue.Enqueue amortizado", instrument.Moves, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				q := queue.NewArrayQueue(1)
+				q.SetCounters(c)
+				for i := 0; i < n; i++ {
+					q.Enqueue(i)
+				}
+				return n
+			}},
+		{"ArrayQueue.Contains", instrument.Comparisons, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				q := queue.NewArrayQueue(n)
+				for i := 0; i < n; i++ {
+					q.Enqueue(i)
+				}
+				q.SetCounters(c)
+				q.Contains(-1)
+				return 1
+			}},
+		{"LinkedQueue.Enqueue", instrument.Total, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				q := queue.NewLinkedQueue()
+				q.SetCounters(c)
+				for i := 0; i < n; i++ {
+					q.Enqueue(i)
+				}
+				return n
+			}},
+		{"PriorityQueue.Enqueue", instrument.Comparisons, instrument.Logarithmic,
+			func(n int, c *instrument.Counters) int {
+				// Valores decrescentes num min-heap: cada um sobe até a raiz
+				pq := queue.NewMinPriorityQueue(n)
+				for i := 0; i < n; i++ {
+					pq.Enqueue(2*n - i)
+				}
+				pq.SetCounters(c)
+				for i := 0; i < n; i++ {
+					pq.Enqueue(n - i)
+				}
+				return n
+			}},
+		{"PriorityQueue.Dequeue", instrument.Comparisons, instrument.Logarithmic,
+			func(n int, c *instrument.Counters) int {
+				rng := rand.New(rand.NewPCG(uint64(n), 3))
+				pq := queue.NewMinPriorityQueue(2 * n)
+				for i := 0; i < 2*n; i++ {
+					pq.Enqueue(rng.IntN(n))
+				}
+				pq.SetCounters(c)
+				for i := 0; i < n; i++ {
+					pq.Dequeue()
+				}
+				return n
+			}},
+		{"PriorityQueue.Heapify", instrument.Comparisons, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				rng := rand.New(rand.NewPCG(uint64(n), 4))
+				elements := make([]int, n)
+				for i := range elements {
+					elements[i] = rng.IntN(n)
+				}
+				pq := queue.NewMinPriorityQueue(n)
+				pq.SetCounters(c)
+				pq.Heapify(elements)
+				return 1
+			}},
+	}
+	
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := instrument.Measure(nil, tc.run)
+			fit := instrument.FitGrowth(samples, tc.metric)
+			if fit.Growth != tc.expected {
+				t.Errorf("esperado O(%s), medido %s\n%s", tc.expected, fit,
+					instrument.Report(tc.name, samples, tc.metric))
+			}
+		})
+	}
+}
@@ -0,0 +1,442 @@
+package queue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"dca3503/errs"
+	"dca3503/serial"
+)
+
+// ============================================================================
+// WRITE-AHEAD LOG DA DURABLEQUEUE
+// ============================================================================
+
+// Arquivos no diretório da fila:
+//
+//	wal-<n>.log        segmento n do log: cabeçalho + registros
+//	snapshot-<n>.dca   conteúdo da fila antes do segmento n (formato do pacote serial)
+//	*.tmp              snapshot interrompido, apagado na abertura
+//
+// Registro: operação (1 byte), valor em varint zigzag (só no Enqueue) e
+// CRC-32 IEEE big-endian da operação e do valor
+//
+// Compactação, na ordem em que é segura contra quedas:
+//  1. abre o segmento n+1 e passa a gravar nele
+//  2. grava snapshot-<n+1>.dca.tmp, faz fsync e renomeia
+//  3. apaga os segmentos até n e os snapshots anteriores
+// Se o processo cair entre os passos, a abertura usa o snapshot mais novo
+// e apaga o que sobrou dos anteriores
+
+// Cabeçalho de cada segmento: assinatura e versão do formato do log
+const (
+	walMagic      = "DCAW"
+	walVersion    = 1
+	walHeaderSize = len(walMagic) + 1
+)
+
+// Operações gravadas no log
+const (
+	recordEnqueue byte = 'E'
+	recordDequeue byte = 'D'
+	recordClear   byte = 'C'
+)
+
+// walHeader retorna os bytes do cabeçalho de um segmento novo
+func walHeader() []byte {
+	return append([]byte(walMagic), walVersion)
+}
+
+// recordChecksumSize é o CRC-32 no final de cada registro
+const recordChecksumSize = 4
+
+// errTruncated marca um registro que termina antes do fim dos dados
+var errTruncated = errs.Define("registro incompleto", "truncated record")
+
+// segmentName e snapshotName montam os nomes dos arquivos; os números têm
+// largura fixa para que a ordem alfabética seja a numérica
+func segmentName(id uint64) string {
+	return fmt.Sprintf("wal-%016d.log", id)
+}
+
+func snapshotName(id uint64) string {
+	return fmt.Sprintf("snapshot-%016d.dca", id)
+}
+
+// parseName extrai o número de um nome com o prefixo e o sufixo dados
+func parseName(name, prefix, suffix string) (uint64, bool) {
+	if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, suffix) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(name[len(prefix):len(name)-len(suffix)], 10, 64)
+	return id, err == nil
+}
+
+// path retorna o caminho de um arquivo da fila
+func (q *DurableQueue) path(name string) string {
+	return filepath.Join(q.dir, name)
+}
+
+// ============================================================================
+// REGISTROS
+// ============================================================================
+
+// appendRecord codifica um registro no final de buf
+func appendRecord(buf []byte, op byte, value int) []byte {
+	start := len(buf)
+	buf = append(buf, op)
+	if op == recordEnqueue {
+		buf = binary.AppendVarint(buf, int64(value))
+	}
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf[start:]))
+}
+
+// decodeRecord lê o registro no início de data e retorna seu tamanho
+// Com checksum errado o tamanho também é retornado, para decidir se o
+// registro era o último do arquivo
+func decodeRecord(data []byte) (op byte, value int, n int, err error) {
+	op, n = data[0], 1
+	switch op {
+	case recordEnqueue:
+		v, size := binary.Varint(data[1:])
+		if size == 0 {
+			return op, 0, 0, errTruncated
+		}
+		if size < 0 {
+			return op, 0, 0, errs.New(nil, "varint inválido", "invalid varint")
+		}
+		value, n = int(v), n+size
+	case recordDequeue, recordClear:
+	default:
+		return op, 0, 0, errs.New(nil, "operação desconhecida %q", "unknown operation %q", op)
+	}
+	if len(data) < n+recordChecksumSize {
+		return op, 0, 0, errTruncated
+	}
+	stored := binary.BigEndian.Uint32(data[n:])
+	if computed := crc32.ChecksumIEEE(data[:n]); computed != stored {
+		return op, 0, n + recordChecksumSize, errs.New(nil, "checksum %08x, o registro dá %08x", "checksum %08x, the record gives %08x", stored, computed)
+	}
+	return op, value, n + recordChecksumSize, nil
+}
+
+// isTorn decide se um registro ruim é o fim de uma gravação interrompida:
+// incompleto, ruim e terminando exatamente no fim do arquivo, ou seguido só
+// de zeros (o sistema de arquivos aumentou o arquivo sem gravar os dados)
+// Qualquer outro defeito é corrupção de verdade e impede a abertura
+func isTorn(rest []byte, n int, err error) bool {
+	return errors.Is(err, errTruncated) || n == len(rest) || len(bytes.TrimLeft(rest, "\x00")) == 0
+}
+
+// apply reaplica um registro na fila em memória
+func (q *DurableQueue) apply(op byte, value int) error {
+	switch op {
+	case recordEnqueue:
+		q.memory.Enqueue(value)
+	case recordDequeue:
+		if _, err := q.memory.Dequeue(); err != nil {
+			return errs.New(nil, "dequeue com a fila vazia", "dequeue on an empty queue")
+		}
+	case recordClear:
+		q.memory.Clear()
+	}
+	return nil
+}
+
+// ============================================================================
+// ABERTURA E RECUPERAÇÃO
+// ============================================================================
+
+// recover carrega o snapshot mais novo, reaplica os segmentos seguintes e
+// abre o último para continuar gravando
+func (q *DurableQueue) recover() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+	var snapshots, segments []uint64
+	for _, entry := range entries {
+		name := entry.Name()
+		if id, ok := parseName(name, "snapshot-", ".dca"); ok {
+			snapshots = append(snapshots, id)
+		} else if id, ok := parseName(name, "wal-", ".log"); ok {
+			segments = append(segments, id)
+		} else if strings.HasSuffix(name, ".tmp") {
+			os.Remove(q.path(name)) // Snapshot que não chegou a ser renomeado
+		}
+	}
+	slices.Sort(snapshots)
+	slices.Sort(segments)
+	
+	q.firstSegment = 1
+	if len(snapshots) > 0 {
+		q.firstSegment = snapshots[len(snapshots)-1]
+		name := snapshotName(q.firstSegment)
+		data, err := os.ReadFile(q.path(name))
+		if err != nil {
+			return err
+		}
+		values, err := serial.Unmarshal[int](serial.Queue, data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		q.memory.load(values)
+	}
+	
+	// Sobras de uma compactação interrompida: já estão no snapshot
+	for _, id := range snapshots[:max(len(snapshots)-1, 0)] {
+		os.Remove(q.path(snapshotName(id)))
+	}
+	live := segments[:0]
+	for _, id := range segments {
+		if id < q.firstSegment {
+			os.Remove(q.path(segmentName(id)))
+		} else {
+			live = append(live, id)
+		}
+	}
+	
+	for i, id := range live {
+		if id != q.firstSegment+uint64(i) {
+			return errs.New(serial.ErrCorrupt, "%v: falta o segmento %s", "%v: missing segment %s", serial.ErrCorrupt, segmentName(q.firstSegment+uint64(i)))
+		}
+	}
+	for i, id := range live {
+		if err := q.replay(id, i == len(live)-1); err != nil {
+			return err
+		}
+	}
+	if len(live) == 0 {
+		return q.createSegment(q.firstSegment)
+	}
+	return nil
+}
+
+// replay reaplica os registros de um segmento
+// No último segmento um registro cortado no fim é descartado (truncando o
+// arquivo), e o segmento fica aberto para as próximas gravações
+func (q *DurableQueue) replay(id uint64, last bool) error {
+	name := segmentName(id)
+	data, err := os.ReadFile(q.path(name))
+	if err != nil {
+		return err
+	}
+	
+	valid := walHeaderSize
+	switch {
+	case len(data) < walHeaderSize:
+		if !last {
+			return errs.New(serial.ErrCorrupt, "%v: %s sem cabeçalho (%d bytes)", "%v: %s has no header (%d bytes)", serial.ErrCorrupt, name, len(data))
+		}
+		valid = 0 // Queda logo depois de criar o segmento
+	case string(data[:len(walMagic)]) != walMagic:
+		return errs.New(serial.ErrCorrupt, "%v: %s não é um segmento de log (assinatura %q)", "%v: %s is not a log segment (signature %q)",
+			serial.ErrCorrupt, name, data[:len(walMagic)])
+	case data[len(walMagic)] != walVersion:
+		return errs.New(serial.ErrUnsupportedVersion, "%v: %s na versão %d, este programa lê a versão %d",
+			"%v: %s is version %d, this program reads version %d", serial.ErrUnsupportedVersion, name, data[len(walMagic)], walVersion)
+	}
+	
+	for valid > 0 && valid < len(data) {
+		op, value, n, err := decodeRecord(data[valid:])
+		if err != nil {
+			if last && isTorn(data[valid:], n, err) {
+				break
+			}
+			return errs.New(serial.ErrCorrupt, "%v: %s, registro no byte %d: %v", "%v: %s, record at byte %d: %v", serial.ErrCorrupt, name, valid, err)
+		}
+		if err := q.apply(op, value); err != nil {
+			return errs.New(serial.ErrCorrupt, "%v: %s, registro no byte %d: %v", "%v: %s, record at byte %d: %v", serial.ErrCorrupt, name, valid, err)
+		}
+		valid += n
+		q.logged++
+	}
+	if !last {
+		return nil
+	}
+	
+	file, err := os.OpenFile(q.path(name), os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	q.segment, q.segmentID, q.segmentSize = file, id, int64(valid)
+	if valid == len(data) && valid > 0 {
+		return nil
+	}
+	
+	// Descarta o registro cortado e grava o cabeçalho se ele também se perdeu
+	q.discarded = int64(len(data) - valid)
+	if err := file.Truncate(int64(valid)); err != nil {
+		return err
+	}
+	if valid == 0 {
+		if _, err := file.Write(walHeader()); err != nil {
+			return err
+		}
+		q.segmentSize = int64(walHeaderSize)
+	}
+	return file.Sync()
+}
+
+// ============================================================================
+// GRAVAÇÃO, ROTAÇÃO E COMPACTAÇÃO
+// ============================================================================
+
+// write grava um registro no log, fazendo antes a manutenção pendente
+// (trocar de segmento, compactar) e depois o fsync exigido pelo SyncMode
+func (q *DurableQueue) write(op byte, value int) error {
+	if q.err != nil {
+		return q.err
+	}
+	if q.config.CompactEvery > 0 && q.logged >= q.config.CompactEvery && q.logged >= q.memory.Size() {
+		if err := q.compact(); err != nil {
+			return q.fail(err)
+		}
+	} else if q.segmentSize >= q.config.SegmentSize {
+		if err := q.rotate(); err != nil {
+			return q.fail(err)
+		}
+	}
+	
+	q.record = appendRecord(q.record[:0], op, value)
+	if _, err := q.segment.Write(q.record); err != nil {
+		return q.fail(err)
+	}
+	q.segmentSize += int64(len(q.record))
+	q.logged++
+	q.pending++
+	
+	if q.config.Sync == SyncEveryOperation || q.pending >= q.config.BatchSize ||
+		q.config.BatchInterval > 0 && time.Since(q.lastSync) >= q.config.BatchInterval {
+		if err := q.sync(); err != nil {
+			return q.fail(err)
+		}
+	}
+	return nil
+}
+
+// fail guarda o primeiro erro de gravação; a fila para de aceitar alterações
+func (q *DurableQueue) fail(err error) error {
+	if q.err == nil {
+		q.err = errs.New(err, "fila durável %s: %v", "durable queue %s: %v", q.dir, err)
+	}
+	return q.err
+}
+
+// sync faz o fsync do segmento aberto, se houver registros pendentes
+func (q *DurableQueue) sync() error {
+	if q.pending == 0 {
+		return nil
+	}
+	if err := q.segment.Sync(); err != nil {
+		return err
+	}
+	q.pending = 0
+	q.lastSync = time.Now()
+	q.syncs++
+	return nil
+}
+
+// createSegment cria um segmento vazio (só o cabeçalho) e passa a gravar nele
+func (q *DurableQueue) createSegment(id uint64) error {
+	file, err := os.OpenFile(q.path(segmentName(id)), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(walHeader()); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := syncDir(q.dir); err != nil {
+		file.Close()
+		return err
+	}
+	q.segment, q.segmentID, q.segmentSize = file, id, int64(walHeaderSize)
+	return nil
+}
+
+// rotate fecha o segmento aberto (com fsync) e abre o próximo
+func (q *DurableQueue) rotate() error {
+	if err := q.sync(); err != nil {
+		return err
+	}
+	previous := q.segment
+	if err := q.createSegment(q.segmentID + 1); err != nil {
+		return err
+	}
+	return previous.Close()
+}
+
+// compact grava o snapshot do conteúdo atual e apaga o log que ele substitui
+func (q *DurableQueue) compact() error {
+	if err := q.rotate(); err != nil {
+		return err
+	}
+	data, err := serial.Marshal(serial.Queue, q.memory.ToSlice())
+	if err != nil {
+		return err
+	}
+	name := snapshotName(q.segmentID)
+	if err := writeFileSync(q.path(name+".tmp"), data); err != nil {
+		return err
+	}
+	if err := os.Rename(q.path(name+".tmp"), q.path(name)); err != nil {
+		return err
+	}
+	if err := syncDir(q.dir); err != nil {
+		return err
+	}
+	
+	// A partir daqui o snapshot vale: o que não for apagado agora é apagado
+	// na próxima abertura
+	previous := q.firstSegment
+	q.firstSegment = q.segmentID
+	q.logged = 0
+	q.compactions++
+	os.Remove(q.path(snapshotName(previous)))
+	for id := previous; id < q.firstSegment; id++ {
+		os.Remove(q.path(segmentName(id)))
+	}
+	return syncDir(q.dir)
+}
+
+// writeFileSync grava um arquivo e faz fsync antes de fechar
+func writeFileSync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// syncDir faz fsync do diretório, tornando duráveis criações, renomeações
+// e remoções de arquivos
+func syncDir(dir string) error {
+	file, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	return file.Sync()
+}
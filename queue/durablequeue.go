@@ -0,0 +1,298 @@
+package queue
+
+import (
+	"os"
+	"time"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// DURABLEQUEUE - FILA PERSISTENTE COM WRITE-AHEAD LOG
+// ============================================================================
+
+// SyncMode define quando os registros do log são forçados para o disco (fsync)
+type SyncMode int
+
+const (
+	SyncEveryOperation SyncMode = iota // fsync a cada operação: nada confirmado se perde
+	SyncBatch                          // fsync a cada BatchSize registros ou BatchInterval
+)
+
+// String retorna o nome do modo
+func (m SyncMode) String() string {
+	if m == SyncBatch {
+		return "lote"
+	}
+	return "cada operação"
+}
+
+// DurableConfig configura o log de uma DurableQueue; o valor zero é válido
+type DurableConfig struct {
+	Sync          SyncMode      // Padrão SyncEveryOperation
+	BatchSize     int           // SyncBatch: registros por fsync (padrão 64)
+	BatchInterval time.Duration // SyncBatch: tempo máximo sem fsync, conferido a cada operação (0 = sem limite)
+	SegmentSize   int64         // Bytes por segmento antes de abrir o próximo (padrão 1 MiB)
+	CompactEvery  int           // Registros no log que disparam um snapshot (padrão 10000; negativo desliga)
+}
+
+// Valores usados quando os campos de DurableConfig são zero
+const (
+	DefaultBatchSize    = 64
+	DefaultSegmentSize  = 1 << 20
+	DefaultCompactEvery = 10000
+)
+
+// withDefaults valida a configuração e preenche os campos opcionais
+func (c DurableConfig) withDefaults() (DurableConfig, error) {
+	if c.BatchSize == 0 {
+		c.BatchSize = DefaultBatchSize
+	}
+	if c.SegmentSize == 0 {
+		c.SegmentSize = DefaultSegmentSize
+	}
+	if c.CompactEvery == 0 {
+		c.CompactEvery = DefaultCompactEvery
+	}
+	
+	if c.Sync != SyncEveryOperation && c.Sync != SyncBatch {
+		return c, errs.New(nil, "modo de sincronização inválido: %d", "invalid sync mode: %d", c.Sync)
+	}
+	if c.BatchSize < 0 || c.BatchInterval < 0 {
+		return c, errs.New(nil, "lote inválido: %d registros, intervalo %v", "invalid batch: %d records, interval %v", c.BatchSize, c.BatchInterval)
+	}
+	if c.SegmentSize < 0 {
+		return c, errs.New(nil, "tamanho de segmento inválido: %d", "invalid segment size: %d", c.SegmentSize)
+	}
+	return c, nil
+}
+
+// DurableQueue é uma fila FIFO que sobrevive ao fim do processo
+// Características:
+// - O conteúdo fica em memória (um ArrayQueue): consultas custam o mesmo
+// - Cada Enqueue, Dequeue e Clear é gravado antes num write-ahead log em
+//   disco, dividido em segmentos de até SegmentSize bytes
+// - Ao abrir, o último snapshot é carregado e os segmentos seguintes são
+//   reaplicados; um registro cortado no fim (queda no meio da gravação) é
+//   descartado e o segmento é truncado
+// - De tempos em tempos o conteúdo vira um snapshot e os segmentos antigos
+//   são apagados, então o log não cresce sem limite
+// Como as demais filas (exceto BlockingQueue), não é segura para uso
+// concorrente, e só um processo pode usar o diretório por vez
+type DurableQueue struct {
+	dir    string
+	config DurableConfig
+	memory *ArrayQueue // Estado atual: snapshot + registros reaplicados
+	
+	segment      *os.File // Segmento aberto para escrita (sempre o último)
+	segmentID    uint64   // Número do segmento aberto
+	segmentSize  int64    // Bytes já gravados no segmento aberto
+	firstSegment uint64   // Primeiro segmento depois do snapshot
+	record       []byte   // Buffer reaproveitado para codificar registros
+	
+	logged   int       // Registros gravados desde o último snapshot
+	pending  int       // Registros gravados desde o último fsync
+	lastSync time.Time // Instante do último fsync
+	err      error     // Primeiro erro de gravação, ou ErrQueueClosed
+	
+	syncs       int   // fsyncs dos segmentos
+	compactions int   // Snapshots gravados
+	discarded   int64 // Bytes de registros cortados descartados na abertura
+}
+
+// OpenDurableQueue abre (ou cria) a fila guardada no diretório dir
+// Complexidade: O(n + r), onde n é o tamanho do snapshot e r o número de
+// registros no log depois dele
+func OpenDurableQueue(dir string, config DurableConfig) (*DurableQueue, error) {
+	config, err := config.withDefaults()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	
+	q := &DurableQueue{
+		dir:      dir,
+		config:   config,
+		memory:   NewArrayQueue(0),
+		lastSync: time.Now(),
+	}
+	if err := q.recover(); err != nil {
+		if q.segment != nil {
+			q.segment.Close()
+		}
+		return nil, errs.New(err, "fila durável %s: %v", "durable queue %s: %v", dir, err)
+	}
+	return q, nil
+}
+
+// SetCounters associa contadores de operações à fila em memória
+func (q *DurableQueue) SetCounters(counters *instrument.Counters) {
+	q.memory.SetCounters(counters)
+}
+
+// ============================================================================
+// IMPLEMENTAÇÃO DA INTERFACE QUEUE
+// ============================================================================
+
+// Enqueue adiciona um elemento no final da fila
+// A interface Queue não deixa Enqueue retornar erro: se a gravação falhar,
+// a fila não muda e o erro fica em Err. Use TryEnqueue para recebê-lo
+// Complexidade: O(1) amortizado, mais a gravação (e o fsync, se houver)
+func (q *DurableQueue) Enqueue(element int) {
+	q.TryEnqueue(element)
+}
+
+// TryEnqueue adiciona um elemento no final e retorna o erro de gravação
+// Quando retorna nil o elemento está no log (e no disco, conforme o SyncMode)
+func (q *DurableQueue) TryEnqueue(element int) error {
+	if err := q.write(recordEnqueue, element); err != nil {
+		return err
+	}
+	q.memory.Enqueue(element)
+	return nil
+}
+
+// Dequeue remove e retorna o elemento do início da fila
+// Retorna o erro de gravação, se houver; nesse caso o elemento continua na fila
+// Complexidade: O(1) amortizado, mais a gravação
+func (q *DurableQueue) Dequeue() (int, error) {
+	if q.memory.IsEmpty() {
+		return 0, errDequeueEmpty
+	}
+	if err := q.write(recordDequeue, 0); err != nil {
+		return 0, err
+	}
+	return q.memory.Dequeue()
+}
+
+// Front retorna o elemento do início sem remover
+// Complexidade: O(1)
+func (q *DurableQueue) Front() (int, error) {
+	return q.memory.Front()
+}
+
+// Rear retorna o elemento do final sem remover
+// Complexidade: O(1)
+func (q *DurableQueue) Rear() (int, error) {
+	return q.memory.Rear()
+}
+
+// Size retorna o número de elementos na fila
+// Complexidade: O(1)
+func (q *DurableQueue) Size() int {
+	return q.memory.Size()
+}
+
+// IsEmpty verifica se a fila está vazia
+// Complexidade: O(1)
+func (q *DurableQueue) IsEmpty() bool {
+	return q.memory.IsEmpty()
+}
+
+// IsFull sempre retorna false: a fila é limitada apenas pelo disco
+func (q *DurableQueue) IsFull() bool {
+	return false
+}
+
+// Clear remove todos os elementos da fila com um único registro no log
+// Se a gravação falhar, a fila não muda e o erro fica em Err
+// Complexidade: O(1) mais a gravação
+func (q *DurableQueue) Clear() {
+	if q.memory.IsEmpty() {
+		return
+	}
+	if q.write(recordClear, 0) == nil {
+		q.memory.Clear()
+	}
+}
+
+// ToSlice converte a fila para um slice (do início para o final)
+// Complexidade: O(n)
+func (q *DurableQueue) ToSlice() []int {
+	return q.memory.ToSlice()
+}
+
+// String retorna uma representação em string da fila
+// Complexidade: O(n)
+func (q *DurableQueue) String() string {
+	return q.memory.String()
+}
+
+// ============================================================================
+// DURABILIDADE
+// ============================================================================
+
+// Err retorna o primeiro erro de gravação (ErrQueueClosed depois de Close)
+// Depois de um erro a fila recusa alterações até ser reaberta: o log em
+// disco pode ter ficado com um registro pela metade, que a abertura descarta
+func (q *DurableQueue) Err() error {
+	return q.err
+}
+
+// Sync força para o disco os registros ainda não sincronizados
+// Útil no modo SyncBatch antes de confirmar algo para fora do processo
+func (q *DurableQueue) Sync() error {
+	if q.err != nil {
+		return q.err
+	}
+	if err := q.sync(); err != nil {
+		return q.fail(err)
+	}
+	return nil
+}
+
+// Compact grava o conteúdo atual como snapshot e apaga os segmentos antigos
+// Acontece sozinho a cada CompactEvery registros (e só quando o log já é
+// maior que a fila, para o custo ficar amortizado em O(1) por operação)
+// Complexidade: O(n)
+func (q *DurableQueue) Compact() error {
+	if q.err != nil {
+		return q.err
+	}
+	if err := q.compact(); err != nil {
+		return q.fail(err)
+	}
+	return nil
+}
+
+// Close sincroniza e fecha o log; depois disso as alterações retornam
+// ErrQueueClosed, mas o conteúdo em memória continua consultável
+// Chamar Close mais de uma vez não tem efeito
+func (q *DurableQueue) Close() error {
+	if q.segment == nil {
+		return nil
+	}
+	var err error
+	if q.err == nil {
+		err = q.sync()
+	}
+	if closeErr := q.segment.Close(); err == nil {
+		err = closeErr
+	}
+	q.segment = nil
+	q.err = ErrQueueClosed
+	return err
+}
+
+// Dir retorna o diretório onde a fila é guardada
+func (q *DurableQueue) Dir() string {
+	return q.dir
+}
+
+// GetStatistics retorna estatísticas da fila e do log
+func (q *DurableQueue) GetStatistics() map[string]interface{} {
+	return map[string]interface{}{
+		"size":           q.memory.Size(),
+		"syncMode":       q.config.Sync.String(),
+		"segments":       int(q.segmentID - q.firstSegment + 1),
+		"segmentBytes":   q.segmentSize,
+		"loggedRecords":  q.logged,
+		"pendingRecords": q.pending,
+		"syncs":          q.syncs,
+		"compactions":    q.compactions,
+		"discardedBytes": q.discarded,
+	}
+}
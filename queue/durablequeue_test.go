@@ -0,0 +1,273 @@
+package queue
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"dca3503/serial"
+)
+
+// openDurable abre a fila no diretório e falha o teste se não conseguir
+func openDurable(t *testing.T, dir string, config DurableConfig) *DurableQueue {
+	t.Helper()
+	q, err := OpenDurableQueue(dir, config)
+	if err != nil {
+		t.Fatalf("OpenDurableQueue: %v", err)
+	}
+	t.Cleanup(func() { q.Close() })
+	return q
+}
+
+// files lista os segmentos e snapshots do diretório
+func files(t *testing.T, dir string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	return names
+}
+
+func TestDurableQueueSurvivesReopen(t *testing.T) {
+	dir := t.TempDir()
+	q := openDurable(t, dir, DurableConfig{})
+	for i := 1; i <= 5; i++ {
+		q.Enqueue(i)
+	}
+	q.Dequeue()
+	q.Dequeue()
+	if syncs := q.GetStatistics()["syncs"]; syncs != 7 {
+		t.Errorf("SyncEveryOperation: %v fsyncs, esperado 7", syncs)
+	}
+	
+	// Sem Close: o processo "caiu" depois das gravações
+	reopened := openDurable(t, dir, DurableConfig{})
+	if got := reopened.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Fatalf("depois de reabrir: %v", got)
+	}
+	reopened.Clear()
+	reopened.Enqueue(-7)
+	reopened.Close()
+	
+	again := openDurable(t, dir, DurableConfig{})
+	if got := again.ToSlice(); !slices.Equal(got, []int{-7}) {
+		t.Errorf("Clear + Enqueue depois de reabrir: %v", got)
+	}
+}
+
+func TestDurableQueueTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	q := openDurable(t, dir, DurableConfig{})
+	mustEnqueue(t, q, 1, 2, 3)
+	q.Close()
+	
+	segment := filepath.Join(dir, segmentName(1))
+	tails := map[string][]byte{
+		"registro pela metade": appendRecord(nil, recordEnqueue, 1000)[:3],
+		"checksum errado":      corruptLast(appendRecord(nil, recordDequeue, 0)),
+		"zeros":                make([]byte, 9),
+	}
+	for name, tail := range tails {
+		original, _ := os.ReadFile(segment)
+		os.WriteFile(segment, append(slices.Clone(original), tail...), 0o644)
+		
+		recovered := openDurable(t, dir, DurableConfig{})
+		if got := recovered.ToSlice(); !slices.Equal(got, []int{1, 2, 3}) {
+			t.Errorf("%s: %v", name, got)
+		}
+		if discarded := recovered.GetStatistics()["discardedBytes"]; discarded != int64(len(tail)) {
+			t.Errorf("%s: %v bytes descartados, esperado %d", name, discarded, len(tail))
+		}
+		recovered.Close()
+		if truncated, _ := os.ReadFile(segment); len(truncated) != len(original) {
+			t.Errorf("%s: segmento com %d bytes, esperado %d", name, len(truncated), len(original))
+		}
+	}
+	
+	// Segmento criado mas sem o cabeçalho completo
+	os.WriteFile(filepath.Join(dir, segmentName(2)), []byte("DC"), 0o644)
+	recovered := openDurable(t, dir, DurableConfig{})
+	recovered.Enqueue(4)
+	recovered.Close()
+	if got := openDurable(t, dir, DurableConfig{}).ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Errorf("depois de recriar o cabeçalho: %v", got)
+	}
+}
+
+func TestDurableQueueRejectsCorruption(t *testing.T) {
+	build := func(t *testing.T) string {
+		dir := t.TempDir()
+		q := openDurable(t, dir, DurableConfig{SegmentSize: 20, CompactEvery: -1})
+		for i := range 12 {
+			q.Enqueue(i)
+		}
+		q.Close()
+		return dir
+	}
+	
+	t.Run("segmento do meio", func(t *testing.T) {
+		dir := build(t)
+		flipByte(t, filepath.Join(dir, segmentName(1)), walHeaderSize+1)
+		_, err := OpenDurableQueue(dir, DurableConfig{})
+		if !errors.Is(err, serial.ErrCorrupt) || !strings.Contains(err.Error(), segmentName(1)) {
+			t.Errorf("erro %v", err)
+		}
+	})
+	t.Run("registro seguido de outros", func(t *testing.T) {
+		dir := build(t)
+		last := files(t, dir)[len(files(t, dir))-1]
+		q := openDurable(t, dir, DurableConfig{SegmentSize: 1 << 10})
+		q.Enqueue(100)
+		q.Enqueue(200)
+		q.Close()
+		flipByte(t, filepath.Join(dir, last), walHeaderSize+1)
+		if _, err := OpenDurableQueue(dir, DurableConfig{}); !errors.Is(err, serial.ErrCorrupt) {
+			t.Errorf("erro %v", err)
+		}
+	})
+	t.Run("segmento faltando", func(t *testing.T) {
+		dir := build(t)
+		os.Remove(filepath.Join(dir, segmentName(2)))
+		_, err := OpenDurableQueue(dir, DurableConfig{})
+		if !errors.Is(err, serial.ErrCorrupt) || !strings.Contains(err.Error(), "falta o segmento "+segmentName(2)) {
+			t.Errorf("erro %v", err)
+		}
+	})
+	t.Run("versão nova", func(t *testing.T) {
+		dir := build(t)
+		path := filepath.Join(dir, segmentName(1))
+		data, _ := os.ReadFile(path)
+		data[len(walMagic)] = walVersion + 1
+		os.WriteFile(path, data, 0o644)
+		if _, err := OpenDurableQueue(dir, DurableConfig{}); !errors.Is(err, serial.ErrUnsupportedVersion) {
+			t.Errorf("erro %v", err)
+		}
+	})
+}
+
+func TestDurableQueueCompaction(t *testing.T) {
+	dir := t.TempDir()
+	config := DurableConfig{Sync: SyncBatch, SegmentSize: 64, CompactEvery: 50}
+	q := openDurable(t, dir, config)
+	var model []int
+	for i := range 1000 {
+		if i%3 == 2 {
+			q.Dequeue()
+			model = model[1:]
+		} else {
+			q.Enqueue(i)
+			model = append(model, i)
+		}
+	}
+	stats := q.GetStatistics()
+	if stats["compactions"].(int) < 5 {
+		t.Errorf("poucas compactações: %v", stats)
+	}
+	
+	var snapshots, segments int
+	for _, name := range files(t, dir) {
+		switch {
+		case strings.HasPrefix(name, "snapshot-"):
+			snapshots++
+		case strings.HasPrefix(name, "wal-"):
+			segments++
+		}
+	}
+	if snapshots != 1 || segments != stats["segments"] {
+		t.Errorf("%d snapshots e %d segmentos no diretório: %v", snapshots, segments, files(t, dir))
+	}
+	q.Close()
+	
+	// Sobras de uma compactação interrompida são ignoradas e apagadas
+	os.WriteFile(filepath.Join(dir, segmentName(1)), []byte("lixo"), 0o644)
+	os.WriteFile(filepath.Join(dir, snapshotName(1)), []byte("lixo"), 0o644)
+	os.WriteFile(filepath.Join(dir, snapshotName(99999)+".tmp"), []byte("lixo"), 0o644)
+	reopened := openDurable(t, dir, config)
+	if got := reopened.ToSlice(); !slices.Equal(got, model) {
+		t.Fatalf("depois de reabrir: %d elementos, esperado %d", len(got), len(model))
+	}
+	for _, name := range files(t, dir) {
+		if name == segmentName(1) || name == snapshotName(1) || strings.HasSuffix(name, ".tmp") {
+			t.Errorf("%s deveria ter sido apagado", name)
+		}
+	}
+	
+	if err := reopened.Compact(); err != nil {
+		t.Fatal(err)
+	}
+	if logged := reopened.GetStatistics()["loggedRecords"]; logged != 0 {
+		t.Errorf("%v registros no log depois de Compact", logged)
+	}
+}
+
+func TestDurableQueueBatchSyncAndClose(t *testing.T) {
+	q := openDurable(t, t.TempDir(), DurableConfig{Sync: SyncBatch, BatchSize: 10})
+	for i := range 25 {
+		q.Enqueue(i)
+	}
+	stats := q.GetStatistics()
+	if stats["syncs"] != 2 || stats["pendingRecords"] != 5 {
+		t.Errorf("lote de 10: %v", stats)
+	}
+	if err := q.Sync(); err != nil || q.GetStatistics()["syncs"] != 3 {
+		t.Errorf("Sync: %v, %v", err, q.GetStatistics())
+	}
+	
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+	q.Enqueue(99)
+	if !errors.Is(q.Err(), ErrQueueClosed) || q.Size() != 25 {
+		t.Errorf("Enqueue depois de Close: Err() = %v, Size() = %d", q.Err(), q.Size())
+	}
+	if _, err := q.Dequeue(); !errors.Is(err, ErrQueueClosed) {
+		t.Errorf("Dequeue depois de Close: %v", err)
+	}
+	if front, _ := q.Front(); front != 0 {
+		t.Errorf("o conteúdo deveria continuar consultável: Front() = %d", front)
+	}
+	if err := q.Close(); err != nil {
+		t.Errorf("segundo Close: %v", err)
+	}
+	
+	if _, err := OpenDurableQueue(t.TempDir(), DurableConfig{Sync: 7}); err == nil {
+		t.Error("modo de sincronização inválido deveria ser rejeitado")
+	}
+}
+
+// mustEnqueue enfileira os valores falhando o teste se algum der erro
+func mustEnqueue(t *testing.T, q *DurableQueue, values ...int) {
+	t.Helper()
+	for _, value := range values {
+		if err := q.TryEnqueue(value); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// corruptLast troca um bit do checksum de um registro
+func corruptLast(record []byte) []byte {
+	record[len(record)-1] ^= 1
+	return record
+}
+
+// flipByte troca um bit do byte na posição dada do arquivo
+func flipByte(t *testing.T, path string, offset int) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[offset] ^= 0x10
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
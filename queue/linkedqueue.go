@@ -1,9 +1,13 @@
-package main
+package queue
 
 import (
 	"fmt"
-	"errors"
+	"iter"
 	"strings"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/links"
 )
 
 // ============================================================================
@@ -23,9 +27,10 @@ type QueueNode struct {
 // - Uso dinâmico de memória (aloca conforme necessário)
 // - Mantém ponteiros para frente e final para eficiência
 type LinkedQueue struct {
-	front *QueueNode // Ponteiro para o primeiro nó
-	rear  *QueueNode // Ponteiro para o último nó
-	size  int        // Contador de elementos
+	front    *QueueNode           // Ponteiro para o primeiro nó
+	rear     *QueueNode           // Ponteiro para o último nó
+	size     int                  // Contador de elementos
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewLinkedQueue cria uma nova instância de LinkedQueue
@@ -37,6 +42,12 @@ func NewLinkedQueue() *LinkedQueue {
 	}
 }
 
+// SetCounters associa contadores de operações à fila (nil desliga a contagem)
+// Conta alocações de nós, comparações de busca e saltos de ponteiro
+func (q *LinkedQueue) SetCounters(counters *instrument.Counters) {
+	q.counters = counters
+}
+
 // ============================================================================
 // IMPLEMENTAÇÃO DA INTERFACE QUEUE
 // ============================================================================
@@ -44,10 +55,12 @@ func NewLinkedQueue() *LinkedQueue {
 // Enqueue adiciona um elemento no final da fila
 // Complexidade: O(1)
 func (q *LinkedQueue) Enqueue(element int) {
+	defer checkInvariants(q)
 	newNode := &QueueNode{
 		data: element,
 		next: nil,
 	}
+	q.counters.AddAllocations(1)
 	
 	if q.IsEmpty() {
 		// Primeiro elemento
@@ -65,8 +78,9 @@ func (q *LinkedQueue) Enqueue(element int) {
 // Dequeue remove e retorna o elemento do início da fila
 // Complexidade: O(1)
 func (q *LinkedQueue) Dequeue() (int, error) {
+	defer checkInvariants(q)
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não é possível fazer dequeue")
+		return 0, errDequeueEmpty
 	}
 	
 	value := q.front.data
@@ -85,7 +99,7 @@ func (q *LinkedQueue) Dequeue() (int, error) {
 // Complexidade: O(1)
 func (q *LinkedQueue) Front() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento na frente")
+		return 0, errFrontEmpty
 	}
 	return q.front.data, nil
 }
@@ -94,7 +108,7 @@ func (q *LinkedQueue) Front() (int, error) {
 // Complexidade: O(1)
 func (q *LinkedQueue) Rear() (int, error) {
 	if q.IsEmpty() {
-		return 0, errors.New("fila vazia: não há elemento no final")
+		return 0, errRearEmpty
 	}
 	return q.rear.data, nil
 }
@@ -121,6 +135,7 @@ func (q *LinkedQueue) IsFull() bool {
 // Clear remove todos os elementos da fila
 // Complexidade: O(1) - apenas redefine ponteiros, GC limpa os nós
 func (q *LinkedQueue) Clear() {
+	defer checkInvariants(q)
 	q.front = nil
 	q.rear = nil
 	q.size = 0
@@ -147,6 +162,16 @@ func (q *LinkedQueue) ToSlice() []int {
 	return result
 }
 
+// Links fotografa os nós como estão na memória, a partir do front e do
+// rear, com os ponteiros next reais (ver links.Snapshot)
+// Complexidade: O(n)
+func (q *LinkedQueue) Links() links.Snapshot[int] {
+	return links.Walk([]*QueueNode{q.front, q.rear},
+		func(node *QueueNode) int { return node.data },
+		func(node *QueueNode) *QueueNode { return node.next },
+		nil)
+}
+
 // String retorna uma representação em string da fila
 // Complexidade: O(n)
 func (q *LinkedQueue) String() string {
@@ -180,6 +205,7 @@ func (q *LinkedQueue) String() string {
 // EnqueueAll adiciona múltiplos elementos de uma vez
 // Complexidade: O(n) onde n é o número de elementos
 func (q *LinkedQueue) EnqueueAll(elements []int) {
+	defer checkInvariants(q)
 	for _, element := range elements {
 		q.Enqueue(element)
 	}
@@ -188,12 +214,13 @@ func (q *LinkedQueue) EnqueueAll(elements []int) {
 // DequeueMultiple remove e retorna múltiplos elementos do início
 // Complexidade: O(n) onde n é o número de elementos a remover
 func (q *LinkedQueue) DequeueMultiple(count int) ([]int, error) {
+	defer checkInvariants(q)
 	if count <= 0 {
 		return []int{}, nil
 	}
 	
 	if count > q.size {
-		return nil, fmt.Errorf("não há elementos suficientes: solicitado %d, disponível %d", count, q.size)
+		return nil, errs.NotEnough(count, q.size)
 	}
 	
 	result := make([]int, count)
@@ -210,10 +237,12 @@ func (q *LinkedQueue) DequeueMultiple(count int) ([]int, error) {
 func (q *LinkedQueue) Contains(element int) bool {
 	current := q.front
 	for current != nil {
+		q.counters.AddComparisons(1)
 		if current.data == element {
 			return true
 		}
 		current = current.next
+		q.counters.AddTraversals(1)
 	}
 	return false
 }
@@ -226,10 +255,12 @@ func (q *LinkedQueue) IndexOf(element int) int {
 	index := 0
 	
 	for current != nil {
+		q.counters.AddComparisons(1)
 		if current.data == element {
 			return index
 		}
 		current = current.next
+		q.counters.AddTraversals(1)
 		index++
 	}
 	
@@ -278,6 +309,7 @@ func (q *LinkedQueue) Equals(other *LinkedQueue) bool {
 // Reverse inverte a ordem dos elementos na fila
 // Complexidade: O(n)
 func (q *LinkedQueue) Reverse() {
+	defer checkInvariants(q)
 	if q.size <= 1 {
 		return
 	}
@@ -467,6 +499,7 @@ func (q *LinkedQueue) DropWhile(predicate func(int) bool) *LinkedQueue {
 
 // Rotate rotaciona a fila k posições para a esquerda
 func (q *LinkedQueue) Rotate(k int) {
+	defer checkInvariants(q)
 	if q.IsEmpty() || k <= 0 {
 		return
 	}
@@ -533,13 +566,43 @@ func (q *LinkedQueue) Merge(other *LinkedQueue) *LinkedQueue {
 // GetNth retorna o n-ésimo elemento (0-indexado) sem removê-lo
 func (q *LinkedQueue) GetNth(n int) (int, error) {
 	if n < 0 || n >= q.size {
-		return 0, fmt.Errorf("índice fora dos limites: %d", n)
+		return 0, errs.Index(n, q.size)
 	}
 	
 	current := q.front
 	for i := 0; i < n; i++ {
 		current = current.next
 	}
+	q.counters.AddTraversals(n)
 	
 	return current.data, nil
-}
\ No newline at end of file
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) do início para o final
+// Não há Backward, pois os nós só apontam para o próximo
+func (q *LinkedQueue) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		index := 0
+		for current := q.front; current != nil; current = current.next {
+			if !yield(index, current.data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do início para o final
+func (q *LinkedQueue) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for current := q.front; current != nil; current = current.next {
+			if !yield(current.data) {
+				return
+			}
+		}
+	}
+}
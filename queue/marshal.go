@@ -0,0 +1,258 @@
+package queue
+
+import (
+	"fmt"
+
+	"dca3503/errs"
+	"dca3503/serial"
+)
+
+// ============================================================================
+// SERIALIZAÇÃO (JSON, BINÁRIO E GOB)
+// ============================================================================
+
+// As filas são gravadas do início para o final (a ordem de ToSlice, em que
+// os elementos sairiam), em JSON como um array e em binário no formato do
+// pacote serial. Todas as filas leem os dados umas das outras. Unmarshal
+// substitui todo o conteúdo e funciona também com o valor zero
+// (var q ArrayQueue); a capacidade não é gravada. A ArrayQueue mantém a
+// política de capacidade e rejeita com errs.ErrFull dados que passem de
+// MaxCapacity; BlockingQueue e RingBuffer fazem o mesmo com a capacidade fixa
+// Complexidade: Θ(n) em todas as operações, exceto onde indicado
+
+// MarshalJSON implementa json.Marshaler
+func (q *ArrayQueue) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(q.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (q *ArrayQueue) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("ArrayQueue: %w", err)
+	}
+	return q.load(values)
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (q *ArrayQueue) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Queue, q.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (q *ArrayQueue) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Queue, data)
+	if err != nil {
+		return fmt.Errorf("ArrayQueue: %w", err)
+	}
+	return q.load(values)
+}
+
+// load troca o conteúdo pelos valores, reaproveitando o array se couber
+// Os elementos voltam a começar no slot 0
+func (q *ArrayQueue) load(values []int) error {
+	defer checkInvariants(q)
+	if err := q.policy.Validate(len(values)); err != nil {
+		return fmt.Errorf("ArrayQueue: %w", err)
+	}
+	if len(values) > q.capacity || q.capacity <= 0 {
+		q.capacity = q.policy.Clamp(max(len(values), 10)) // Capacidade padrão do construtor
+		q.data = make([]int, q.capacity)
+	}
+	copy(q.data, values)
+	q.front = 0
+	q.size = len(values)
+	q.rear = q.size % q.capacity
+	q.counters.AddMoves(len(values))
+	return nil
+}
+
+// MarshalJSON implementa json.Marshaler
+func (q *LinkedQueue) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(q.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (q *LinkedQueue) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("LinkedQueue: %w", err)
+	}
+	q.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (q *LinkedQueue) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Queue, q.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (q *LinkedQueue) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Queue, data)
+	if err != nil {
+		return fmt.Errorf("LinkedQueue: %w", err)
+	}
+	q.load(values)
+	return nil
+}
+
+// load troca o conteúdo por uma nova cadeia de nós com os valores
+func (q *LinkedQueue) load(values []int) {
+	defer checkInvariants(q)
+	q.front, q.rear = nil, nil
+	for _, value := range values {
+		node := &QueueNode{data: value}
+		if q.rear == nil {
+			q.front = node
+		} else {
+			q.rear.next = node
+		}
+		q.rear = node
+	}
+	q.size = len(values)
+	q.counters.AddAllocations(len(values))
+}
+
+// MarshalJSON implementa json.Marshaler
+// Os elementos saem em ordem de prioridade; o comparador não é gravado
+// Complexidade: O(n log n), como ToSlice
+func (pq *PriorityQueue) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(pq.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (pq *PriorityQueue) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("PriorityQueue: %w", err)
+	}
+	pq.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+// Complexidade: O(n log n), como ToSlice
+func (pq *PriorityQueue) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Queue, pq.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (pq *PriorityQueue) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Queue, data)
+	if err != nil {
+		return fmt.Errorf("PriorityQueue: %w", err)
+	}
+	pq.load(values)
+	return nil
+}
+
+// load reconstrói o heap com os valores usando o comparador atual
+// Como o comparador não é gravado, o valor zero passa a usar MinComparator
+// e dados de uma fila comum (ou de outro comparador) são reorganizados
+func (pq *PriorityQueue) load(values []int) {
+	if pq.compare == nil {
+		pq.compare = MinComparator
+	}
+	pq.Heapify(values)
+}
+
+// MarshalJSON implementa json.Marshaler
+func (q *BlockingQueue) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(q.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (q *BlockingQueue) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("BlockingQueue: %w", err)
+	}
+	return q.load(values)
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (q *BlockingQueue) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Queue, q.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (q *BlockingQueue) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Queue, data)
+	if err != nil {
+		return fmt.Errorf("BlockingQueue: %w", err)
+	}
+	return q.load(values)
+}
+
+// load troca o conteúdo pelos valores de forma atômica e acorda quem espera
+// A capacidade máxima é mantida: dados que não cabem são rejeitados sem
+// alterar a fila. No valor zero a capacidade passa a ser a padrão (10) ou
+// o número de elementos, se for maior
+func (q *BlockingQueue) load(values []int) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	
+	if q.buffer == nil {
+		q.capacity = max(len(values), 10)
+		q.buffer = NewArrayQueue(q.capacity)
+		q.notFull = make(chan struct{})
+		q.notEmpty = make(chan struct{})
+	}
+	if len(values) > q.capacity {
+		return errs.New(errs.ErrFull, "BlockingQueue: %d elementos não cabem na capacidade %d",
+			"BlockingQueue: %d elements do not fit in capacity %d", len(values), q.capacity)
+	}
+	q.buffer.load(values)
+	q.signal(&q.notEmpty)
+	q.signal(&q.notFull)
+	return nil
+}
+
+// MarshalJSON implementa json.Marshaler
+func (r *RingBuffer) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(r.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (r *RingBuffer) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("RingBuffer: %w", err)
+	}
+	return r.load(values)
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (r *RingBuffer) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Queue, r.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (r *RingBuffer) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Queue, data)
+	if err != nil {
+		return fmt.Errorf("RingBuffer: %w", err)
+	}
+	return r.load(values)
+}
+
+// load troca o conteúdo pelos valores e recalcula os agregados
+// Como na BlockingQueue, a capacidade é mantida e dados que não cabem são
+// rejeitados sem alterar o buffer (em vez de descartar os mais antigos). No
+// valor zero a capacidade passa a ser a padrão (10) ou o número de
+// elementos, se for maior
+func (r *RingBuffer) load(values []int) error {
+	if r.buffer == nil {
+		*r = *NewRingBuffer(max(len(values), 10))
+	}
+	if len(values) > r.buffer.capacity {
+		return errs.New(errs.ErrFull, "RingBuffer: %d elementos não cabem na capacidade %d",
+			"RingBuffer: %d elements do not fit in capacity %d", len(values), r.buffer.capacity)
+	}
+	r.Clear()
+	for _, value := range values {
+		r.Push(value)
+	}
+	return nil
+}
@@ -0,0 +1,443 @@
+package queue
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// PRIORITYQUEUE - FILA DE PRIORIDADE BASEADA EM HEAP BINÁRIO
+// ============================================================================
+
+// Comparator define a ordem de prioridade entre dois elementos
+// Retorna true se a deve sair da fila antes de b
+type Comparator func(a, b int) bool
+
+// MinComparator dá prioridade aos menores valores (min-heap)
+func MinComparator(a, b int) bool {
+	return a < b
+}
+
+// MaxComparator dá prioridade aos maiores valores (max-heap)
+func MaxComparator(a, b int) bool {
+	return a > b
+}
+
+// PriorityQueue implementa uma fila de prioridade usando um heap binário
+// armazenado em array
+// Características:
+// - Enqueue/Dequeue são O(log n)
+// - Front (elemento de maior prioridade) é O(1)
+// - O nó i tem filhos em 2i+1 e 2i+2 e pai em (i-1)/2
+// - A ordem de saída é definida pelo Comparator, não pela ordem de chegada
+type PriorityQueue struct {
+	data     []int                // Array interno que armazena o heap
+	compare  Comparator           // Define quem tem prioridade
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
+}
+
+// ErrNotFound é retornado por Update e DecreaseKey quando oldValue não está na fila
+var ErrNotFound = errs.Define("elemento não encontrado", "element not found")
+
+// NewPriorityQueue cria uma fila de prioridade com comparador personalizado
+func NewPriorityQueue(initialCapacity int, compare Comparator) *PriorityQueue {
+	if initialCapacity <= 0 {
+		initialCapacity = 10 // Capacidade padrão
+	}
+	if compare == nil {
+		compare = MinComparator
+	}
+	return &PriorityQueue{
+		data:    make([]int, 0, initialCapacity),
+		compare: compare,
+	}
+}
+
+// SetCounters associa contadores de operações à fila (nil desliga a contagem)
+// Conta chamadas ao comparador, escritas no heap (trocas contam 2) e
+// crescimentos do slice interno
+func (pq *PriorityQueue) SetCounters(counters *instrument.Counters) {
+	pq.counters = counters
+}
+
+// NewMinPriorityQueue cria uma fila onde o menor elemento sai primeiro
+func NewMinPriorityQueue(initialCapacity int) *PriorityQueue {
+	return NewPriorityQueue(initialCapacity, MinComparator)
+}
+
+// NewMaxPriorityQueue cria uma fila onde o maior elemento sai primeiro
+func NewMaxPriorityQueue(initialCapacity int) *PriorityQueue {
+	return NewPriorityQueue(initialCapacity, MaxComparator)
+}
+
+// ============================================================================
+// IMPLEMENTAÇÃO DA INTERFACE QUEUE
+// ============================================================================
+
+// Enqueue adiciona um elemento respeitando a prioridade
+// Complexidade: O(log n) - O(n) se o slice precisar crescer
+func (pq *PriorityQueue) Enqueue(element int) {
+	defer checkInvariants(pq)
+	if len(pq.data) == cap(pq.data) {
+		pq.counters.Resize(len(pq.data)) // append vai alocar e copiar
+	}
+	pq.data = append(pq.data, element)
+	pq.counters.AddMoves(1)
+	pq.siftUp(len(pq.data) - 1)
+}
+
+// Dequeue remove e retorna o elemento de maior prioridade
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Guardar a raiz
+// 2. Mover o último elemento para a raiz
+// 3. Descer a nova raiz até restaurar a propriedade de heap
+func (pq *PriorityQueue) Dequeue() (int, error) {
+	defer checkInvariants(pq)
+	if pq.IsEmpty() {
+		return 0, errDequeueEmpty
+	}
+	
+	root := pq.data[0]
+	last := len(pq.data) - 1
+	pq.data[0] = pq.data[last]
+	pq.data = pq.data[:last]
+	pq.counters.AddMoves(1)
+	
+	if len(pq.data) > 0 {
+		pq.siftDown(0)
+	}
+	
+	return root, nil
+}
+
+// Front retorna o elemento de maior prioridade sem removê-lo
+// Complexidade: O(1)
+func (pq *PriorityQueue) Front() (int, error) {
+	if pq.IsEmpty() {
+		return 0, errFrontEmpty
+	}
+	return pq.data[0], nil
+}
+
+// Rear retorna o elemento de menor prioridade (o último a sair)
+// Complexidade: O(n) - o menos prioritário está em uma das folhas
+func (pq *PriorityQueue) Rear() (int, error) {
+	if pq.IsEmpty() {
+		return 0, errRearEmpty
+	}
+	
+	// As folhas ocupam as posições n/2 até n-1
+	rear := pq.data[len(pq.data)/2]
+	for i := len(pq.data)/2 + 1; i < len(pq.data); i++ {
+		if pq.higher(rear, pq.data[i]) {
+			rear = pq.data[i]
+		}
+	}
+	return rear, nil
+}
+
+// Size retorna o número de elementos na fila
+// Complexidade: O(1)
+func (pq *PriorityQueue) Size() int {
+	return len(pq.data)
+}
+
+// IsEmpty verifica se a fila está vazia
+// Complexidade: O(1)
+func (pq *PriorityQueue) IsEmpty() bool {
+	return len(pq.data) == 0
+}
+
+// IsFull verifica se a fila está cheia
+// O heap cresce automaticamente, então nunca está "cheio"
+// Complexidade: O(1)
+func (pq *PriorityQueue) IsFull() bool {
+	return false
+}
+
+// Clear remove todos os elementos da fila
+// Complexidade: O(1)
+func (pq *PriorityQueue) Clear() {
+	defer checkInvariants(pq)
+	pq.data = pq.data[:0]
+}
+
+// ToSlice retorna os elementos na ordem em que sairiam da fila
+// Complexidade: O(n log n) - ordena uma cópia do heap
+func (pq *PriorityQueue) ToSlice() []int {
+	clone := pq.Clone()
+	result := make([]int, 0, len(pq.data))
+	
+	for !clone.IsEmpty() {
+		value, _ := clone.Dequeue()
+		result = append(result, value)
+	}
+	
+	return result
+}
+
+// String retorna uma representação em string da fila (em ordem de prioridade)
+// Complexidade: O(n log n)
+func (pq *PriorityQueue) String() string {
+	if pq.IsEmpty() {
+		return "[vazia]"
+	}
+	
+	var builder strings.Builder
+	builder.WriteString("frente → [")
+	
+	for i, value := range pq.ToSlice() {
+		if i > 0 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprintf("%d", value))
+	}
+	
+	builder.WriteString("] ← final")
+	return builder.String()
+}
+
+// ============================================================================
+// OPERAÇÕES DO HEAP
+// ============================================================================
+
+// siftUp sobe o elemento da posição index enquanto tiver mais prioridade que o pai
+// Complexidade: O(log n)
+func (pq *PriorityQueue) siftUp(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !pq.higher(pq.data[index], pq.data[parent]) {
+			break
+		}
+		pq.data[index], pq.data[parent] = pq.data[parent], pq.data[index]
+		pq.counters.AddMoves(2)
+		index = parent
+	}
+}
+
+// siftDown desce o elemento da posição index até nenhum filho ter mais prioridade
+// Complexidade: O(log n)
+func (pq *PriorityQueue) siftDown(index int) {
+	size := len(pq.data)
+	for {
+		best := index
+		left := 2*index + 1
+		right := 2*index + 2
+		
+		if left < size && pq.higher(pq.data[left], pq.data[best]) {
+			best = left
+		}
+		if right < size && pq.higher(pq.data[right], pq.data[best]) {
+			best = right
+		}
+		if best == index {
+			return
+		}
+		
+		pq.data[index], pq.data[best] = pq.data[best], pq.data[index]
+		pq.counters.AddMoves(2)
+		index = best
+	}
+}
+
+// higher compara dois elementos com o comparador da fila, contabilizando a comparação
+func (pq *PriorityQueue) higher(a, b int) bool {
+	pq.counters.AddComparisons(1)
+	return pq.compare(a, b)
+}
+
+// buildHeap reorganiza o array inteiro em um heap (construção bottom-up)
+// Complexidade: O(n) - a maioria dos nós está perto das folhas e desce pouco
+func (pq *PriorityQueue) buildHeap() {
+	for i := len(pq.data)/2 - 1; i >= 0; i-- {
+		pq.siftDown(i)
+	}
+}
+
+// Heapify substitui o conteúdo da fila pelos elementos do slice
+// O slice é copiado, então pode ser reutilizado pelo chamador
+// Complexidade: O(n)
+func (pq *PriorityQueue) Heapify(elements []int) {
+	defer checkInvariants(pq)
+	pq.data = make([]int, len(elements))
+	copy(pq.data, elements)
+	pq.buildHeap()
+}
+
+// Update troca a primeira ocorrência de oldValue por newValue
+// e reposiciona o elemento para cima ou para baixo conforme a nova prioridade
+// Complexidade: O(n) para localizar + O(log n) para reposicionar
+func (pq *PriorityQueue) Update(oldValue, newValue int) error {
+	defer checkInvariants(pq)
+	index := pq.indexOf(oldValue)
+	if index == -1 {
+		return fmt.Errorf("%w: %d", ErrNotFound, oldValue)
+	}
+	
+	pq.data[index] = newValue
+	if pq.higher(newValue, oldValue) {
+		pq.siftUp(index)
+	} else {
+		pq.siftDown(index)
+	}
+	return nil
+}
+
+// DecreaseKey aumenta a prioridade de um elemento
+// O nome segue a convenção do min-heap: o novo valor precisa ter prioridade
+// maior ou igual à do valor antigo, caso contrário retorna erro
+// Complexidade: O(n) para localizar + O(log n) para subir
+func (pq *PriorityQueue) DecreaseKey(oldValue, newValue int) error {
+	defer checkInvariants(pq)
+	if pq.higher(oldValue, newValue) {
+		return errs.New(nil, "nova chave %d tem prioridade menor que %d", "new key %d has lower priority than %d", newValue, oldValue)
+	}
+	
+	index := pq.indexOf(oldValue)
+	if index == -1 {
+		return fmt.Errorf("%w: %d", ErrNotFound, oldValue)
+	}
+	
+	pq.data[index] = newValue
+	pq.siftUp(index)
+	return nil
+}
+
+// Merge move todos os elementos de other para esta fila
+// A ordem de prioridade usada é a desta fila; other fica vazia
+// Juntar a fila com ela mesma não tem efeito
+// Complexidade: O(n + m) - concatena e reconstrói o heap
+func (pq *PriorityQueue) Merge(other *PriorityQueue) {
+	defer checkInvariants(pq)
+	if other == pq {
+		return
+	}
+	pq.data = append(pq.data, other.data...)
+	other.Clear()
+	pq.buildHeap()
+}
+
+// indexOf procura a posição de um elemento no array do heap
+// Complexidade: O(n)
+func (pq *PriorityQueue) indexOf(element int) int {
+	for i, value := range pq.data {
+		pq.counters.AddComparisons(1)
+		if value == element {
+			return i
+		}
+	}
+	return -1
+}
+
+// ============================================================================
+// MÉTODOS AUXILIARES ESPECÍFICOS DO PRIORITYQUEUE
+// ============================================================================
+
+// Capacity retorna a capacidade atual do array interno
+func (pq *PriorityQueue) Capacity() int {
+	return cap(pq.data)
+}
+
+// EnqueueAll adiciona múltiplos elementos de uma vez
+// Complexidade: O(n + m) - reconstrói o heap em vez de m inserções
+func (pq *PriorityQueue) EnqueueAll(elements []int) {
+	defer checkInvariants(pq)
+	pq.data = append(pq.data, elements...)
+	pq.buildHeap()
+}
+
+// Contains verifica se a fila contém um elemento específico
+// Complexidade: O(n)
+func (pq *PriorityQueue) Contains(element int) bool {
+	return pq.indexOf(element) != -1
+}
+
+// Clone cria uma cópia independente da fila com o mesmo comparador
+// Complexidade: O(n)
+func (pq *PriorityQueue) Clone() *PriorityQueue {
+	newQueue := NewPriorityQueue(cap(pq.data), pq.compare)
+	newQueue.data = append(newQueue.data, pq.data...)
+	return newQueue
+}
+
+// GetStatistics retorna estatísticas da fila
+func (pq *PriorityQueue) GetStatistics() map[string]interface{} {
+	if pq.IsEmpty() {
+		return map[string]interface{}{
+			"size":     0,
+			"capacity": cap(pq.data),
+			"isEmpty":  true,
+		}
+	}
+	
+	sum := 0
+	for _, value := range pq.data {
+		sum += value
+	}
+	front, _ := pq.Front()
+	rear, _ := pq.Rear()
+	
+	// Altura do heap: floor(log2(n))
+	height := 0
+	for n := len(pq.data); n > 1; n /= 2 {
+		height++
+	}
+	
+	return map[string]interface{}{
+		"size":     len(pq.data),
+		"capacity": cap(pq.data),
+		"isEmpty":  false,
+		"sum":      sum,
+		"average":  float64(sum) / float64(len(pq.data)),
+		"front":    front,
+		"rear":     rear,
+		"height":   height,
+	}
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) em ordem de prioridade
+// A ordem de prioridade exige uma cópia do heap: O(n) de memória extra e
+// O(log n) por elemento percorrido; parar cedo evita o custo restante
+func (pq *PriorityQueue) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		clone := pq.Clone()
+		for index := 0; !clone.IsEmpty(); index++ {
+			value, _ := clone.Dequeue()
+			if !yield(index, value) {
+				return
+			}
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos em ordem de prioridade
+func (pq *PriorityQueue) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, value := range pq.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// HeapOrder retorna um iterador sobre o array interno do heap, sem cópia
+// A ordem é a do array (nível a nível), não a ordem de saída
+func (pq *PriorityQueue) HeapOrder() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i, value := range pq.data {
+			if !yield(i, value) {
+				return
+			}
+		}
+	}
+}
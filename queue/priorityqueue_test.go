@@ -0,0 +1,179 @@
+package queue_test
+
+import (
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/queue"
+)
+
+// ordered retorna o modelo na ordem de saída da fila com o comparador
+func ordered(model []int, compare queue.Comparator) []int {
+	result := slices.Clone(model)
+	slices.SortStableFunc(result, func(a, b int) int {
+		switch {
+		case compare(a, b):
+			return -1
+		case compare(b, a):
+			return 1
+		}
+		return 0
+	})
+	return result
+}
+
+// replaceFirst troca uma ocorrência de oldValue por newValue no modelo
+func replaceFirst(model []int, oldValue, newValue int) bool {
+	if i := slices.Index(model, oldValue); i >= 0 {
+		model[i] = newValue
+		return true
+	}
+	return false
+}
+
+func TestPriorityQueueMatchesSortedReference(t *testing.T) {
+	comparators := []struct {
+		name    string
+		compare queue.Comparator
+		newPQ   func() *queue.PriorityQueue
+	}{
+		{"min", queue.MinComparator, func() *queue.PriorityQueue { return queue.NewMinPriorityQueue(2) }},
+		{"max", queue.MaxComparator, func() *queue.PriorityQueue { return queue.NewMaxPriorityQueue(2) }},
+	}
+	for _, c := range comparators {
+		t.Run(c.name, func(t *testing.T) {
+			rng := rand.New(rand.NewPCG(5, 8))
+			pq := c.newPQ()
+			model := []int{}
+			
+			for step := 0; step < 3000; step++ {
+				value := rng.IntN(50) - 25
+				switch rng.IntN(12) {
+				case 0, 1, 2, 3:
+					pq.Enqueue(value)
+					model = append(model, value)
+				case 4, 5:
+					got, err := pq.Dequeue()
+					if len(model) == 0 {
+						if !errors.Is(err, errs.ErrEmpty) {
+							t.Fatalf("passo %d: Dequeue em fila vazia: %v", step, err)
+						}
+						break
+					}
+					want := ordered(model, c.compare)[0]
+					if err != nil || got != want {
+						t.Fatalf("passo %d: Dequeue = (%d, %v), esperado %d", step, got, err, want)
+					}
+					replaceFirst(model, want, model[len(model)-1])
+					model = model[:len(model)-1]
+				case 6:
+					newValue := value + rng.IntN(21) - 10
+					err := pq.Update(value, newValue)
+					if replaceFirst(model, value, newValue) != (err == nil) || err != nil && !errors.Is(err, queue.ErrNotFound) {
+						t.Fatalf("passo %d: Update(%d): %v com modelo %v", step, value, err, model)
+					}
+				case 7:
+					newValue := value + rng.IntN(21) - 10
+					err := pq.DecreaseKey(value, newValue)
+					switch {
+					case c.compare(value, newValue):
+						if err == nil {
+							t.Fatalf("passo %d: DecreaseKey(%d, %d) baixou a prioridade sem erro", step, value, newValue)
+						}
+						continue
+					case !slices.Contains(model, value):
+						if !errors.Is(err, queue.ErrNotFound) {
+							t.Fatalf("passo %d: DecreaseKey de ausente: %v", step, err)
+						}
+						continue
+					case err != nil:
+						t.Fatalf("passo %d: DecreaseKey(%d, %d): %v", step, value, newValue, err)
+					}
+					replaceFirst(model, value, newValue)
+				case 8:
+					other := c.newPQ()
+					extra := []int{value, value + 1, value - 7}
+					other.EnqueueAll(extra)
+					pq.Merge(other)
+					model = append(model, extra...)
+					if !other.IsEmpty() {
+						t.Fatalf("passo %d: Merge deixou %v na outra fila", step, other.ToSlice())
+					}
+				case 9:
+					pq.Merge(pq) // Sem efeito
+				case 10:
+					if rng.IntN(10) == 0 {
+						elements := make([]int, rng.IntN(30))
+						for i := range elements {
+							elements[i] = rng.IntN(50) - 25
+						}
+						pq.Heapify(elements)
+						model = slices.Clone(elements)
+						for i := range elements {
+							elements[i] = 1000 // Heapify copia: o slice pode ser reutilizado
+						}
+					}
+				case 11:
+					front, errFront := pq.Front()
+					rear, errRear := pq.Rear()
+					if len(model) == 0 {
+						if errFront == nil || errRear == nil {
+							t.Fatalf("passo %d: Front/Rear em fila vazia sem erro", step)
+						}
+						break
+					}
+					sorted := ordered(model, c.compare)
+					if front != sorted[0] || rear != sorted[len(sorted)-1] || errFront != nil || errRear != nil {
+						t.Fatalf("passo %d: Front = %d, Rear = %d, esperado %d e %d", step, front, rear, sorted[0], sorted[len(sorted)-1])
+					}
+				}
+				
+				if err := pq.Validate(); err != nil {
+					t.Fatalf("passo %d: %v", step, err)
+				}
+				if got, want := pq.ToSlice(), ordered(model, c.compare); !slices.Equal(got, want) || pq.Size() != len(model) {
+					t.Fatalf("passo %d: fila %v, esperado %v", step, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPriorityQueueMergeWithItself(t *testing.T) {
+	pq := queue.NewMinPriorityQueue(4)
+	pq.EnqueueAll([]int{5, 1, 3})
+	pq.Merge(pq)
+	if got := pq.ToSlice(); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("Merge consigo mesma: %v, esperado [1 3 5]", got)
+	}
+}
+
+// Update e DecreaseKey comparam prioridades pelo mesmo caminho do heap,
+// então os contadores veem todas as comparações
+func TestPriorityQueueUpdateCountsComparisons(t *testing.T) {
+	pq := queue.NewMinPriorityQueue(4)
+	pq.Heapify([]int{1, 2, 3})
+	var counters instrument.Counters
+	pq.SetCounters(&counters)
+	
+	// 3 para achar o 3, 1 para decidir a direção; uma folha não desce
+	if err := pq.Update(3, 5); err != nil || counters.Comparisons != 4 {
+		t.Errorf("Update: %v, %d comparações, esperado 4", err, counters.Comparisons)
+	}
+	counters.Reset()
+	if err := pq.DecreaseKey(2, 7); err == nil || counters.Comparisons != 1 {
+		t.Errorf("DecreaseKey com prioridade menor: %v, %d comparações, esperado 1", err, counters.Comparisons)
+	}
+	counters.Reset()
+	// 1 para validar, 2 para achar o 2, 1 para subir até a raiz
+	if err := pq.DecreaseKey(2, 0); err != nil || counters.Comparisons != 4 {
+		t.Errorf("DecreaseKey: %v, %d comparações, esperado 4", err, counters.Comparisons)
+	}
+	if got := pq.ToSlice(); !slices.Equal(got, []int{0, 1, 5}) {
+		t.Errorf("fila %v, esperado [0 1 5]", got)
+	}
+}
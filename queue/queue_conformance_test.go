@@ -0,0 +1,67 @@
+package queue_test
+
+import (
+	"iter"
+	"testing"
+	
+	"dca3503/capacity"
+	"dca3503/queue"
+	"dca3503/queue/queuetest"
+)
+
+func TestArrayQueueConformance(t *testing.T) {
+	queuetest.Run(t, func() queue.Queue { return queue.NewArrayQueue(2) })
+}
+
+func TestArrayQueueGrowthPolicyConformance(t *testing.T) {
+	policy := capacity.Policy{Growth: capacity.Geometric{Factor: 1.5}, ShrinkAt: 0.3, ShrinkTo: 0.6}
+	queuetest.Run(t, func() queue.Queue {
+		q := queue.NewArrayQueue(1)
+		if err := q.SetGrowthPolicy(policy); err != nil {
+			t.Fatal(err)
+		}
+		return q
+	})
+}
+
+func TestLinkedQueueConformance(t *testing.T) {
+	queuetest.Run(t, func() queue.Queue { return queue.NewLinkedQueue() })
+}
+
+func TestRingBufferConformance(t *testing.T) {
+	// Grande o bastante para a suíte nunca sobrescrever
+	queuetest.Run(t, func() queue.Queue { return queue.NewRingBuffer(4096) })
+}
+
+func TestDurableQueueConformance(t *testing.T) {
+	// Segmentos pequenos e compactação frequente para exercitar o log
+	config := queue.DurableConfig{Sync: queue.SyncBatch, SegmentSize: 256, CompactEvery: 100}
+	queuetest.Run(t, func() queue.Queue {
+		q, err := queue.OpenDurableQueue(t.TempDir(), config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { q.Close() })
+		return q
+	})
+}
+
+// queuetest só confere os iteradores que o tipo tem: aqui garante que as
+// filas em memória têm All e Values, e as baseadas em array também Backward
+func TestQueuesHaveIterators(t *testing.T) {
+	type iterable interface {
+		All() iter.Seq2[int, int]
+		Values() iter.Seq[int]
+	}
+	type backward interface {
+		iterable
+		Backward() iter.Seq2[int, int]
+	}
+	var (
+		_ backward = (*queue.ArrayQueue)(nil)
+		_ backward = (*queue.RingBuffer)(nil)
+		_ backward = (*queue.BlockingQueue)(nil)
+		_ iterable = (*queue.LinkedQueue)(nil)
+		_ iterable = (*queue.PriorityQueue)(nil)
+	)
+}
@@ -1,6 +1,10 @@
-package main
+package queue
 
-import "fmt"
+import (
+	"fmt"
+
+	"dca3503/errs"
+)
 
 // ============================================================================
 // INTERFACE QUEUE - TIPO ABSTRATO DE DADOS
@@ -27,6 +31,18 @@ type Queue interface {
 	String() string            // Representação em string
 }
 
+// ============================================================================
+// ERROS
+// ============================================================================
+
+// Erros das filas; todos satisfazem errors.Is(err, errs.ErrEmpty)
+var (
+	errEmptyQueue   = errs.New(errs.ErrEmpty, "fila vazia", "empty queue")
+	errDequeueEmpty = errs.New(errs.ErrEmpty, "fila vazia: não é possível fazer dequeue", "empty queue: cannot dequeue")
+	errFrontEmpty   = errs.New(errs.ErrEmpty, "fila vazia: não há elemento na frente", "empty queue: no element at the front")
+	errRearEmpty    = errs.New(errs.ErrEmpty, "fila vazia: não há elemento no final", "empty queue: no element at the rear")
+)
+
 // ============================================================================
 // FUNÇÕES UTILITÁRIAS QUE TRABALHAM COM A INTERFACE
 // ============================================================================
@@ -131,7 +147,7 @@ func QueueSum(queue Queue) int {
 // QueueMax encontra o maior elemento na fila
 func QueueMax(queue Queue) (int, error) {
 	if queue.IsEmpty() {
-		return 0, fmt.Errorf("fila vazia")
+		return 0, errEmptyQueue
 	}
 	
 	// Fila auxiliar para preservar ordem
@@ -161,7 +177,7 @@ func QueueMax(queue Queue) (int, error) {
 // QueueMin encontra o menor elemento na fila
 func QueueMin(queue Queue) (int, error) {
 	if queue.IsEmpty() {
-		return 0, fmt.Errorf("fila vazia")
+		return 0, errEmptyQueue
 	}
 	
 	// Fila auxiliar para preservar ordem
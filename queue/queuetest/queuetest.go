@@ -0,0 +1,359 @@
+// Package queuetest implementa uma suíte de conformidade para queue.Queue
+//
+// Qualquer implementação FIFO da interface pode ser validada com uma chamada:
+//
+//	func TestMinhaFila(t *testing.T) {
+//		queuetest.Run(t, func() queue.Queue { return NovaMinhaFila() })
+//	}
+//
+// A suíte verifica os contratos da interface (erros em fila vazia, ordem
+// FIFO, Front/Rear sem remoção, ToSlice do início para o final, concordância
+// entre ToSlice e String) e executa sequências aleatórias de operações
+// comparando a fila com um modelo simples baseado em slice. Se a implementação tiver
+// um método Validate() error, ele é chamado depois de cada passo, e os
+// iteradores All, Values e Backward que ela tiver são conferidos contra ToSlice.
+//
+// PriorityQueue também implementa queue.Queue, mas não é FIFO: a ordem de
+// saída depende da prioridade, então ela não deve ser validada por esta suíte.
+package queuetest
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/queue"
+)
+
+// Factory cria uma fila nova e vazia a cada chamada
+type Factory func() queue.Queue
+
+// RandomSeeds são as sementes usadas nas sequências aleatórias
+// Fixas para que uma falha seja sempre reproduzível
+var RandomSeeds = []uint64{1, 2, 3, 42, 2024}
+
+// RandomOperations é o número de operações por sequência aleatória
+var RandomOperations = 2000
+
+// Run executa toda a suíte de conformidade sobre filas criadas por newQueue
+func Run(t *testing.T, newQueue Factory) {
+	t.Helper()
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, newQueue()) })
+	t.Run("FIFO", func(t *testing.T) { testFIFO(t, newQueue()) })
+	t.Run("FrontRearDoNotRemove", func(t *testing.T) { testFrontRear(t, newQueue()) })
+	t.Run("WrapAround", func(t *testing.T) { testWrapAround(t, newQueue()) })
+	t.Run("GrowAndShrink", func(t *testing.T) { testGrowAndShrink(t, newQueue()) })
+	t.Run("Clear", func(t *testing.T) { testClear(t, newQueue()) })
+	t.Run("ToSliceIsCopy", func(t *testing.T) { testToSliceIsCopy(t, newQueue()) })
+	t.Run("Randomized", func(t *testing.T) {
+		for _, seed := range RandomSeeds {
+			t.Run("seed="+strconv.FormatUint(seed, 10), func(t *testing.T) {
+				testRandomized(t, newQueue(), seed)
+			})
+		}
+	})
+}
+
+// ============================================================================
+// CONTRATOS
+// ============================================================================
+
+func testEmpty(t *testing.T, q queue.Queue) {
+	if q.Size() != 0 || !q.IsEmpty() {
+		t.Fatalf("fila nova: Size()=%d IsEmpty()=%v, esperado 0 e true", q.Size(), q.IsEmpty())
+	}
+	if q.IsFull() {
+		t.Errorf("fila nova não pode estar cheia")
+	}
+	if _, err := q.Dequeue(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Dequeue() em fila vazia deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	if _, err := q.Front(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Front() em fila vazia deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	if _, err := q.Rear(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Rear() em fila vazia deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	// Erros não podem alterar o estado
+	checkState(t, q, []int{})
+}
+
+func testFIFO(t *testing.T, q queue.Queue) {
+	want := []int{1, 2, 3, -4, 5}
+	for _, v := range want {
+		q.Enqueue(v)
+	}
+	checkState(t, q, want)
+	
+	for _, v := range want {
+		got, err := q.Dequeue()
+		if err != nil || got != v {
+			t.Fatalf("Dequeue() = (%d, %v), esperado (%d, nil)", got, err, v)
+		}
+	}
+	checkState(t, q, []int{})
+}
+
+func testFrontRear(t *testing.T, q queue.Queue) {
+	q.Enqueue(10)
+	q.Enqueue(20)
+	q.Enqueue(30)
+	for i := 0; i < 3; i++ {
+		front, errFront := q.Front()
+		rear, errRear := q.Rear()
+		if errFront != nil || errRear != nil || front != 10 || rear != 30 {
+			t.Fatalf("Front()=(%d, %v) Rear()=(%d, %v), esperado 10 e 30",
+				front, errFront, rear, errRear)
+		}
+	}
+	checkState(t, q, []int{10, 20, 30})
+}
+
+func testWrapAround(t *testing.T, q queue.Queue) {
+	// Entra e sai alternadamente para que o início dê várias voltas no array
+	next, expected := 0, 0
+	for round := 0; round < 100; round++ {
+		for i := 0; i < 3; i++ {
+			q.Enqueue(next)
+			next++
+		}
+		for i := 0; i < 2; i++ {
+			got, err := q.Dequeue()
+			if err != nil || got != expected {
+				t.Fatalf("rodada %d: Dequeue() = (%d, %v), esperado (%d, nil)", round, got, err, expected)
+			}
+			expected++
+		}
+	}
+	
+	want := []int{}
+	for v := expected; v < next; v++ {
+		want = append(want, v)
+	}
+	checkState(t, q, want)
+}
+
+func testGrowAndShrink(t *testing.T, q queue.Queue) {
+	// Passa por vários redimensionamentos nas implementações com array
+	const n = 1000
+	for i := 0; i < n; i++ {
+		q.Enqueue(i)
+	}
+	if q.Size() != n {
+		t.Fatalf("Size() = %d após %d Enqueue, esperado %d", q.Size(), n, n)
+	}
+	for i := 0; i < n; i++ {
+		got, err := q.Dequeue()
+		if err != nil || got != i {
+			t.Fatalf("Dequeue() = (%d, %v), esperado (%d, nil)", got, err, i)
+		}
+	}
+	checkState(t, q, []int{})
+}
+
+func testClear(t *testing.T, q queue.Queue) {
+	for i := 0; i < 50; i++ {
+		q.Enqueue(i)
+	}
+	q.Clear()
+	checkState(t, q, []int{})
+	
+	// A fila continua utilizável depois de Clear
+	q.Enqueue(1)
+	q.Enqueue(2)
+	checkState(t, q, []int{1, 2})
+}
+
+func testToSliceIsCopy(t *testing.T, q queue.Queue) {
+	q.Enqueue(1)
+	q.Enqueue(2)
+	slice := q.ToSlice()
+	slice[0] = 100
+	if got, _ := q.Front(); got != 1 {
+		t.Errorf("alterar o resultado de ToSlice modificou a fila: Front() = %d", got)
+	}
+}
+
+// ============================================================================
+// SEQUÊNCIAS ALEATÓRIAS CONTRA UM MODELO
+// ============================================================================
+
+// testRandomized aplica operações aleatórias na fila e num slice (modelo)
+// e compara os dois depois de cada passo
+func testRandomized(t *testing.T, q queue.Queue, seed uint64) {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	model := []int{}
+	
+	for step := 0; step < RandomOperations; step++ {
+		var op string
+		switch rng.IntN(10) {
+		case 0, 1, 2, 3, 4:
+			value := rng.IntN(100) - 50
+			op = "Enqueue(" + strconv.Itoa(value) + ")"
+			q.Enqueue(value)
+			model = append(model, value)
+		case 5, 6, 7:
+			op = "Dequeue()"
+			got, err := q.Dequeue()
+			if len(model) == 0 {
+				if !errors.Is(err, errs.ErrEmpty) {
+					t.Fatalf("passo %d: Dequeue() em fila vazia deveria retornar errs.ErrEmpty, retornou %v", step, err)
+				}
+				break
+			}
+			want := model[0]
+			model = model[1:]
+			if err != nil || got != want {
+				t.Fatalf("passo %d: Dequeue() = (%d, %v), modelo tem %d", step, got, err, want)
+			}
+		case 8:
+			op = "Front()/Rear()"
+			front, errFront := q.Front()
+			rear, errRear := q.Rear()
+			if len(model) == 0 {
+				if errFront == nil || errRear == nil {
+					t.Fatalf("passo %d: Front()/Rear() em fila vazia deveriam retornar erro", step)
+				}
+				break
+			}
+			if errFront != nil || front != model[0] {
+				t.Fatalf("passo %d: Front() = (%d, %v), modelo tem %d", step, front, errFront, model[0])
+			}
+			if last := model[len(model)-1]; errRear != nil || rear != last {
+				t.Fatalf("passo %d: Rear() = (%d, %v), modelo tem %d", step, rear, errRear, last)
+			}
+		case 9:
+			// Clear raro para deixar a fila crescer
+			if rng.IntN(20) == 0 {
+				op = "Clear()"
+				q.Clear()
+				model = model[:0]
+			}
+		}
+		
+		if err := validate(q); err != nil {
+			t.Fatalf("passo %d (%s): %v", step, op, err)
+		}
+		
+		if q.Size() != len(model) || !slices.Equal(q.ToSlice(), model) {
+			t.Fatalf("passo %d (%s): fila %v (Size()=%d), modelo %v",
+				step, op, q.ToSlice(), q.Size(), model)
+		}
+		if q.IsEmpty() != (len(model) == 0) {
+			t.Fatalf("passo %d (%s): IsEmpty()=%v com %d elementos", step, op, q.IsEmpty(), len(model))
+		}
+	}
+	checkString(t, q)
+}
+
+// ============================================================================
+// AUXILIARES
+// ============================================================================
+
+// checkState compara tamanho, ToSlice e String com o conteúdo esperado
+func checkState(t *testing.T, q queue.Queue, want []int) {
+	t.Helper()
+	if err := validate(q); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if q.Size() != len(want) {
+		t.Fatalf("Size() = %d, esperado %d", q.Size(), len(want))
+	}
+	if q.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("IsEmpty() = %v com %d elementos", q.IsEmpty(), len(want))
+	}
+	if got := q.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, esperado %v", got, want)
+	}
+	checkIterators(t, q)
+	checkString(t, q)
+}
+
+// validate roda Validate() quando a implementação oferece o verificador de
+// invariantes estruturais; implementações sem ele passam direto
+func validate(structure queue.Queue) error {
+	if validator, ok := structure.(interface{ Validate() error }); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// checkIterators confere os iteradores All, Values e Backward que a
+// implementação oferecer: percorrem os elementos de ToSlice na mesma ordem
+// (Backward na inversa, com as mesmas posições) e param assim que yield
+// retorna false
+func checkIterators(t *testing.T, q queue.Queue) {
+	t.Helper()
+	want := q.ToSlice()
+	positions := make([]int, len(want))
+	for i := range positions {
+		positions[i] = i
+	}
+	if it, ok := q.(interface{ All() iter.Seq2[int, int] }); ok {
+		checkSeq(t, "All", it.All(), positions, want)
+	}
+	if it, ok := q.(interface{ Values() iter.Seq[int] }); ok {
+		// Numera os valores para conferir como os pares de All
+		numbered := func(yield func(int, int) bool) {
+			i := 0
+			for value := range it.Values() {
+				if !yield(i, value) {
+					return
+				}
+				i++
+			}
+		}
+		checkSeq(t, "Values", numbered, positions, want)
+	}
+	if it, ok := q.(interface{ Backward() iter.Seq2[int, int] }); ok {
+		slices.Reverse(positions)
+		backward := slices.Clone(want)
+		slices.Reverse(backward)
+		checkSeq(t, "Backward", it.Backward(), positions, backward)
+	}
+}
+
+// checkSeq percorre seq inteiro comparando com os pares esperados e depois
+// de novo, parando no meio: seq não pode chamar yield depois de um false
+func checkSeq(t *testing.T, name string, seq iter.Seq2[int, int], positions, want []int) {
+	t.Helper()
+	gotPositions, gotValues := []int{}, []int{}
+	for position, value := range seq {
+		gotPositions = append(gotPositions, position)
+		gotValues = append(gotValues, value)
+	}
+	if !slices.Equal(gotPositions, positions) || !slices.Equal(gotValues, want) {
+		t.Fatalf("%s() = posições %v, valores %v; esperado %v e %v", name, gotPositions, gotValues, positions, want)
+	}
+	
+	stop := len(want)/2 + 1
+	calls := 0
+	seq(func(int, int) bool {
+		calls++
+		return calls < stop
+	})
+	if len(want) > 0 && calls != stop {
+		t.Fatalf("%s(): yield chamado %d vezes, mas retornou false na chamada %d", name, calls, stop)
+	}
+}
+
+var numberPattern = regexp.MustCompile(`-?\d+`)
+
+// checkString verifica se String mostra os mesmos números, na mesma ordem, que ToSlice
+// O formato (colchetes, separadores, rótulos) fica livre para cada implementação
+func checkString(t *testing.T, q queue.Queue) {
+	t.Helper()
+	got := []int{}
+	for _, match := range numberPattern.FindAllString(q.String(), -1) {
+		number, _ := strconv.Atoi(match)
+		got = append(got, number)
+	}
+	if want := q.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("String() = %q não concorda com ToSlice() = %v", q.String(), want)
+	}
+}
@@ -0,0 +1,329 @@
+package queue
+
+import (
+	"iter"
+
+	"dca3503/capacity"
+	"dca3503/instrument"
+)
+
+// ============================================================================
+// RINGBUFFER - ÚLTIMOS N ELEMENTOS COM AGREGADOS MÓVEIS
+// ============================================================================
+
+// RingBuffer guarda os últimos N elementos inseridos, como uma janela móvel
+// de amostras de telemetria, usando o array circular do ArrayQueue
+// Características:
+// - Capacidade fixa: o array nunca cresce nem diminui
+// - Push (e Enqueue) com o buffer cheio sobrescreve o elemento mais antigo
+//   e retorna o que foi descartado
+// - Acesso O(1) por posição a partir de qualquer ponta (At, AtBack)
+// - Sum, Min, Max e Mean em O(1), mantidos a cada inserção e remoção
+//   sem percorrer o buffer
+//
+// Mínimo e máximo usam filas monotônicas: para o mínimo guardam-se só os
+// elementos que ainda podem vir a ser o menor da janela, em ordem crescente
+// de valor. Um elemento novo remove do fim os candidatos maiores ou iguais
+// a ele (nunca mais serão o mínimo enquanto ele estiver na janela), e o
+// mínimo é sempre o primeiro candidato. Cada elemento entra e sai uma vez,
+// então o custo é O(1) amortizado por inserção
+type RingBuffer struct {
+	buffer    *ArrayQueue // MaxCapacity == capacidade, com OverflowOverwrite
+	first     int         // Número de sequência do elemento da frente
+	sum       int         // Soma dos elementos no buffer
+	mins      monotonic   // Candidatos a mínimo: valores crescentes
+	maxs      monotonic   // Candidatos a máximo: valores decrescentes
+	evictions int         // Elementos descartados por sobrescrita
+}
+
+// monotonic é um array circular de números de sequência (os candidatos)
+// A sequência de um elemento é first mais a sua posição no buffer, então
+// continua válida enquanto ele não sai da frente
+type monotonic struct {
+	seqs []int
+	head int
+	size int
+}
+
+// NewRingBuffer cria um buffer circular para os últimos limit elementos
+func NewRingBuffer(limit int) *RingBuffer {
+	if limit <= 0 {
+		limit = 10 // Capacidade padrão
+	}
+	buffer := NewArrayQueue(limit)
+	buffer.SetGrowthPolicy(capacity.Policy{MaxCapacity: limit, Overflow: capacity.OverflowOverwrite})
+	return &RingBuffer{
+		buffer: buffer,
+		mins:   monotonic{seqs: make([]int, limit)},
+		maxs:   monotonic{seqs: make([]int, limit)},
+	}
+}
+
+// SetCounters associa contadores de operações ao buffer interno
+func (r *RingBuffer) SetCounters(counters *instrument.Counters) {
+	r.buffer.SetCounters(counters)
+}
+
+// ============================================================================
+// INSERÇÃO E REMOÇÃO
+// ============================================================================
+
+// Push adiciona um elemento no final; com o buffer cheio descarta o mais
+// antigo (a frente) e o retorna com ok == true
+// Complexidade: O(1) amortizado
+func (r *RingBuffer) Push(element int) (evicted int, ok bool) {
+	defer checkInvariants(r)
+	evicted, ok = r.buffer.Overwrite(element)
+	if ok {
+		r.forget(evicted)
+		r.evictions++
+	}
+	r.sum += element
+	r.track(r.first+r.buffer.size-1, element)
+	return evicted, ok
+}
+
+// Enqueue adiciona um elemento no final, descartando o mais antigo se o
+// buffer estiver cheio; use Push para saber qual foi descartado
+// Complexidade: O(1) amortizado
+func (r *RingBuffer) Enqueue(element int) {
+	r.Push(element)
+}
+
+// Dequeue remove e retorna o elemento mais antigo
+// Complexidade: O(1)
+func (r *RingBuffer) Dequeue() (int, error) {
+	defer checkInvariants(r)
+	element, err := r.buffer.Dequeue()
+	if err != nil {
+		return 0, err
+	}
+	r.forget(element)
+	return element, nil
+}
+
+// forget atualiza os agregados depois que o elemento da frente saiu
+func (r *RingBuffer) forget(element int) {
+	r.sum -= element
+	r.mins.dropFront(r.first)
+	r.maxs.dropFront(r.first)
+	r.first++
+}
+
+// track inclui nos candidatos o elemento recém-inserido com sequência seq
+func (r *RingBuffer) track(seq, element int) {
+	for r.mins.size > 0 && r.value(r.mins.back()) >= element {
+		r.mins.popBack()
+	}
+	r.mins.pushBack(seq)
+	for r.maxs.size > 0 && r.value(r.maxs.back()) <= element {
+		r.maxs.popBack()
+	}
+	r.maxs.pushBack(seq)
+}
+
+// value retorna o elemento com a sequência seq, que precisa estar no buffer
+func (r *RingBuffer) value(seq int) int {
+	return r.buffer.data[(r.buffer.front+seq-r.first)%r.buffer.capacity]
+}
+
+// Clear remove todos os elementos sem realocar o array
+// Complexidade: O(1)
+func (r *RingBuffer) Clear() {
+	defer checkInvariants(r)
+	r.buffer.front = 0
+	r.buffer.rear = 0
+	r.buffer.size = 0
+	r.sum = 0
+	r.mins.head, r.mins.size = 0, 0
+	r.maxs.head, r.maxs.size = 0, 0
+}
+
+// ============================================================================
+// CONSULTAS
+// ============================================================================
+
+// Front retorna o elemento mais antigo sem removê-lo
+// Complexidade: O(1)
+func (r *RingBuffer) Front() (int, error) {
+	return r.buffer.Front()
+}
+
+// Rear retorna o elemento mais recente sem removê-lo
+// Complexidade: O(1)
+func (r *RingBuffer) Rear() (int, error) {
+	return r.buffer.Rear()
+}
+
+// At retorna o elemento na posição index a partir do mais antigo (0)
+// Complexidade: O(1)
+func (r *RingBuffer) At(index int) (int, error) {
+	return r.buffer.At(index)
+}
+
+// AtBack retorna o elemento na posição index a partir do mais recente (0)
+// Complexidade: O(1)
+func (r *RingBuffer) AtBack(index int) (int, error) {
+	return r.buffer.AtBack(index)
+}
+
+// Snapshot copia os elementos (do mais antigo para o mais recente) para
+// dst, reaproveitando o seu array, e retorna o slice resultante
+// Complexidade: O(n), sem alocar se cap(dst) >= Size()
+func (r *RingBuffer) Snapshot(dst []int) []int {
+	return r.buffer.Snapshot(dst)
+}
+
+// Size retorna o número de elementos no buffer
+// Complexidade: O(1)
+func (r *RingBuffer) Size() int {
+	return r.buffer.Size()
+}
+
+// IsEmpty verifica se o buffer está vazio
+// Complexidade: O(1)
+func (r *RingBuffer) IsEmpty() bool {
+	return r.buffer.IsEmpty()
+}
+
+// IsFull verifica se o buffer está cheio (a próxima inserção sobrescreve)
+// Complexidade: O(1)
+func (r *RingBuffer) IsFull() bool {
+	return r.buffer.IsFull()
+}
+
+// Capacity retorna o número máximo de elementos guardados
+func (r *RingBuffer) Capacity() int {
+	return r.buffer.Capacity()
+}
+
+// ToSlice converte o buffer para um slice (do mais antigo para o mais recente)
+// Complexidade: O(n)
+func (r *RingBuffer) ToSlice() []int {
+	return r.buffer.ToSlice()
+}
+
+// String retorna uma representação em string do buffer
+// Complexidade: O(n)
+func (r *RingBuffer) String() string {
+	return r.buffer.String()
+}
+
+// All retorna um iterador sobre pares (posição, elemento) do mais antigo
+// para o mais recente, com o acesso O(1) de At
+func (r *RingBuffer) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := 0; i < r.Size(); i++ {
+			element, _ := r.At(i)
+			if !yield(i, element) {
+				return
+			}
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do mais antigo para o mais recente
+func (r *RingBuffer) Values() iter.Seq[int] {
+	return r.buffer.Values()
+}
+
+// Backward retorna um iterador sobre pares (posição, elemento) do mais
+// recente para o mais antigo, com o acesso O(1) de AtBack
+func (r *RingBuffer) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for k := 0; k < r.Size(); k++ {
+			element, _ := r.AtBack(k)
+			if !yield(r.Size()-1-k, element) {
+				return
+			}
+		}
+	}
+}
+
+// ============================================================================
+// AGREGADOS MÓVEIS
+// ============================================================================
+
+// Sum retorna a soma dos elementos no buffer (0 se vazio)
+// Complexidade: O(1)
+func (r *RingBuffer) Sum() int {
+	return r.sum
+}
+
+// Min retorna o menor elemento no buffer
+// Complexidade: O(1)
+func (r *RingBuffer) Min() (int, error) {
+	if r.buffer.IsEmpty() {
+		return 0, errEmptyQueue
+	}
+	return r.value(r.mins.front()), nil
+}
+
+// Max retorna o maior elemento no buffer
+// Complexidade: O(1)
+func (r *RingBuffer) Max() (int, error) {
+	if r.buffer.IsEmpty() {
+		return 0, errEmptyQueue
+	}
+	return r.value(r.maxs.front()), nil
+}
+
+// Mean retorna a média dos elementos no buffer
+// Complexidade: O(1)
+func (r *RingBuffer) Mean() (float64, error) {
+	if r.buffer.IsEmpty() {
+		return 0, errEmptyQueue
+	}
+	return float64(r.sum) / float64(r.buffer.size), nil
+}
+
+// GetStatistics retorna os agregados e o número de elementos descartados
+func (r *RingBuffer) GetStatistics() map[string]interface{} {
+	stats := map[string]interface{}{
+		"size":      r.buffer.size,
+		"capacity":  r.buffer.capacity,
+		"isEmpty":   r.buffer.IsEmpty(),
+		"sum":       r.sum,
+		"evictions": r.evictions,
+	}
+	if !r.buffer.IsEmpty() {
+		stats["min"], _ = r.Min()
+		stats["max"], _ = r.Max()
+		stats["average"], _ = r.Mean()
+	}
+	return stats
+}
+
+// ============================================================================
+// FILA MONOTÔNICA
+// ============================================================================
+
+// front retorna o primeiro candidato (o mais antigo)
+func (m *monotonic) front() int {
+	return m.seqs[m.head]
+}
+
+// back retorna o último candidato (o mais recente)
+func (m *monotonic) back() int {
+	return m.seqs[(m.head+m.size-1)%len(m.seqs)]
+}
+
+// pushBack acrescenta um candidato no fim
+func (m *monotonic) pushBack(seq int) {
+	m.seqs[(m.head+m.size)%len(m.seqs)] = seq
+	m.size++
+}
+
+// popBack descarta o último candidato
+func (m *monotonic) popBack() {
+	m.size--
+}
+
+// dropFront descarta o primeiro candidato se ele é o elemento seq, que
+// acabou de sair da janela
+func (m *monotonic) dropFront(seq int) {
+	if m.size > 0 && m.seqs[m.head] == seq {
+		m.head = (m.head + 1) % len(m.seqs)
+		m.size--
+	}
+}
@@ -0,0 +1,166 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	
+	"dca3503/errs"
+)
+
+func TestRingBufferOverwritesOldest(t *testing.T) {
+	r := NewRingBuffer(3)
+	for i := 1; i <= 3; i++ {
+		if _, ok := r.Push(i); ok {
+			t.Fatalf("Push(%d) com espaço livre descartou um elemento", i)
+		}
+	}
+	if !r.IsFull() {
+		t.Fatalf("buffer deveria estar cheio: %s", r)
+	}
+	for i := 4; i <= 5; i++ {
+		if evicted, ok := r.Push(i); !ok || evicted != i-3 {
+			t.Errorf("Push(%d) = (%d, %v), esperado (%d, true)", i, evicted, ok, i-3)
+		}
+	}
+	if got := r.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) || r.Capacity() != 3 {
+		t.Errorf("buffer %v com capacidade %d", got, r.Capacity())
+	}
+	if stats := r.GetStatistics(); stats["evictions"] != 2 {
+		t.Errorf("evictions = %v, esperado 2", stats["evictions"])
+	}
+	
+	// Depois de Clear o array é o mesmo
+	data := r.buffer.data
+	r.Clear()
+	r.Push(7)
+	if &r.buffer.data[0] != &data[0] || r.Sum() != 7 {
+		t.Errorf("Clear realocou o array ou não zerou a soma: %s, soma %d", r, r.Sum())
+	}
+}
+
+func TestRingBufferIndexAndSnapshot(t *testing.T) {
+	r := NewRingBuffer(4)
+	for i := 1; i <= 6; i++ {
+		r.Push(i * 10) // Dá a volta no array: [30, 40, 50, 60]
+	}
+	for i, want := range []int{30, 40, 50, 60} {
+		if got, err := r.At(i); err != nil || got != want {
+			t.Errorf("At(%d) = (%d, %v), esperado %d", i, got, err, want)
+		}
+		if got, err := r.AtBack(i); err != nil || got != 90-want {
+			t.Errorf("AtBack(%d) = (%d, %v), esperado %d", i, got, err, 90-want)
+		}
+	}
+	var indexErr *errs.IndexError
+	if _, err := r.AtBack(4); !errors.As(err, &indexErr) || *indexErr != (errs.IndexError{Index: 4, Size: 4}) {
+		t.Errorf("AtBack(4): %v", err)
+	}
+	
+	dst := make([]int, 0, 8)
+	got := r.Snapshot(dst)
+	if !slices.Equal(got, []int{30, 40, 50, 60}) || &got[0] != &dst[:1][0] {
+		t.Errorf("Snapshot = %v, deveria reaproveitar dst", got)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { dst = r.Snapshot(dst) }); allocs != 0 {
+		t.Errorf("Snapshot com capacidade suficiente alocou %.0f vezes", allocs)
+	}
+	if got := r.Snapshot(nil); !slices.Equal(got, r.ToSlice()) {
+		t.Errorf("Snapshot(nil) = %v", got)
+	}
+	
+	// Os iteradores também partem do elemento mais antigo, não do slot 0
+	var forward, backward []int
+	for i, element := range r.All() {
+		forward = append(forward, i, element)
+	}
+	for i, element := range r.Backward() {
+		backward = append(backward, i, element)
+	}
+	if !slices.Equal(forward, []int{0, 30, 1, 40, 2, 50, 3, 60}) || !slices.Equal(backward, []int{3, 60, 2, 50, 1, 40, 0, 30}) {
+		t.Errorf("All = %v, Backward = %v", forward, backward)
+	}
+}
+
+func TestRingBufferAggregatesMatchModel(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+	r := NewRingBuffer(5)
+	model := []int{}
+	
+	for step := 0; step < 3000; step++ {
+		switch rng.IntN(10) {
+		case 0, 1, 2, 3, 4, 5, 6:
+			value := rng.IntN(21) - 10 // Valores repetidos de propósito
+			evicted, ok := r.Push(value)
+			if len(model) == 5 {
+				if !ok || evicted != model[0] {
+					t.Fatalf("passo %d: Push = (%d, %v), modelo descarta %d", step, evicted, ok, model[0])
+				}
+				model = model[1:]
+			}
+			model = append(model, value)
+		case 7, 8:
+			if _, err := r.Dequeue(); err == nil {
+				model = model[1:]
+			}
+		case 9:
+			if rng.IntN(10) == 0 {
+				r.Clear()
+				model = model[:0]
+			}
+		}
+		if err := r.Validate(); err != nil {
+			t.Fatalf("passo %d: %v", step, err)
+		}
+		checkAggregates(t, step, r, model)
+	}
+}
+
+// checkAggregates compara Sum, Min, Max e Mean com o cálculo direto no modelo
+func checkAggregates(t *testing.T, step int, r *RingBuffer, model []int) {
+	t.Helper()
+	sum := 0
+	for _, value := range model {
+		sum += value
+	}
+	if r.Sum() != sum || !slices.Equal(r.ToSlice(), model) {
+		t.Fatalf("passo %d: buffer %v (soma %d), modelo %v (soma %d)", step, r.ToSlice(), r.Sum(), model, sum)
+	}
+	minimum, errMin := r.Min()
+	maximum, errMax := r.Max()
+	mean, errMean := r.Mean()
+	if len(model) == 0 {
+		if !errors.Is(errMin, errs.ErrEmpty) || !errors.Is(errMax, errs.ErrEmpty) || !errors.Is(errMean, errs.ErrEmpty) {
+			t.Fatalf("passo %d: agregados de buffer vazio deveriam retornar errs.ErrEmpty", step)
+		}
+		return
+	}
+	if minimum != slices.Min(model) || maximum != slices.Max(model) || mean != float64(sum)/float64(len(model)) {
+		t.Fatalf("passo %d: min %d, max %d, média %g para %v", step, minimum, maximum, mean, model)
+	}
+}
+
+func TestRingBufferJSON(t *testing.T) {
+	r := NewRingBuffer(3)
+	for i := 1; i <= 4; i++ {
+		r.Push(i)
+	}
+	data, err := json.Marshal(r)
+	if err != nil || string(data) != "[2,3,4]" {
+		t.Fatalf("Marshal = %s, %v", data, err)
+	}
+	
+	var decoded RingBuffer
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if minimum, _ := decoded.Min(); decoded.Sum() != 9 || minimum != 2 || decoded.Capacity() != 10 {
+		t.Errorf("valor zero decodificado: %s, soma %d, capacidade %d", &decoded, decoded.Sum(), decoded.Capacity())
+	}
+	small := NewRingBuffer(2)
+	if err := json.Unmarshal(data, small); !errors.Is(err, errs.ErrFull) || !small.IsEmpty() {
+		t.Errorf("3 elementos em capacidade 2: %v, buffer %s", err, small)
+	}
+}
@@ -0,0 +1,207 @@
+package queue
+
+import (
+	"fmt"
+
+	"dca3503/capacity"
+)
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// Validate verifica as invariantes internas do ArrayQueue
+// - capacity == len(data) e capacity > 0
+// - 0 <= front < capacity e 0 <= size <= capacity
+// - rear == (front + size) % capacity
+// - capacity <= MaxCapacity da política, quando houver limite
+// Complexidade: Θ(1)
+func (q *ArrayQueue) Validate() error {
+	if q.capacity != len(q.data) {
+		return fmt.Errorf("ArrayQueue: capacity %d diferente do array interno (%d)", q.capacity, len(q.data))
+	}
+	if q.capacity <= 0 {
+		return fmt.Errorf("ArrayQueue: capacity deveria ser positiva (%d)", q.capacity)
+	}
+	if q.front < 0 || q.front >= q.capacity {
+		return fmt.Errorf("ArrayQueue: front %d fora de [0, %d)", q.front, q.capacity)
+	}
+	if q.size < 0 || q.size > q.capacity {
+		return fmt.Errorf("ArrayQueue: size %d fora de [0, %d]", q.size, q.capacity)
+	}
+	if want := (q.front + q.size) % q.capacity; q.rear != want {
+		return fmt.Errorf("ArrayQueue: rear %d, esperado (front+size)%%capacity = %d", q.rear, want)
+	}
+	if limit := q.policy.Clamp(q.capacity); limit < q.capacity {
+		return fmt.Errorf("ArrayQueue: capacity %d acima do máximo da política (%d)", q.capacity, limit)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da LinkedQueue
+// - front, rear e size concordam sobre a fila estar vazia
+// - rear.next == nil
+// - a cadeia a partir de front tem exatamente size nós e termina em rear
+// Complexidade: O(n)
+func (q *LinkedQueue) Validate() error {
+	if (q.front == nil) != (q.rear == nil) || (q.front == nil) != (q.size == 0) {
+		return fmt.Errorf("LinkedQueue: front nil = %v, rear nil = %v com size %d",
+			q.front == nil, q.rear == nil, q.size)
+	}
+	if q.front == nil {
+		return nil
+	}
+	if q.rear.next != nil {
+		return fmt.Errorf("LinkedQueue: rear.next deveria ser nil")
+	}
+	
+	count := 1
+	current := q.front
+	for current.next != nil {
+		current = current.next
+		count++
+		if count > q.size {
+			return fmt.Errorf("LinkedQueue: mais nós que size (%d) ou ciclo", q.size)
+		}
+	}
+	if current != q.rear {
+		return fmt.Errorf("LinkedQueue: a cadeia não termina em rear")
+	}
+	if count != q.size {
+		return fmt.Errorf("LinkedQueue: size %d, mas a cadeia tem %d nós", q.size, count)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da PriorityQueue
+// - o comparador existe
+// - propriedade de heap: nenhum filho tem prioridade sobre o pai
+// Complexidade: O(n)
+func (pq *PriorityQueue) Validate() error {
+	if pq.compare == nil {
+		return fmt.Errorf("PriorityQueue: comparador nil")
+	}
+	for i := 1; i < len(pq.data); i++ {
+		parent := (i - 1) / 2
+		if pq.compare(pq.data[i], pq.data[parent]) {
+			return fmt.Errorf("PriorityQueue: filho %d (índice %d) tem prioridade sobre o pai %d (índice %d)",
+				pq.data[i], i, pq.data[parent], parent)
+		}
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da BlockingQueue
+// - o buffer é um ArrayQueue válido com no máximo capacity elementos
+// - os canais de sinalização existem
+// As operações da BlockingQueue não chamam checkInvariants diretamente:
+// toda alteração passa pelo buffer, que já é verificado a cada operação
+// Complexidade: Θ(1)
+func (q *BlockingQueue) Validate() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	
+	if err := q.buffer.Validate(); err != nil {
+		return fmt.Errorf("BlockingQueue: %w", err)
+	}
+	if q.buffer.Size() > q.capacity {
+		return fmt.Errorf("BlockingQueue: %d elementos com capacidade %d", q.buffer.Size(), q.capacity)
+	}
+	if q.notFull == nil || q.notEmpty == nil {
+		return fmt.Errorf("BlockingQueue: canal de sinalização nil")
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da DurableQueue
+// - a fila em memória é um ArrayQueue válido
+// - o segmento aberto não vem antes do snapshot e já tem o cabeçalho
+// As alterações passam pela fila em memória, que já é verificada a cada operação
+// Complexidade: Θ(1)
+func (q *DurableQueue) Validate() error {
+	if err := q.memory.Validate(); err != nil {
+		return fmt.Errorf("DurableQueue: %w", err)
+	}
+	if q.segmentID < q.firstSegment {
+		return fmt.Errorf("DurableQueue: segmento %d anterior ao snapshot (%d)", q.segmentID, q.firstSegment)
+	}
+	if q.segment != nil && q.segmentSize < int64(walHeaderSize) {
+		return fmt.Errorf("DurableQueue: segmento com %d bytes, menor que o cabeçalho", q.segmentSize)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas do RingBuffer
+// - o buffer é um ArrayQueue válido cuja capacidade é o MaxCapacity da
+//   política, que sobrescreve ao encher
+// - sum é a soma dos elementos
+// - as filas monotônicas têm sequências crescentes dentro da janela, valores
+//   estritamente crescentes (mínimo) ou decrescentes (máximo), o primeiro
+//   candidato é o mínimo (máximo) e o último é o elemento mais recente
+// Complexidade: O(n)
+func (r *RingBuffer) Validate() error {
+	if err := r.buffer.Validate(); err != nil {
+		return fmt.Errorf("RingBuffer: %w", err)
+	}
+	policy := r.buffer.policy
+	if policy.MaxCapacity != r.buffer.capacity || policy.Overflow != capacity.OverflowOverwrite {
+		return fmt.Errorf("RingBuffer: política %s com capacidade %d", policy, r.buffer.capacity)
+	}
+	sum := 0
+	for value := range r.buffer.Values() {
+		sum += value
+	}
+	if sum != r.sum {
+		return fmt.Errorf("RingBuffer: sum %d, mas os elementos somam %d", r.sum, sum)
+	}
+	if err := r.validateCandidates("mínimo", &r.mins, func(a, b int) bool { return a < b }); err != nil {
+		return err
+	}
+	return r.validateCandidates("máximo", &r.maxs, func(a, b int) bool { return a > b })
+}
+
+// validateCandidates confere uma fila monotônica; before(a, b) diz se o
+// candidato a vem antes de b (a < b para o mínimo)
+func (r *RingBuffer) validateCandidates(name string, m *monotonic, before func(a, b int) bool) error {
+	size := r.buffer.size
+	if len(m.seqs) != r.buffer.capacity || m.size > size || (m.size == 0) != (size == 0) {
+		return fmt.Errorf("RingBuffer: %d candidatos a %s com %d elementos", m.size, name, size)
+	}
+	if size == 0 {
+		return nil
+	}
+	previous := r.first - 1
+	for i := 0; i < m.size; i++ {
+		seq := m.seqs[(m.head+i)%len(m.seqs)]
+		if seq <= previous || seq >= r.first+size {
+			return fmt.Errorf("RingBuffer: candidato a %s com sequência %d fora de ordem ou da janela [%d, %d)",
+				name, seq, r.first, r.first+size)
+		}
+		if i > 0 && !before(r.value(previous), r.value(seq)) {
+			return fmt.Errorf("RingBuffer: candidatos a %s fora de ordem: %d antes de %d", name, r.value(previous), r.value(seq))
+		}
+		previous = seq
+	}
+	if previous != r.first+size-1 {
+		return fmt.Errorf("RingBuffer: o último candidato a %s não é o elemento mais recente", name)
+	}
+	best := r.value(m.front())
+	for value := range r.buffer.Values() {
+		if before(value, best) {
+			return fmt.Errorf("RingBuffer: %s %d, mas o buffer tem %d", name, best, value)
+		}
+	}
+	return nil
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug);
+// uma invariante quebrada vira panic, apontando a operação culpada no stack trace
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
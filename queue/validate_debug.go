@@ -0,0 +1,6 @@
+//go:build debug
+
+package queue
+
+// debugValidate liga checkInvariants (compilado com -tags debug)
+const debugValidate = true
@@ -0,0 +1,6 @@
+//go:build !debug
+
+package queue
+
+// debugValidate desliga checkInvariants (compilação normal)
+const debugValidate = false
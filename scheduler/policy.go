@@ -0,0 +1,374 @@
+package scheduler
+
+import (
+	"fmt"
+	"strings"
+
+	"dca3503/queue"
+)
+
+// ============================================================================
+// INTERFACE POLICY
+// ============================================================================
+
+// State é a visão do simulador compartilhada com a política
+// Os processos são identificados pelo índice em Processes
+type State struct {
+	Processes []Process
+	Remaining []int // Tempo de CPU que ainda falta para cada processo
+	Now       int   // Relógio do simulador
+}
+
+// Policy decide qual processo pronto usa a CPU e por quanto tempo
+// O simulador cuida do relógio, das chegadas e das estatísticas
+type Policy interface {
+	Name() string
+	Reset(state *State)                    // Prepara a política para uma nova carga
+	Arrive(process int)                    // Processo ficou pronto pela primeira vez
+	Preempt(process int, used int)         // Processo saiu da CPU sem terminar, depois de usar used unidades
+	Next() (process, quantum int, ok bool) // Próximo a executar; quantum 0 = até terminar
+	ShouldPreempt(running int) bool        // Consultada após chegadas: interromper quem está rodando?
+}
+
+// ============================================================================
+// FCFS - PRIMEIRO A CHEGAR, PRIMEIRO A SER SERVIDO
+// ============================================================================
+
+// FCFS executa os processos na ordem de chegada, sem preempção
+type FCFS struct {
+	ready *queue.LinkedQueue
+}
+
+// NewFCFS cria a política FCFS
+func NewFCFS() *FCFS {
+	return &FCFS{ready: queue.NewLinkedQueue()}
+}
+
+// Name retorna "FCFS"
+func (p *FCFS) Name() string { return "FCFS" }
+
+// Reset esvazia a fila de prontos
+func (p *FCFS) Reset(state *State) { p.ready.Clear() }
+
+// Arrive coloca o processo no fim da fila
+func (p *FCFS) Arrive(process int) { p.ready.Enqueue(process) }
+
+// Preempt não acontece em FCFS, mas mantém o processo no fim da fila
+func (p *FCFS) Preempt(process int, used int) { p.ready.Enqueue(process) }
+
+// Next retira o primeiro da fila e o deixa rodar até o fim
+func (p *FCFS) Next() (int, int, bool) {
+	process, err := p.ready.Dequeue()
+	return process, 0, err == nil
+}
+
+// ShouldPreempt é sempre false
+func (p *FCFS) ShouldPreempt(running int) bool { return false }
+
+// ============================================================================
+// ROUND ROBIN
+// ============================================================================
+
+// RoundRobin dá a cada processo uma fatia (quantum) de CPU e o devolve ao
+// fim da fila de prontos se não terminou
+// Convenção: quem chega no mesmo instante em que um quantum expira entra na
+// fila antes do processo preemptado
+type RoundRobin struct {
+	Quantum int
+	ready   *queue.ArrayQueue
+}
+
+// DefaultQuantum é o quantum usado quando o informado não é positivo
+const DefaultQuantum = 4
+
+// NewRoundRobin cria a política Round Robin com o quantum informado
+func NewRoundRobin(quantum int) *RoundRobin {
+	if quantum <= 0 {
+		quantum = DefaultQuantum
+	}
+	return &RoundRobin{Quantum: quantum, ready: queue.NewArrayQueue(16)}
+}
+
+// Name retorna "RR(q=...)"
+func (p *RoundRobin) Name() string { return fmt.Sprintf("RR(q=%d)", p.Quantum) }
+
+// Reset esvazia a fila de prontos
+func (p *RoundRobin) Reset(state *State) { p.ready.Clear() }
+
+// Arrive coloca o processo no fim da fila
+func (p *RoundRobin) Arrive(process int) { p.ready.Enqueue(process) }
+
+// Preempt devolve o processo ao fim da fila
+func (p *RoundRobin) Preempt(process int, used int) { p.ready.Enqueue(process) }
+
+// Next retira o primeiro da fila com uma fatia de Quantum
+func (p *RoundRobin) Next() (int, int, bool) {
+	process, err := p.ready.Dequeue()
+	return process, p.Quantum, err == nil
+}
+
+// ShouldPreempt é sempre false: a preempção vem do fim do quantum
+func (p *RoundRobin) ShouldPreempt(running int) bool { return false }
+
+// ============================================================================
+// SJF / SRTF - MENOR TRABALHO PRIMEIRO
+// ============================================================================
+
+// ShortestJob escolhe o processo com menor tempo restante usando uma
+// PriorityQueue de índices
+// Sem preempção é o SJF; com preempção é o SRTF (Shortest Remaining Time
+// First), que interrompe o processo atual quando chega alguém mais curto
+// Empates: chegada mais antiga, depois ordem na carga
+type ShortestJob struct {
+	Preemptive bool
+	state      *State
+	ready      *queue.PriorityQueue
+}
+
+// NewSJF cria a política SJF (não preemptiva)
+func NewSJF() *ShortestJob {
+	return &ShortestJob{}
+}
+
+// NewSRTF cria a política SRTF (preemptiva)
+func NewSRTF() *ShortestJob {
+	return &ShortestJob{Preemptive: true}
+}
+
+// Name retorna "SJF" ou "SRTF"
+func (p *ShortestJob) Name() string {
+	if p.Preemptive {
+		return "SRTF"
+	}
+	return "SJF"
+}
+
+// Reset cria o heap ordenado pelo tempo restante
+// O restante só muda para o processo em execução, que está fora do heap,
+// então a propriedade de heap nunca é violada
+func (p *ShortestJob) Reset(state *State) {
+	p.state = state
+	p.ready = queue.NewPriorityQueue(len(state.Processes), p.before)
+}
+
+// before compara dois processos pelo tempo restante
+func (p *ShortestJob) before(a, b int) bool {
+	if p.state.Remaining[a] != p.state.Remaining[b] {
+		return p.state.Remaining[a] < p.state.Remaining[b]
+	}
+	if p.state.Processes[a].Arrival != p.state.Processes[b].Arrival {
+		return p.state.Processes[a].Arrival < p.state.Processes[b].Arrival
+	}
+	return a < b
+}
+
+// Arrive insere o processo no heap
+// Complexidade: O(log n)
+func (p *ShortestJob) Arrive(process int) { p.ready.Enqueue(process) }
+
+// Preempt devolve o processo ao heap com o tempo restante atualizado
+func (p *ShortestJob) Preempt(process int, used int) { p.ready.Enqueue(process) }
+
+// Next retira o processo mais curto
+// Complexidade: O(log n)
+func (p *ShortestJob) Next() (int, int, bool) {
+	process, err := p.ready.Dequeue()
+	return process, 0, err == nil
+}
+
+// ShouldPreempt (SRTF) compara o mais curto da fila com o que está rodando
+func (p *ShortestJob) ShouldPreempt(running int) bool {
+	if !p.Preemptive {
+		return false
+	}
+	shortest, err := p.ready.Front()
+	return err == nil && p.before(shortest, running)
+}
+
+// ============================================================================
+// PRIORIDADE
+// ============================================================================
+
+// PriorityPolicy escolhe o processo com menor valor de Priority
+// Com Preemptive, uma chegada mais prioritária interrompe o processo atual
+// Empates: chegada mais antiga, depois ordem na carga
+type PriorityPolicy struct {
+	Preemptive bool
+	state      *State
+	ready      *queue.PriorityQueue
+}
+
+// NewPriority cria a política de prioridade
+func NewPriority(preemptive bool) *PriorityPolicy {
+	return &PriorityPolicy{Preemptive: preemptive}
+}
+
+// Name retorna "Prioridade" ou "Prioridade(preemptiva)"
+func (p *PriorityPolicy) Name() string {
+	if p.Preemptive {
+		return "Prioridade(preemptiva)"
+	}
+	return "Prioridade"
+}
+
+// Reset cria o heap ordenado pela prioridade
+func (p *PriorityPolicy) Reset(state *State) {
+	p.state = state
+	p.ready = queue.NewPriorityQueue(len(state.Processes), p.before)
+}
+
+// before compara dois processos pela prioridade
+func (p *PriorityPolicy) before(a, b int) bool {
+	pa, pb := p.state.Processes[a], p.state.Processes[b]
+	if pa.Priority != pb.Priority {
+		return pa.Priority < pb.Priority
+	}
+	if pa.Arrival != pb.Arrival {
+		return pa.Arrival < pb.Arrival
+	}
+	return a < b
+}
+
+// Arrive insere o processo no heap
+func (p *PriorityPolicy) Arrive(process int) { p.ready.Enqueue(process) }
+
+// Preempt devolve o processo ao heap
+func (p *PriorityPolicy) Preempt(process int, used int) { p.ready.Enqueue(process) }
+
+// Next retira o processo mais prioritário
+func (p *PriorityPolicy) Next() (int, int, bool) {
+	process, err := p.ready.Dequeue()
+	return process, 0, err == nil
+}
+
+// ShouldPreempt interrompe quando há alguém estritamente mais prioritário
+func (p *PriorityPolicy) ShouldPreempt(running int) bool {
+	if !p.Preemptive {
+		return false
+	}
+	first, err := p.ready.Front()
+	return err == nil && p.state.Processes[first].Priority < p.state.Processes[running].Priority
+}
+
+// ============================================================================
+// MLFQ - FILAS MULTINÍVEL COM REALIMENTAÇÃO
+// ============================================================================
+
+// MLFQ mantém uma LinkedQueue por nível; o nível 0 é o mais prioritário
+// Regras:
+// 1. Processos novos entram no nível 0
+// 2. Sempre roda o primeiro da fila não vazia de menor nível
+// 3. Quem usa o quantum inteiro desce um nível (é "CPU-bound")
+// 4. Quem é interrompido antes do fim do quantum volta ao fim do mesmo nível
+// 5. Chegada em nível mais alto interrompe o processo atual
+// 6. A cada BoostInterval unidades de tempo todos voltam ao nível 0 (evita inanição)
+type MLFQ struct {
+	Quanta        []int // Quantum de cada nível; 0 no último nível = até terminar
+	BoostInterval int   // 0 desliga o boost
+	state         *State
+	levels        []*queue.LinkedQueue
+	level         []int // Nível atual de cada processo
+	lastBoost     int
+}
+
+// NewMLFQ cria a política com um nível por quantum
+// Sem quanta usa três níveis (4, 8, até terminar); quanta não positivos nos
+// níveis intermediários viram DefaultQuantum e no último nível viram 0
+func NewMLFQ(quanta []int, boostInterval int) *MLFQ {
+	if len(quanta) == 0 {
+		quanta = []int{DefaultQuantum, 2 * DefaultQuantum, 0}
+	}
+	quanta = append([]int(nil), quanta...)
+	for i, quantum := range quanta {
+		if quantum <= 0 {
+			quanta[i] = DefaultQuantum
+			if i == len(quanta)-1 {
+				quanta[i] = 0
+			}
+		}
+	}
+	return &MLFQ{Quanta: quanta, BoostInterval: max(boostInterval, 0)}
+}
+
+// Name retorna "MLFQ(q=...)"
+func (p *MLFQ) Name() string {
+	quanta := make([]string, len(p.Quanta))
+	for i, quantum := range p.Quanta {
+		quanta[i] = fmt.Sprint(quantum)
+		if quantum == 0 {
+			quanta[i] = "∞"
+		}
+	}
+	name := "MLFQ(q=" + strings.Join(quanta, "/")
+	if p.BoostInterval > 0 {
+		name += fmt.Sprintf(", boost=%d", p.BoostInterval)
+	}
+	return name + ")"
+}
+
+// Reset cria as filas de cada nível
+func (p *MLFQ) Reset(state *State) {
+	p.state = state
+	p.levels = make([]*queue.LinkedQueue, len(p.Quanta))
+	for i := range p.levels {
+		p.levels[i] = queue.NewLinkedQueue()
+	}
+	p.level = make([]int, len(state.Processes))
+	p.lastBoost = 0
+}
+
+// Level retorna o nível atual de um processo
+func (p *MLFQ) Level(process int) int { return p.level[process] }
+
+// Arrive coloca o processo no nível 0
+func (p *MLFQ) Arrive(process int) {
+	p.level[process] = 0
+	p.levels[0].Enqueue(process)
+}
+
+// Preempt rebaixa o processo se ele usou o quantum inteiro
+func (p *MLFQ) Preempt(process int, used int) {
+	level := p.level[process]
+	if quantum := p.Quanta[level]; quantum > 0 && used >= quantum && level < len(p.levels)-1 {
+		level++
+	}
+	p.level[process] = level
+	p.levels[level].Enqueue(process)
+}
+
+// Next aplica o boost, se for a hora, e retira o primeiro do nível mais alto
+// O processo em execução durante o boost mantém o nível até sair da CPU
+// Complexidade: O(k) para k níveis (O(n) no boost)
+func (p *MLFQ) Next() (int, int, bool) {
+	if p.BoostInterval > 0 && p.state.Now-p.lastBoost >= p.BoostInterval {
+		p.boost()
+	}
+	for level, ready := range p.levels {
+		if process, err := ready.Dequeue(); err == nil {
+			return process, p.Quanta[level], true
+		}
+	}
+	return 0, 0, false
+}
+
+// boost move todos os processos para o nível 0, mantendo a ordem por nível
+func (p *MLFQ) boost() {
+	p.lastBoost = p.state.Now - (p.state.Now-p.lastBoost)%p.BoostInterval
+	for _, ready := range p.levels[1:] {
+		for !ready.IsEmpty() {
+			process, _ := ready.Dequeue()
+			p.level[process] = 0
+			p.levels[0].Enqueue(process)
+		}
+	}
+}
+
+// ShouldPreempt interrompe quando há processo pronto em nível mais alto
+func (p *MLFQ) ShouldPreempt(running int) bool {
+	for level := 0; level < p.level[running]; level++ {
+		if !p.levels[level].IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,124 @@
+// Package scheduler simula o escalonamento de processos em uma CPU usando as
+// filas do pacote queue: FCFS, Round Robin, SJF/SRTF, prioridade e filas
+// multinível com realimentação (MLFQ).
+//
+// As filas guardam apenas int, então as políticas enfileiram o índice do
+// processo na carga de trabalho; os dados ficam no slice de Process.
+package scheduler
+
+import (
+	"encoding/csv"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// PROCESSOS E CARGA DE TRABALHO
+// ============================================================================
+
+// Process é um processo da carga de trabalho (tempos em unidades inteiras)
+type Process struct {
+	PID      string // Identificador exibido no diagrama de Gantt
+	Arrival  int    // Instante em que fica pronto
+	Burst    int    // Tempo total de CPU necessário
+	Priority int    // Menor valor = mais prioritário
+}
+
+// ParseWorkload lê uma carga de trabalho em CSV com as colunas
+// pid,arrival,burst[,priority]
+// O cabeçalho é opcional; linhas vazias e iniciadas por '#' são ignoradas
+// Exemplo:
+//
+//	pid,arrival,burst,priority
+//	P1,0,8,2
+//	P2,1,4,1
+func ParseWorkload(r io.Reader) ([]Process, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	
+	processes := []Process{}
+	seen := map[string]int{}
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		
+		if first && isHeader(record) {
+			continue
+		}
+		if len(record) < 3 || len(record) > 4 {
+			return nil, errs.New(nil, "linha %d: esperado pid,arrival,burst[,priority], recebidos %d campos",
+				"line %d: expected pid,arrival,burst[,priority], got %d fields", line, len(record))
+		}
+		
+		process := Process{PID: strings.TrimSpace(record[0])}
+		fields := []*int{&process.Arrival, &process.Burst, &process.Priority}
+		names := []string{"arrival", "burst", "priority"}
+		for i, text := range record[1:] {
+			value, err := strconv.Atoi(strings.TrimSpace(text))
+			if err != nil {
+				return nil, errs.New(nil, "linha %d: %s inválido %q", "line %d: invalid %s %q", line, names[i], text)
+			}
+			*fields[i] = value
+		}
+		if err := validateProcess(process); err != nil {
+			return nil, errs.New(err, "linha %d: %v", "line %d: %v", line, err)
+		}
+		if previous, ok := seen[process.PID]; ok {
+			return nil, errs.New(nil, "linha %d: pid %q repetido (já definido na linha %d)",
+				"line %d: duplicate pid %q (already defined on line %d)", line, process.PID, previous)
+		}
+		seen[process.PID] = line
+		processes = append(processes, process)
+	}
+	return processes, nil
+}
+
+// LoadWorkload lê a carga de trabalho de um arquivo CSV
+func LoadWorkload(path string) ([]Process, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	
+	processes, err := ParseWorkload(file)
+	if err != nil {
+		return nil, errs.New(err, "%s: %v", "%s: %v", path, err)
+	}
+	return processes, nil
+}
+
+// isHeader reconhece a primeira linha como cabeçalho quando arrival não é número
+func isHeader(record []string) bool {
+	if len(record) < 2 {
+		return false
+	}
+	_, err := strconv.Atoi(strings.TrimSpace(record[1]))
+	return err != nil
+}
+
+// validateProcess rejeita processos que o simulador não consegue executar
+func validateProcess(p Process) error {
+	if p.PID == "" {
+		return errs.New(nil, "pid vazio", "empty pid")
+	}
+	if p.Arrival < 0 {
+		return errs.New(nil, "processo %s: chegada negativa (%d)", "process %s: negative arrival (%d)", p.PID, p.Arrival)
+	}
+	if p.Burst <= 0 {
+		return errs.New(nil, "processo %s: burst deve ser positivo (%d)", "process %s: burst must be positive (%d)", p.PID, p.Burst)
+	}
+	return nil
+}
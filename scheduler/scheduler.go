@@ -0,0 +1,280 @@
+package scheduler
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// SIMULADOR
+// ============================================================================
+
+// Idle é o índice usado no diagrama de Gantt para a CPU ociosa
+const Idle = -1
+
+// Slice é um trecho contínuo do diagrama de Gantt
+type Slice struct {
+	Process int // Índice do processo ou Idle
+	Start   int
+	End     int
+}
+
+// ProcessStats são as medidas de um processo depois da simulação
+type ProcessStats struct {
+	Process
+	Start      int // Primeira vez que recebeu a CPU
+	Completion int // Instante em que terminou
+	Turnaround int // Completion - Arrival
+	Waiting    int // Turnaround - Burst: tempo total na fila de prontos
+	Response   int // Start - Arrival
+}
+
+// Result reúne a linha do tempo e as medidas da simulação
+type Result struct {
+	Policy          string
+	Timeline        []Slice        // Trechos em ordem de tempo (inclui ociosidade)
+	Stats           []ProcessStats // Na ordem da carga de trabalho
+	ContextSwitches int            // Trocas entre processos diferentes
+	Makespan        int            // Instante em que o último processo termina
+}
+
+// Run simula a política sobre a carga de trabalho
+// Complexidade: O((n + p) · custo da política) para n processos e p preempções
+// Pseudocódigo:
+// 1. Ordenar as chegadas por instante (empate: ordem na carga)
+// 2. Repetir até todos terminarem:
+//   - Entregar à política os processos que já chegaram
+//   - Sem processo na CPU: pedir o próximo; se não houver, a CPU fica ociosa
+//     até a próxima chegada
+//   - Executar até o primeiro destes eventos: término, fim do quantum ou
+//     próxima chegada
+//   - Terminou: registrar; fim do quantum ou política pediu preempção:
+//     devolver o processo à política
+func Run(processes []Process, policy Policy) (*Result, error) {
+	for _, process := range processes {
+		if err := validateProcess(process); err != nil {
+			return nil, err
+		}
+	}
+	
+	n := len(processes)
+	state := &State{Processes: processes, Remaining: make([]int, n)}
+	for i, process := range processes {
+		state.Remaining[i] = process.Burst
+	}
+	policy.Reset(state)
+	
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return processes[order[a]].Arrival < processes[order[b]].Arrival
+	})
+	
+	result := &Result{Policy: policy.Name(), Stats: make([]ProcessStats, n)}
+	started := make([]bool, n)
+	nextArrival := 0
+	admit := func() {
+		for nextArrival < n && processes[order[nextArrival]].Arrival <= state.Now {
+			policy.Arrive(order[nextArrival])
+			nextArrival++
+		}
+	}
+	
+	running, dispatched, sliceEnd, last := Idle, 0, 0, Idle
+	for finished := 0; finished < n; {
+		admit()
+		
+		if running == Idle {
+			process, quantum, ok := policy.Next()
+			if !ok {
+				// Ninguém pronto: a CPU fica ociosa até a próxima chegada
+				if nextArrival == n {
+					return nil, errs.New(nil, "política %s não devolveu nenhum processo pronto", "policy %s returned no ready process", policy.Name())
+				}
+				result.record(Idle, state.Now, processes[order[nextArrival]].Arrival)
+				state.Now = processes[order[nextArrival]].Arrival
+				continue
+			}
+			if last != Idle && last != process {
+				result.ContextSwitches++
+			}
+			if !started[process] {
+				started[process] = true
+				result.Stats[process].Start = state.Now
+			}
+			running, last, dispatched = process, process, state.Now
+			sliceEnd = math.MaxInt
+			if quantum > 0 {
+				sliceEnd = state.Now + quantum
+			}
+		}
+		
+		end := min(state.Now+state.Remaining[running], sliceEnd)
+		if nextArrival < n {
+			end = min(end, processes[order[nextArrival]].Arrival)
+		}
+		result.record(running, state.Now, end)
+		state.Remaining[running] -= end - state.Now
+		state.Now = end
+		
+		if state.Remaining[running] == 0 {
+			result.Stats[running].Completion = state.Now
+			finished++
+			running = Idle
+			continue
+		}
+		
+		admit()
+		if state.Now == sliceEnd || policy.ShouldPreempt(running) {
+			policy.Preempt(running, state.Now-dispatched)
+			running = Idle
+		}
+	}
+	
+	for i, process := range processes {
+		stats := &result.Stats[i]
+		stats.Process = process
+		stats.Turnaround = stats.Completion - process.Arrival
+		stats.Waiting = stats.Turnaround - process.Burst
+		stats.Response = stats.Start - process.Arrival
+		result.Makespan = max(result.Makespan, stats.Completion)
+	}
+	return result, nil
+}
+
+// record acrescenta um trecho ao diagrama, emendando com o anterior se for
+// do mesmo processo e contíguo
+func (r *Result) record(process, start, end int) {
+	if end <= start {
+		return
+	}
+	if last := len(r.Timeline) - 1; last >= 0 && r.Timeline[last].Process == process && r.Timeline[last].End == start {
+		r.Timeline[last].End = end
+		return
+	}
+	r.Timeline = append(r.Timeline, Slice{Process: process, Start: start, End: end})
+}
+
+// ============================================================================
+// MÉTRICAS
+// ============================================================================
+
+// average aplica field a cada processo e retorna a média
+func (r *Result) average(field func(ProcessStats) int) float64 {
+	if len(r.Stats) == 0 {
+		return 0
+	}
+	total := 0
+	for _, stats := range r.Stats {
+		total += field(stats)
+	}
+	return float64(total) / float64(len(r.Stats))
+}
+
+// AverageTurnaround retorna o tempo médio de retorno (chegada até término)
+func (r *Result) AverageTurnaround() float64 {
+	return r.average(func(s ProcessStats) int { return s.Turnaround })
+}
+
+// AverageWaiting retorna o tempo médio na fila de prontos
+func (r *Result) AverageWaiting() float64 {
+	return r.average(func(s ProcessStats) int { return s.Waiting })
+}
+
+// AverageResponse retorna o tempo médio até a primeira execução
+func (r *Result) AverageResponse() float64 {
+	return r.average(func(s ProcessStats) int { return s.Response })
+}
+
+// Utilization retorna a fração do tempo (até o makespan) em que a CPU trabalhou
+func (r *Result) Utilization() float64 {
+	if r.Makespan == 0 {
+		return 0
+	}
+	busy := 0
+	for _, slice := range r.Timeline {
+		if slice.Process != Idle {
+			busy += slice.End - slice.Start
+		}
+	}
+	return float64(busy) / float64(r.Makespan)
+}
+
+// Throughput retorna processos concluídos por unidade de tempo
+func (r *Result) Throughput() float64 {
+	if r.Makespan == 0 {
+		return 0
+	}
+	return float64(len(r.Stats)) / float64(r.Makespan)
+}
+
+// ============================================================================
+// SAÍDA EM TEXTO
+// ============================================================================
+
+// label retorna o rótulo de um trecho do diagrama
+func (r *Result) label(process int) string {
+	if process == Idle {
+		return "-"
+	}
+	return r.Stats[process].PID
+}
+
+// Gantt desenha o diagrama de Gantt em texto com unitWidth colunas por
+// unidade de tempo (cada trecho tem pelo menos a largura do rótulo)
+// Exemplo:
+//
+//	|  P1  | P2 |   P3   |
+//	0      3    5        9
+func (r *Result) Gantt(unitWidth int) string {
+	if len(r.Timeline) == 0 {
+		return ""
+	}
+	unitWidth = max(unitWidth, 1)
+	
+	var bar, marks strings.Builder
+	bar.WriteString("|")
+	marks.WriteString(fmt.Sprint(r.Timeline[0].Start))
+	column := 0 // Coluna da última barra vertical
+	for _, slice := range r.Timeline {
+		label := r.label(slice.Process)
+		width := max((slice.End-slice.Start)*unitWidth, len([]rune(label))+2)
+		left := (width - len([]rune(label))) / 2
+		bar.WriteString(strings.Repeat(" ", left) + label + strings.Repeat(" ", width-left-len([]rune(label))) + "|")
+		column += width + 1
+		
+		// Marca de tempo sob a barra, se couber depois da marca anterior
+		mark := fmt.Sprint(slice.End)
+		if gap := column - len([]rune(marks.String())); gap >= 1 {
+			marks.WriteString(strings.Repeat(" ", gap) + mark)
+		}
+	}
+	return bar.String() + "\n" + marks.String() + "\n"
+}
+
+// Table formata as medidas de cada processo e as médias
+func (r *Result) Table() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-8s %8s %6s %10s %8s %10s %8s %8s\n",
+		"pid", "chegada", "burst", "prioridade", "término", "retorno", "espera", "resposta"))
+	for _, s := range r.Stats {
+		builder.WriteString(fmt.Sprintf("%-8s %8d %6d %10d %8d %10d %8d %8d\n",
+			s.PID, s.Arrival, s.Burst, s.Priority, s.Completion, s.Turnaround, s.Waiting, s.Response))
+	}
+	builder.WriteString(fmt.Sprintf("%-46s %10.2f %8.2f %8.2f\n",
+		"média", r.AverageTurnaround(), r.AverageWaiting(), r.AverageResponse()))
+	builder.WriteString(fmt.Sprintf("utilização %.1f%%, vazão %.3f processos/unidade, %d trocas de contexto\n",
+		100*r.Utilization(), r.Throughput(), r.ContextSwitches))
+	return builder.String()
+}
+
+// String junta o nome da política, o diagrama e a tabela
+func (r *Result) String() string {
+	return fmt.Sprintf("=== %s ===\n%s\n%s", r.Policy, r.Gantt(1), r.Table())
+}
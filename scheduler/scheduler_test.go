@@ -0,0 +1,165 @@
+package scheduler_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/scheduler"
+)
+
+// timeline resume o diagrama como "P1 0-4, P2 4-8, ..."
+func timeline(r *scheduler.Result) string {
+	parts := []string{}
+	for _, slice := range r.Timeline {
+		label := "-"
+		if slice.Process != scheduler.Idle {
+			label = r.Stats[slice.Process].PID
+		}
+		parts = append(parts, fmt.Sprintf("%s %d-%d", label, slice.Start, slice.End))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func TestPoliciesTextbookWorkload(t *testing.T) {
+	workload, err := scheduler.LoadWorkload("testdata/carga.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		policy   scheduler.Policy
+		timeline string
+		waiting  float64
+		response float64
+	}{
+		{scheduler.NewFCFS(), "P1 0-8, P2 8-12, P3 12-21, P4 21-26", 8.75, 8.75},
+		{scheduler.NewRoundRobin(4), "P1 0-4, P2 4-8, P3 8-12, P4 12-16, P1 16-20, P3 20-24, P4 24-25, P3 25-26", 11.75, 4.5},
+		{scheduler.NewSJF(), "P1 0-8, P2 8-12, P4 12-17, P3 17-26", 7.75, 7.75},
+		{scheduler.NewSRTF(), "P1 0-1, P2 1-5, P4 5-10, P1 10-17, P3 17-26", 6.5, 4.25},
+		{scheduler.NewPriority(false), "P1 0-8, P2 8-12, P4 12-17, P3 17-26", 7.75, 7.75},
+		{scheduler.NewMLFQ([]int{4, 8, 0}, 0), "P1 0-4, P2 4-8, P3 8-12, P4 12-16, P1 16-20, P3 20-25, P4 25-26", 11.75, 4.5},
+	}
+	for _, tc := range cases {
+		result, err := scheduler.Run(workload, tc.policy)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.policy.Name(), err)
+		}
+		if got := timeline(result); got != tc.timeline {
+			t.Errorf("%s: diagrama %q, esperado %q", result.Policy, got, tc.timeline)
+		}
+		if result.AverageWaiting() != tc.waiting || result.AverageResponse() != tc.response {
+			t.Errorf("%s: espera %.2f e resposta %.2f, esperado %.2f e %.2f",
+				result.Policy, result.AverageWaiting(), result.AverageResponse(), tc.waiting, tc.response)
+		}
+		for _, s := range result.Stats {
+			if s.Turnaround != s.Waiting+s.Burst || s.Response > s.Waiting || s.Completion > result.Makespan {
+				t.Errorf("%s: medidas inconsistentes para %s: %+v", result.Policy, s.PID, s)
+			}
+		}
+	}
+}
+
+func TestIdleGapsAndPreemptivePriority(t *testing.T) {
+	workload := []scheduler.Process{
+		{PID: "A", Arrival: 2, Burst: 3, Priority: 2},
+		{PID: "B", Arrival: 3, Burst: 2, Priority: 1},
+		{PID: "C", Arrival: 10, Burst: 1, Priority: 5},
+	}
+	result, err := scheduler.Run(workload, scheduler.NewPriority(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := timeline(result), "- 0-2, A 2-3, B 3-5, A 5-7, - 7-10, C 10-11"; got != want {
+		t.Errorf("diagrama %q, esperado %q", got, want)
+	}
+	if result.Makespan != 11 || result.Utilization() != 6.0/11 {
+		t.Errorf("makespan %d, utilização %g", result.Makespan, result.Utilization())
+	}
+	if got, want := result.Gantt(2), "| -  | A | B  | A  |  -   | C |\n0    2   3    5    7      10  11\n"; got != want {
+		t.Errorf("Gantt:\n%s\nesperado:\n%s", got, want)
+	}
+}
+
+func TestMLFQDemotionAndBoost(t *testing.T) {
+	// Um processo longo e uma sequência de curtos que cabem no quantum
+	workload := []scheduler.Process{{PID: "L", Arrival: 0, Burst: 20}}
+	for i := 0; i < 6; i++ {
+		workload = append(workload, scheduler.Process{PID: fmt.Sprint("S", i), Arrival: 2 + 2*i, Burst: 2})
+	}
+	// resumed retorna quando o processo longo volta à CPU depois de rebaixado
+	resumed := func(r *scheduler.Result) int {
+		for _, slice := range r.Timeline[1:] {
+			if slice.Process == 0 {
+				return slice.Start
+			}
+		}
+		return -1
+	}
+	
+	mlfq := scheduler.NewMLFQ([]int{2, 4}, 0)
+	result, err := scheduler.Run(workload, mlfq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mlfq.Level(0) != 1 {
+		t.Errorf("processo longo deveria terminar no último nível, está em %d", mlfq.Level(0))
+	}
+	if got := resumed(result); got != 14 {
+		t.Errorf("sem boost o processo longo só volta quando os curtos acabam (14), voltou em %d", got)
+	}
+	
+	boosted, err := scheduler.Run(workload, scheduler.NewMLFQ([]int{2, 4}, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := resumed(boosted); got >= 14 {
+		t.Errorf("boost deveria devolver a CPU ao processo longo antes de 14, voltou em %d", got)
+	}
+}
+
+func TestParseWorkloadErrors(t *testing.T) {
+	cases := []struct {
+		input    string
+		fragment string
+	}{
+		{"P1,0,x\n", `linha 1: burst inválido "x"`},
+		{"pid,arrival,burst\nP1,0,3\nP1,2,3\n", "linha 3: pid \"P1\" repetido"},
+		{"P1,0\n", "linha 1: esperado pid,arrival,burst"},
+		{"P1,-1,3\n", "chegada negativa"},
+		{"# comentário\nP1,0,0\n", "linha 2: processo P1: burst deve ser positivo"},
+	}
+	for _, tc := range cases {
+		_, err := scheduler.ParseWorkload(strings.NewReader(tc.input))
+		if err == nil || !strings.Contains(err.Error(), tc.fragment) {
+			t.Errorf("ParseWorkload(%q) = %v, esperado erro com %q", tc.input, err, tc.fragment)
+		}
+	}
+	
+	workload, err := scheduler.ParseWorkload(strings.NewReader("A, 0, 5\n\nB, 1, 2, 7\n"))
+	if err != nil || len(workload) != 2 || workload[1] != (scheduler.Process{PID: "B", Arrival: 1, Burst: 2, Priority: 7}) {
+		t.Errorf("ParseWorkload sem cabeçalho = %v, %v", workload, err)
+	}
+}
+
+func TestParseWorkloadErrorsInEnglish(t *testing.T) {
+	previous := errs.CurrentLanguage()
+	errs.SetLanguage(errs.English)
+	defer errs.SetLanguage(previous)
+	
+	cases := []struct {
+		input string
+		want  string
+	}{
+		{"P1,0,x\n", `line 1: invalid burst "x"`},
+		{"pid,arrival,burst\nP1,0,3\nP1,2,3\n", `line 3: duplicate pid "P1" (already defined on line 2)`},
+		{"P1,0\n", "line 1: expected pid,arrival,burst[,priority], got 2 fields"},
+		{"# comentário\nP1,0,0\n", "line 2: process P1: burst must be positive (0)"},
+		{" ,0,3\n", "line 1: empty pid"},
+	}
+	for _, tc := range cases {
+		if _, err := scheduler.ParseWorkload(strings.NewReader(tc.input)); err == nil || err.Error() != tc.want {
+			t.Errorf("ParseWorkload(%q) = %v, esperado %q", tc.input, err, tc.want)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+// Package serial implementa o formato binário e a codificação JSON usados
+// por MarshalBinary/UnmarshalBinary e MarshalJSON/UnmarshalJSON das listas,
+// pilhas, filas e deques.
+//
+// Convenção de ordem: os elementos são gravados na ordem lógica da
+// estrutura, a mesma de ToSlice. Listas, filas e deques começam pelo início
+// (Get(0), Front); pilhas começam pelo topo (Peek), ou seja, na ordem em que
+// os elementos sairiam. Assim um ArrayStack gravado pode ser lido por uma
+// LinkedStack e uma ArrayQueue por uma LinkedQueue; só o conteúdo é gravado,
+// não a capacidade nem os contadores.
+//
+// Formato binário (versão 1):
+//
+//	"DCA"      3 bytes  assinatura
+//	versão     1 byte   Version
+//	estrutura  1 byte   Kind ('L' lista, 'S' pilha, 'Q' fila, 'D' deque)
+//	elemento   1 byte   'i' inteiro, 'u' sem sinal, 'f' real, 's' string, 'b' bool
+//	n          uvarint  número de elementos
+//	elementos  n vezes  varint zigzag, uvarint, real em uvarint com os bytes
+//	                    invertidos, string como uvarint(tamanho) + bytes, bool em 1 byte
+//	CRC-32     4 bytes  IEEE, big-endian, de tudo que vem antes
+//
+// Como encoding/gob usa BinaryMarshaler quando o tipo não tem GobEncoder,
+// as estruturas também podem ser gravadas com gob.
+package serial
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"math/bits"
+	"reflect"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// FORMATO
+// ============================================================================
+
+// Version é a versão do formato binário gravada no cabeçalho
+const Version = 1
+
+// magic identifica os dados como gerados por este pacote
+const magic = "DCA"
+
+// headerSize é o cabeçalho fixo (assinatura, versão, estrutura, elemento) e
+// checksumSize o CRC-32 no final
+const (
+	headerSize   = len(magic) + 3
+	checksumSize = 4
+)
+
+// Kind identifica a família da estrutura gravada
+// Implementações da mesma família (ArrayQueue e LinkedQueue, por exemplo)
+// leem os dados umas das outras
+type Kind byte
+
+const (
+	List  Kind = 'L'
+	Stack Kind = 'S'
+	Queue Kind = 'Q'
+	Deque Kind = 'D'
+)
+
+// String retorna o nome da família no idioma das mensagens de erro
+// (ver errs.SetLanguage)
+func (k Kind) String() string {
+	switch k {
+	case List:
+		return errs.Message("lista", "list")
+	case Stack:
+		return errs.Message("pilha", "stack")
+	case Queue:
+		return errs.Message("fila", "queue")
+	case Deque:
+		return "deque"
+	}
+	return fmt.Sprintf(errs.Message("estrutura desconhecida (%q)", "unknown structure (%q)"), byte(k))
+}
+
+// Erros de decodificação; as mensagens completas dizem o que estava errado
+// e onde. Use errors.Is para distinguir os casos
+var (
+	ErrCorrupt            = errs.Define("dados corrompidos", "corrupted data")
+	ErrUnsupportedVersion = errs.Define("versão do formato não suportada", "unsupported format version")
+	ErrMismatch           = errs.Define("dados de outro tipo", "data of another type")
+)
+
+// ============================================================================
+// CODIFICAÇÃO BINÁRIA
+// ============================================================================
+
+// Marshal grava os elementos (já na ordem lógica) no formato binário
+// Os elementos podem ser de qualquer tipo cujo tipo básico seja inteiro,
+// inteiro sem sinal, real, string ou bool
+func Marshal[T any](kind Kind, values []T) ([]byte, error) {
+	tag, err := elementTag(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 0, headerSize+binary.MaxVarintLen64+len(values)*2+checksumSize)
+	buf = append(buf, magic...)
+	buf = append(buf, Version, byte(kind), tag)
+	buf = binary.AppendUvarint(buf, uint64(len(values)))
+	for _, value := range values {
+		buf = appendElement(buf, tag, reflect.ValueOf(value))
+	}
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(buf)), nil
+}
+
+// Unmarshal lê dados gravados por Marshal, conferindo assinatura, versão,
+// checksum, família da estrutura e tipo dos elementos
+func Unmarshal[T any](kind Kind, data []byte) ([]T, error) {
+	elementType := reflect.TypeFor[T]()
+	tag, err := elementTag(elementType)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < headerSize+1+checksumSize {
+		return nil, errs.New(ErrCorrupt, "%v: %d bytes, o mínimo é %d", "%v: %d bytes, the minimum is %d", ErrCorrupt, len(data), headerSize+1+checksumSize)
+	}
+	if string(data[:len(magic)]) != magic {
+		return nil, errs.New(ErrCorrupt, "%v: assinatura %q, esperado %q", "%v: signature %q, expected %q", ErrCorrupt, data[:len(magic)], magic)
+	}
+	body := data[:len(data)-checksumSize]
+	stored := binary.BigEndian.Uint32(data[len(body):])
+	if computed := crc32.ChecksumIEEE(body); computed != stored {
+		return nil, errs.New(ErrCorrupt, "%v: checksum %08x, os dados dão %08x", "%v: checksum %08x, the data gives %08x", ErrCorrupt, stored, computed)
+	}
+	if version := data[len(magic)]; version != Version {
+		return nil, errs.New(ErrUnsupportedVersion, "%v: versão %d, este programa lê a versão %d", "%v: version %d, this program reads version %d",
+			ErrUnsupportedVersion, version, Version)
+	}
+	if got := Kind(data[len(magic)+1]); got != kind {
+		return nil, errs.New(ErrMismatch, "%v: gravado por uma %v, lido como %v", "%v: written by a %v, read as %v", ErrMismatch, got, kind)
+	}
+	if got := data[len(magic)+2]; got != tag {
+		return nil, errs.New(ErrMismatch, "%v: elementos %v, esperado %v (%v)", "%v: elements are %v, expected %v (%v)", ErrMismatch, tagName(got), tagName(tag), elementType)
+	}
+	
+	r := reader{data: body, offset: headerSize}
+	n, err := r.uvarint("número de elementos", "element count")
+	if err != nil {
+		return nil, err
+	}
+	// Cada elemento ocupa pelo menos 1 byte: evita alocar um n absurdo
+	if n > uint64(len(body)-r.offset) {
+		return nil, errs.New(ErrCorrupt, "%v: %d elementos anunciados, mas só restam %d bytes", "%v: %d elements announced, but only %d bytes remain",
+			ErrCorrupt, n, len(body)-r.offset)
+	}
+	values := make([]T, n)
+	for i := range values {
+		element := reflect.ValueOf(&values[i]).Elem()
+		if err := r.element(tag, element); err != nil {
+			return nil, errs.New(err, "elemento %d: %v", "element %d: %v", i, err)
+		}
+	}
+	if r.offset != len(body) {
+		return nil, errs.New(ErrCorrupt, "%v: %d bytes sobrando depois do último elemento", "%v: %d bytes left after the last element", ErrCorrupt, len(body)-r.offset)
+	}
+	return values, nil
+}
+
+// elementTag retorna o código do tipo de elemento
+func elementTag(t reflect.Type) (byte, error) {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return 'i', nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return 'u', nil
+	case reflect.Float32, reflect.Float64:
+		return 'f', nil
+	case reflect.String:
+		return 's', nil
+	case reflect.Bool:
+		return 'b', nil
+	}
+	return 0, errs.New(nil, "tipo de elemento %v não suportado no formato binário (use inteiros, reais, strings ou bool; ou JSON)",
+		"element type %v not supported by the binary format (use integers, floats, strings or bool; or JSON)", t)
+}
+
+// tagName descreve o código do tipo de elemento nas mensagens de erro
+// É um tipo (e não uma função) para o idioma ser escolhido só quando a
+// mensagem é formatada, como nos erros de errs.New
+type tagName byte
+
+func (tag tagName) String() string {
+	switch tag {
+	case 'i':
+		return errs.Message("inteiros", "integers")
+	case 'u':
+		return errs.Message("inteiros sem sinal", "unsigned integers")
+	case 'f':
+		return errs.Message("reais", "floats")
+	case 's':
+		return "strings"
+	case 'b':
+		return "bool"
+	}
+	return fmt.Sprintf(errs.Message("de tipo desconhecido (%q)", "of unknown type (%q)"), byte(tag))
+}
+
+// appendElement grava um elemento
+// Reais vão com os bytes invertidos: valores "redondos" como 2.0 ou 0.5 têm
+// os bytes baixos zerados, e invertidos viram varints curtos (truque do gob)
+func appendElement(buf []byte, tag byte, v reflect.Value) []byte {
+	switch tag {
+	case 'i':
+		return binary.AppendVarint(buf, v.Int())
+	case 'u':
+		return binary.AppendUvarint(buf, v.Uint())
+	case 'f':
+		return binary.AppendUvarint(buf, bits.ReverseBytes64(math.Float64bits(v.Float())))
+	case 's':
+		buf = binary.AppendUvarint(buf, uint64(v.Len()))
+		return append(buf, v.String()...)
+	default: // 'b'
+		if v.Bool() {
+			return append(buf, 1)
+		}
+		return append(buf, 0)
+	}
+}
+
+// reader percorre os dados acompanhando a posição, para erros com o offset
+type reader struct {
+	data   []byte
+	offset int
+}
+
+// uvarint lê um varint sem sinal; what e whatEN dizem o que ele é, nos
+// dois idiomas, para a mensagem de erro
+func (r *reader) uvarint(what, whatEN string) (uint64, error) {
+	value, n := binary.Uvarint(r.data[r.offset:])
+	if n <= 0 {
+		return 0, errs.New(ErrCorrupt, "%[1]v: %[2]s com varint inválido no byte %[4]d", "%[1]v: %[3]s with invalid varint at byte %[4]d",
+			ErrCorrupt, what, whatEN, r.offset)
+	}
+	r.offset += n
+	return value, nil
+}
+
+// element lê um elemento para dentro de v, conferindo se cabe no tipo
+func (r *reader) element(tag byte, v reflect.Value) error {
+	switch tag {
+	case 'i':
+		value, n := binary.Varint(r.data[r.offset:])
+		if n <= 0 {
+			return errs.New(ErrCorrupt, "%v: varint inválido no byte %d", "%v: invalid varint at byte %d", ErrCorrupt, r.offset)
+		}
+		r.offset += n
+		if v.OverflowInt(value) {
+			return errs.New(ErrMismatch, "%v: %d não cabe em %v", "%v: %d does not fit in %v", ErrMismatch, value, v.Type())
+		}
+		v.SetInt(value)
+	case 'u':
+		value, err := r.uvarint("valor", "value")
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(value) {
+			return errs.New(ErrMismatch, "%v: %d não cabe em %v", "%v: %d does not fit in %v", ErrMismatch, value, v.Type())
+		}
+		v.SetUint(value)
+	case 'f':
+		value, err := r.uvarint("valor", "value")
+		if err != nil {
+			return err
+		}
+		f := math.Float64frombits(bits.ReverseBytes64(value))
+		if v.OverflowFloat(f) {
+			return errs.New(ErrMismatch, "%v: %g não cabe em %v", "%v: %g does not fit in %v", ErrMismatch, f, v.Type())
+		}
+		v.SetFloat(f)
+	case 's':
+		length, err := r.uvarint("tamanho da string", "string length")
+		if err != nil {
+			return err
+		}
+		if length > uint64(len(r.data)-r.offset) {
+			return errs.New(ErrCorrupt, "%v: string de %d bytes no byte %d, mas só restam %d", "%v: string of %d bytes at byte %d, but only %d remain",
+				ErrCorrupt, length, r.offset, len(r.data)-r.offset)
+		}
+		v.SetString(string(r.data[r.offset : r.offset+int(length)]))
+		r.offset += int(length)
+	default: // 'b'
+		if r.offset >= len(r.data) || r.data[r.offset] > 1 {
+			return errs.New(ErrCorrupt, "%v: bool inválido no byte %d", "%v: invalid bool at byte %d", ErrCorrupt, r.offset)
+		}
+		v.SetBool(r.data[r.offset] == 1)
+		r.offset++
+	}
+	return nil
+}
+
+// ============================================================================
+// JSON
+// ============================================================================
+
+// MarshalJSON grava os elementos como um array JSON na ordem lógica
+// Uma estrutura vazia vira [] (e não null)
+func MarshalJSON[T any](values []T) ([]byte, error) {
+	if values == nil {
+		values = []T{}
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON lê um array JSON de elementos; null é uma estrutura vazia
+func UnmarshalJSON[T any](data []byte) ([]T, error) {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, errs.New(err, "JSON inválido, esperado um array de %v: %v", "invalid JSON, expected an array of %v: %v", reflect.TypeFor[T](), err)
+	}
+	return values, nil
+}
@@ -0,0 +1,280 @@
+package serial_test
+
+import (
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"hash/crc32"
+	"slices"
+	"strings"
+	"testing"
+
+	"dca3503/deque"
+	"dca3503/list"
+	"dca3503/queue"
+	"dca3503/serial"
+	"dca3503/stack"
+)
+
+// binaryValue é o que todas as estruturas implementam
+type binaryValue interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+	ToSlice() []int
+}
+
+func TestRoundTripKeepsLogicalOrder(t *testing.T) {
+	arrayQueue := queue.NewArrayQueue(4)
+	for i := 1; i <= 4; i++ {
+		arrayQueue.Enqueue(i * 10)
+	}
+	arrayQueue.Dequeue()
+	arrayQueue.Enqueue(50) // Dá a volta no buffer: [50 20 30 40]
+	
+	arrayDeque := deque.NewArrayDeque(3)
+	arrayDeque.EnqueueRear(2)
+	arrayDeque.EnqueueFront(1)
+	arrayDeque.EnqueueRear(3)
+	
+	arrayStack := stack.NewArrayStack(2)
+	arrayStack.PushAll([]int{1, 2, 3})
+	linkedStack := stack.NewLinkedStack()
+	linkedStack.PushAll([]int{1, 2, 3})
+	linkedQueue := queue.NewLinkedQueue()
+	linkedQueue.EnqueueAll([]int{-5, 0, 5})
+	linkedListDeque := deque.NewLinkedListDeque()
+	linkedListDeque.EnqueueRear(7)
+	linkedListDeque.EnqueueFront(6)
+	doubly := deque.NewDeque()
+	doubly.EnqueueRear(8)
+	doubly.EnqueueFront(9)
+	
+	tests := []struct {
+		name       string
+		from, into binaryValue
+		want       []int
+	}{
+		{"ArrayStack → LinkedStack", arrayStack, stack.NewLinkedStack(), []int{3, 2, 1}},
+		{"LinkedStack → ArrayStack", linkedStack, new(stack.ArrayStack), []int{3, 2, 1}},
+		{"ArrayQueue → LinkedQueue", arrayQueue, queue.NewLinkedQueue(), []int{20, 30, 40, 50}},
+		{"LinkedQueue → ArrayQueue", linkedQueue, queue.NewArrayQueue(1), []int{-5, 0, 5}},
+		{"ArrayQueue → BlockingQueue", arrayQueue, new(queue.BlockingQueue), []int{20, 30, 40, 50}},
+		{"ArrayDeque → Deque", arrayDeque, new(deque.Deque), []int{1, 2, 3}},
+		{"Deque → LinkedListDeque", doubly, new(deque.LinkedListDeque), []int{9, 8}},
+		{"LinkedListDeque → ArrayDeque", linkedListDeque, new(deque.ArrayDeque), []int{6, 7}},
+	}
+	for _, tt := range tests {
+		data, err := tt.from.MarshalBinary()
+		if err != nil {
+			t.Fatalf("%s: MarshalBinary: %v", tt.name, err)
+		}
+		if err := tt.into.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%s: UnmarshalBinary: %v", tt.name, err)
+		}
+		if got := tt.into.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: %v, esperado %v", tt.name, got, tt.want)
+		}
+		if got := tt.from.ToSlice(); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: a origem mudou para %v", tt.name, got)
+		}
+	}
+	
+	// A estrutura lida continua funcionando normalmente
+	restored := new(stack.ArrayStack)
+	data, _ := arrayStack.MarshalBinary()
+	restored.UnmarshalBinary(data)
+	restored.Push(4)
+	if top, _ := restored.Pop(); top != 4 {
+		t.Errorf("Pop depois de Push(4) = %d", top)
+	}
+	if top, _ := restored.Pop(); top != 3 {
+		t.Errorf("topo restaurado = %d, esperado 3", top)
+	}
+}
+
+func TestListsAndGob(t *testing.T) {
+	source := list.NewDoublyLinkedList[string]()
+	source.AddAll([]string{"", "ação", "b"})
+	
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(source); err != nil {
+		t.Fatal(err)
+	}
+	var linked list.LinkedList[string]
+	if err := gob.NewDecoder(&buffer).Decode(&linked); err != nil {
+		t.Fatal(err)
+	}
+	if got := linked.ToSlice(); !slices.Equal(got, []string{"", "ação", "b"}) {
+		t.Errorf("gob: %q", got)
+	}
+	
+	floats := list.NewArrayList[float64](0)
+	floats.AddAll([]float64{0.5, -2, 1e300})
+	data, _ := floats.MarshalBinary()
+	target := list.NewArrayList[float64](10)
+	target.AddAll([]float64{9, 9, 9, 9, 9})
+	if err := target.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if got := target.ToSlice(); !slices.Equal(got, []float64{0.5, -2, 1e300}) {
+		t.Errorf("reais: %v", got)
+	}
+	
+	// int64 grava como int8 lê, desde que os valores caibam
+	wide := list.NewArrayList[int64](0)
+	wide.AddAll([]int64{-128, 127})
+	data, _ = wide.MarshalBinary()
+	var narrow list.ArrayList[int8]
+	if err := narrow.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	wide.Add(128)
+	data, _ = wide.MarshalBinary()
+	if err := narrow.UnmarshalBinary(data); !errors.Is(err, serial.ErrMismatch) || !strings.Contains(err.Error(), "elemento 2") {
+		t.Errorf("128 em int8: %v", err)
+	}
+	if got := narrow.ToSlice(); !slices.Equal(got, []int8{-128, 127}) {
+		t.Errorf("um erro não deveria alterar a lista: %v", got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	s := stack.NewLinkedStack()
+	s.PushAll([]int{1, 2})
+	got, err := json.Marshal(map[string]any{"pilha": s, "fila": queue.NewLinkedQueue()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"fila":[],"pilha":[2,1]}`; string(got) != want {
+		t.Errorf("json.Marshal = %s, esperado %s", got, want)
+	}
+	
+	var decoded struct {
+		Pilha stack.ArrayStack
+		Fila  *queue.PriorityQueue
+	}
+	if err := json.Unmarshal([]byte(`{"Pilha":[2,1],"Fila":[5,1,3]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got := decoded.Pilha.ToSlice(); !slices.Equal(got, []int{2, 1}) {
+		t.Errorf("pilha: %v", got)
+	}
+	if got := decoded.Fila.ToSlice(); !slices.Equal(got, []int{1, 3, 5}) {
+		t.Errorf("fila de prioridade sem comparador deveria usar MinComparator: %v", got)
+	}
+	
+	maxQueue := queue.NewMaxPriorityQueue(0)
+	if err := maxQueue.UnmarshalJSON([]byte(`[1, 3, 2]`)); err != nil {
+		t.Fatal(err)
+	}
+	if got := maxQueue.ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("o comparador atual deveria ser mantido: %v", got)
+	}
+	
+	d := deque.NewDeque()
+	d.EnqueueRear(1)
+	if err := d.UnmarshalJSON([]byte("null")); err != nil || !d.IsEmpty() {
+		t.Errorf("null: %v, %v", err, d)
+	}
+	if err := d.UnmarshalJSON([]byte(`{"a":1}`)); err == nil || !strings.Contains(err.Error(), "esperado um array de int") {
+		t.Errorf("objeto JSON: %v", err)
+	}
+}
+
+func TestBlockingQueueKeepsCapacity(t *testing.T) {
+	data, _ := serial.Marshal(serial.Queue, []int{1, 2, 3})
+	q := queue.NewBlockingQueue(2)
+	q.Put(context.Background(), 9)
+	err := q.UnmarshalBinary(data)
+	if err == nil || !strings.Contains(err.Error(), "não cabem na capacidade 2") {
+		t.Errorf("3 elementos em capacidade 2: %v", err)
+	}
+	if got := q.ToSlice(); !slices.Equal(got, []int{9}) {
+		t.Errorf("a fila não deveria mudar: %v", got)
+	}
+	
+	var zero queue.BlockingQueue
+	if err := zero.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if zero.Capacity() != 10 || !slices.Equal(zero.ToSlice(), []int{1, 2, 3}) {
+		t.Errorf("valor zero: %v", &zero)
+	}
+	if value, _ := zero.Take(context.Background()); value != 1 {
+		t.Errorf("Take = %d", value)
+	}
+}
+
+func TestRejectsBadInput(t *testing.T) {
+	valid, _ := serial.Marshal(serial.Stack, []int{1, 300, -7})
+	
+	corrupted := bytes.Clone(valid)
+	corrupted[8] ^= 0x40
+	newer := bytes.Clone(valid)
+	newer[3] = serial.Version + 1
+	
+	tests := []struct {
+		name    string
+		data    []byte
+		target  error
+		message string
+	}{
+		{"vazio", nil, serial.ErrCorrupt, "0 bytes"},
+		{"assinatura", append([]byte("XYZ"), valid[3:]...), serial.ErrCorrupt, "assinatura"},
+		{"byte alterado", corrupted, serial.ErrCorrupt, "checksum"},
+		{"truncado", valid[:len(valid)-2], serial.ErrCorrupt, "checksum"},
+		{"versão nova", resign(newer), serial.ErrUnsupportedVersion, "versão 2"},
+		{"bytes sobrando", resign(append(bytes.Clone(valid[:len(valid)-4]), 0, 0, 0, 0, 0)), serial.ErrCorrupt, "sobrando"},
+		{"n absurdo", resign([]byte{'D', 'C', 'A', serial.Version, 'S', 'i', 0xff, 0xff, 0xff, 0xff, 0x0f, 0, 0, 0, 0}), serial.ErrCorrupt, "anunciados"},
+		{"varint cortado", resign([]byte{'D', 'C', 'A', serial.Version, 'S', 'i', 2, 2, 0x80, 0, 0, 0, 0}), serial.ErrCorrupt, "elemento 1"},
+	}
+	for _, tt := range tests {
+		s := stack.NewArrayStack(0)
+		s.Push(42)
+		err := s.UnmarshalBinary(tt.data)
+		if !errors.Is(err, tt.target) || !strings.Contains(err.Error(), tt.message) || !strings.HasPrefix(err.Error(), "ArrayStack: ") {
+			t.Errorf("%s: erro %v, esperado %v com %q", tt.name, err, tt.target, tt.message)
+		}
+		if top, _ := s.Peek(); s.Size() != 1 || top != 42 {
+			t.Errorf("%s: a pilha não deveria mudar: %v", tt.name, s)
+		}
+	}
+	
+	// Família ou tipo de elemento diferentes
+	if err := queue.NewLinkedQueue().UnmarshalBinary(valid); !errors.Is(err, serial.ErrMismatch) || !strings.Contains(err.Error(), "gravado por uma pilha, lido como fila") {
+		t.Errorf("pilha lida como fila: %v", err)
+	}
+	if err := list.NewLinkedList[string]().UnmarshalBinary(mustMarshal(t, serial.List, []int{1})); !errors.Is(err, serial.ErrMismatch) {
+		t.Errorf("inteiros lidos como strings: %v", err)
+	}
+	
+	// Tipos sem codificação binária
+	type point struct{ X, Y int }
+	points := list.NewArrayList[point](1)
+	points.Add(point{1, 2})
+	if _, err := points.MarshalBinary(); err == nil || !strings.Contains(err.Error(), "não suportado") {
+		t.Errorf("struct no formato binário: %v", err)
+	}
+	if data, err := points.MarshalJSON(); err != nil || string(data) != `[{"X":1,"Y":2}]` {
+		t.Errorf("struct em JSON: %s, %v", data, err)
+	}
+}
+
+// resign recalcula o checksum, para testar as verificações que vêm depois dele
+func resign(data []byte) []byte {
+	body := bytes.Clone(data[:len(data)-4])
+	return binary.BigEndian.AppendUint32(body, crc32.ChecksumIEEE(body))
+}
+
+func mustMarshal[T any](t *testing.T, kind serial.Kind, values []T) []byte {
+	t.Helper()
+	data, err := serial.Marshal(kind, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,165 @@
+package simulation
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// FÓRMULAS ANALÍTICAS
+// ============================================================================
+
+// Metrics são as medidas de desempenho em regime estacionário previstas pela teoria
+type Metrics struct {
+	Model    string  // "M/M/1", "M/M/c" ou "M/G/1"
+	Rho      float64 // ρ = λ/(cμ): utilização de cada servidor
+	ProbWait float64 // P(esperar > 0); no M/M/c é a fórmula C de Erlang
+	Lq       float64 // Clientes na fila
+	L        float64 // Clientes no sistema
+	Wq       float64 // Espera média na fila
+	W        float64 // Tempo médio no sistema
+}
+
+// ErrUnstable indica ρ >= 1: a fila cresce sem limite e não há regime estacionário
+var ErrUnstable = errs.Define("sistema instável: ρ >= 1, a fila cresce sem limite", "unstable system: ρ >= 1, the queue grows without bound")
+
+// MM1 retorna as medidas da fila M/M/1 com taxa de chegada λ e de serviço μ
+// Fórmulas: ρ = λ/μ, Lq = ρ²/(1-ρ), Wq = ρ/(μ-λ), W = 1/(μ-λ), L = ρ/(1-ρ)
+func MM1(lambda, mu float64) (Metrics, error) {
+	metrics, err := MMc(lambda, mu, 1)
+	metrics.Model = "M/M/1"
+	return metrics, err
+}
+
+// MMc retorna as medidas da fila M/M/c (c servidores idênticos, fila única)
+// Complexidade: O(c)
+// Pseudocódigo:
+// 1. a = λ/μ (carga oferecida) e ρ = a/c
+// 2. P0 = 1 / (Σ_{k<c} a^k/k! + a^c/(c!(1-ρ)))
+// 3. C(c, a) = a^c/(c!(1-ρ)) · P0 (probabilidade de esperar, Erlang C)
+// 4. Lq = C·ρ/(1-ρ), Wq = Lq/λ, W = Wq + 1/μ, L = λW (lei de Little)
+func MMc(lambda, mu float64, c int) (Metrics, error) {
+	metrics := Metrics{Model: fmt.Sprintf("M/M/%d", c)}
+	if lambda <= 0 || mu <= 0 || c < 1 {
+		return metrics, errs.New(nil, "parâmetros inválidos: λ=%g, μ=%g, c=%d", "invalid parameters: λ=%g, μ=%g, c=%d", lambda, mu, c)
+	}
+	
+	a := lambda / mu
+	rho := a / float64(c)
+	metrics.Rho = rho
+	if rho >= 1 {
+		return metrics, ErrUnstable
+	}
+	
+	// Termos a^k/k! calculados incrementalmente para não estourar com c grande
+	sum, term := 0.0, 1.0
+	for k := 0; k < c; k++ {
+		sum += term
+		term *= a / float64(k+1)
+	}
+	last := term / (1 - rho)
+	p0 := 1 / (sum + last)
+	
+	metrics.ProbWait = last * p0
+	metrics.Lq = metrics.ProbWait * rho / (1 - rho)
+	metrics.Wq = metrics.Lq / lambda
+	metrics.W = metrics.Wq + 1/mu
+	metrics.L = lambda * metrics.W
+	return metrics, nil
+}
+
+// MG1 retorna as medidas da fila M/G/1 (serviço com distribuição qualquer)
+// Usa a fórmula de Pollaczek-Khinchine: Wq = λ·E[S²] / (2(1-ρ))
+// Com serviço determinístico a espera é metade da do M/M/1 de mesma média
+func MG1(lambda float64, service Distribution) (Metrics, error) {
+	metrics := Metrics{Model: "M/G/1"}
+	if err := validateDistribution("atendimento", "service", service); err != nil {
+		return metrics, err
+	}
+	if lambda <= 0 {
+		return metrics, errs.New(nil, "parâmetros inválidos: λ=%g", "invalid parameters: λ=%g", lambda)
+	}
+	
+	mean := service.Mean()
+	rho := lambda * mean
+	metrics.Rho = rho
+	if rho >= 1 {
+		return metrics, ErrUnstable
+	}
+	
+	secondMoment := service.Variance() + mean*mean
+	metrics.ProbWait = rho // Chegadas de Poisson veem o servidor ocupado com probabilidade ρ
+	metrics.Wq = lambda * secondMoment / (2 * (1 - rho))
+	metrics.Lq = lambda * metrics.Wq
+	metrics.W = metrics.Wq + mean
+	metrics.L = lambda * metrics.W
+	return metrics, nil
+}
+
+// Analytic escolhe a fórmula adequada para a configuração
+// Exige chegadas exponenciais; serviço exponencial usa M/M/c, outro serviço
+// com um único servidor usa M/G/1. Para G/G/c não há fórmula fechada.
+func Analytic(config Config) (Metrics, error) {
+	config, err := config.withDefaults()
+	if err != nil {
+		return Metrics{}, err
+	}
+	arrival, ok := config.Arrival.(Exponential)
+	if !ok {
+		return Metrics{}, errs.New(nil, "sem fórmula fechada para chegadas %s: exige chegadas exponenciais",
+			"no closed form for arrivals %s: requires exponential arrivals", config.Arrival)
+	}
+	
+	if service, ok := config.Service.(Exponential); ok {
+		if config.Servers == 1 {
+			return MM1(arrival.Rate, service.Rate)
+		}
+		return MMc(arrival.Rate, service.Rate, config.Servers)
+	}
+	if config.Servers == 1 {
+		return MG1(arrival.Rate, config.Service)
+	}
+	return Metrics{}, errs.New(nil, "sem fórmula fechada para M/G/%d", "no closed form for M/G/%d", config.Servers)
+}
+
+// ============================================================================
+// COMPARAÇÃO
+// ============================================================================
+
+// Compare monta uma tabela com os valores simulados, os analíticos e o erro relativo
+func Compare(result *Result, metrics Metrics) string {
+	rows := []struct {
+		name      string
+		simulated float64
+		analytic  float64
+	}{
+		{"Wq (espera na fila)", result.MeanWait, metrics.Wq},
+		{"W  (tempo no sistema)", result.MeanSystemTime, metrics.W},
+		{"Lq (tamanho da fila)", result.MeanQueueLength, metrics.Lq},
+		{"L  (no sistema)", result.MeanInSystem, metrics.L},
+		{"ρ  (utilização)", result.Utilization, metrics.Rho},
+		{"P(esperar)", result.ProbWait, metrics.ProbWait},
+	}
+	
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%-24s %12s %12s %9s\n", "medida ("+metrics.Model+")", "simulado", "analítico", "erro"))
+	for _, row := range rows {
+		builder.WriteString(fmt.Sprintf("%-24s %12.4f %12.4f %9s\n",
+			row.name, row.simulated, row.analytic, relativeError(row.simulated, row.analytic)))
+	}
+	return builder.String()
+}
+
+// relativeError formata (simulado - analítico) / analítico em porcentagem
+func relativeError(simulated, analytic float64) string {
+	if analytic == 0 {
+		if simulated == 0 {
+			return "0.0%"
+		}
+		return "-"
+	}
+	return fmt.Sprintf("%+.1f%%", 100*(simulated-analytic)/math.Abs(analytic))
+}
@@ -0,0 +1,114 @@
+// Package simulation implementa uma simulação de eventos discretos de filas
+// de atendimento (M/M/c e variações) usando as filas do pacote queue.
+//
+// A fila de espera é qualquer queue.Queue (LinkedQueue por padrão) e a lista
+// de eventos futuros é uma queue.PriorityQueue ordenada pelo instante do
+// evento. Os resultados podem ser comparados com as fórmulas analíticas
+// de M/M/1, M/M/c e M/G/1.
+package simulation
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// DISTRIBUIÇÕES DE PROBABILIDADE
+// ============================================================================
+
+// Distribution gera intervalos de tempo aleatórios (entre chegadas ou de serviço)
+type Distribution interface {
+	Sample(rng *rand.Rand) float64 // Sorteia um valor
+	Mean() float64                 // Valor esperado E[X]
+	Variance() float64             // Variância Var[X]
+	String() string                // Notação curta, ex.: "Exp(λ=2)"
+}
+
+// Exponential é a distribuição exponencial com taxa Rate (média 1/Rate)
+// Chegadas com intervalos exponenciais formam um processo de Poisson ("M" de Markov)
+type Exponential struct {
+	Rate float64
+}
+
+// Sample sorteia pelo método da inversa: -ln(U)/λ
+func (d Exponential) Sample(rng *rand.Rand) float64 {
+	return rng.ExpFloat64() / d.Rate
+}
+
+// Mean retorna 1/λ
+func (d Exponential) Mean() float64 { return 1 / d.Rate }
+
+// Variance retorna 1/λ²
+func (d Exponential) Variance() float64 { return 1 / (d.Rate * d.Rate) }
+
+// String retorna "Exp(λ=...)"
+func (d Exponential) String() string { return fmt.Sprintf("Exp(λ=%g)", d.Rate) }
+
+// Deterministic sempre retorna Value ("D" na notação de Kendall)
+type Deterministic struct {
+	Value float64
+}
+
+// Sample retorna sempre o mesmo valor
+func (d Deterministic) Sample(rng *rand.Rand) float64 { return d.Value }
+
+// Mean retorna o próprio valor
+func (d Deterministic) Mean() float64 { return d.Value }
+
+// Variance é zero
+func (d Deterministic) Variance() float64 { return 0 }
+
+// String retorna "D(...)"
+func (d Deterministic) String() string { return fmt.Sprintf("D(%g)", d.Value) }
+
+// Uniform é a distribuição uniforme contínua em [Min, Max)
+type Uniform struct {
+	Min, Max float64
+}
+
+// Sample sorteia Min + U·(Max-Min)
+func (d Uniform) Sample(rng *rand.Rand) float64 {
+	return d.Min + rng.Float64()*(d.Max-d.Min)
+}
+
+// Mean retorna (Min+Max)/2
+func (d Uniform) Mean() float64 { return (d.Min + d.Max) / 2 }
+
+// Variance retorna (Max-Min)²/12
+func (d Uniform) Variance() float64 { return math.Pow(d.Max-d.Min, 2) / 12 }
+
+// String retorna "U(min, max)"
+func (d Uniform) String() string { return fmt.Sprintf("U(%g, %g)", d.Min, d.Max) }
+
+// validateDistribution rejeita parâmetros que gerariam tempos negativos ou infinitos
+// name e nameEN identificam a distribuição nas mensagens dos dois idiomas
+func validateDistribution(name, nameEN string, d Distribution) error {
+	switch dist := d.(type) {
+	case nil:
+		return errs.New(nil, "%[1]s: distribuição não informada", "%[2]s: distribution not given", name, nameEN)
+	case Exponential:
+		if dist.Rate <= 0 || math.IsInf(dist.Rate, 0) || math.IsNaN(dist.Rate) {
+			return errs.New(nil, "%[1]s: taxa exponencial deve ser positiva, recebida %[3]g",
+				"%[2]s: exponential rate must be positive, got %[3]g", name, nameEN, dist.Rate)
+		}
+	case Deterministic:
+		if dist.Value <= 0 {
+			return errs.New(nil, "%[1]s: valor determinístico deve ser positivo, recebido %[3]g",
+				"%[2]s: deterministic value must be positive, got %[3]g", name, nameEN, dist.Value)
+		}
+	case Uniform:
+		if dist.Min < 0 || dist.Max <= dist.Min {
+			return errs.New(nil, "%[1]s: uniforme exige 0 <= min < max, recebido [%[3]g, %[4]g)",
+				"%[2]s: uniform requires 0 <= min < max, got [%[3]g, %[4]g)", name, nameEN, dist.Min, dist.Max)
+		}
+	default:
+		if d.Mean() <= 0 {
+			return errs.New(nil, "%[1]s: média deve ser positiva, recebida %[3]g",
+				"%[2]s: mean must be positive, got %[3]g", name, nameEN, d.Mean())
+		}
+	}
+	return nil
+}
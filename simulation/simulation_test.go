@@ -0,0 +1,182 @@
+package simulation_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/queue"
+	"dca3503/simulation"
+)
+
+func near(got, want, tolerance float64) bool {
+	return math.Abs(got-want) <= tolerance*math.Max(1, math.Abs(want))
+}
+
+func TestAnalyticFormulas(t *testing.T) {
+	mm1, err := simulation.MM1(0.5, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(mm1.Rho, 0.5, 1e-12) || !near(mm1.Lq, 0.5, 1e-12) || !near(mm1.Wq, 1, 1e-12) ||
+		!near(mm1.W, 2, 1e-12) || !near(mm1.L, 1, 1e-12) {
+		t.Errorf("M/M/1 λ=0.5 μ=1: %+v", mm1)
+	}
+	
+	// Erlang C para c=2, a=1: C = 1/3, Lq = 1/3, Wq = 1/3
+	mm2, err := simulation.MMc(1, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(mm2.ProbWait, 1.0/3, 1e-12) || !near(mm2.Wq, 1.0/3, 1e-12) || !near(mm2.L, 4.0/3, 1e-12) {
+		t.Errorf("M/M/2 λ=1 μ=1: %+v", mm2)
+	}
+	
+	// M/D/1 espera metade do M/M/1 de mesma média
+	md1, err := simulation.MG1(0.5, simulation.Deterministic{Value: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(md1.Wq, mm1.Wq/2, 1e-12) {
+		t.Errorf("M/D/1 Wq = %g, esperado %g", md1.Wq, mm1.Wq/2)
+	}
+	
+	if _, err := simulation.MMc(2, 1, 2); !errors.Is(err, simulation.ErrUnstable) {
+		t.Errorf("ρ = 1 deveria ser instável, erro %v", err)
+	}
+}
+
+func TestSimulationMatchesAnalytic(t *testing.T) {
+	configs := []simulation.Config{
+		{Servers: 1, Arrival: simulation.Exponential{Rate: 0.5}, Service: simulation.Exponential{Rate: 1}},
+		{Servers: 3, Arrival: simulation.Exponential{Rate: 2}, Service: simulation.Exponential{Rate: 1}},
+		{Servers: 1, Arrival: simulation.Exponential{Rate: 0.6}, Service: simulation.Uniform{Min: 0.5, Max: 1.5}},
+	}
+	for _, config := range configs {
+		config.Customers = 200000
+		config.Warmup = 1000
+		config.Seed = 42
+		
+		result, err := simulation.Run(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		metrics, err := simulation.Analytic(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		
+		checks := []struct {
+			name           string
+			simulated, exp float64
+		}{
+			{"Wq", result.MeanWait, metrics.Wq},
+			{"W", result.MeanSystemTime, metrics.W},
+			{"Lq", result.MeanQueueLength, metrics.Lq},
+			{"L", result.MeanInSystem, metrics.L},
+			{"ρ", result.Utilization, metrics.Rho},
+			{"P(esperar)", result.ProbWait, metrics.ProbWait},
+		}
+		for _, check := range checks {
+			if !near(check.simulated, check.exp, 0.08) {
+				t.Errorf("%s %s: simulado %.4f, analítico %.4f\n%s",
+					result.Model(), check.name, check.simulated, check.exp, simulation.Compare(result, metrics))
+			}
+		}
+	}
+}
+
+func TestSimulationDeterministic(t *testing.T) {
+	// D/D/1 com serviço menor que o intervalo: ninguém espera
+	result, err := simulation.Run(simulation.Config{
+		Arrival:   simulation.Deterministic{Value: 2},
+		Service:   simulation.Deterministic{Value: 1.5},
+		Customers: 100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.MeanWait != 0 || result.MaxQueueLength != 0 || result.WaitPercentile(99) != 0 {
+		t.Errorf("D/D/1 não deveria ter espera: %v", result)
+	}
+	if !near(result.MeanSystemTime, 1.5, 1e-12) {
+		t.Errorf("W = %g, esperado 1.5", result.MeanSystemTime)
+	}
+}
+
+func TestSimulationReproducibleAndLittle(t *testing.T) {
+	config := simulation.Config{
+		Servers:   2,
+		Arrival:   simulation.Exponential{Rate: 1.5},
+		Service:   simulation.Exponential{Rate: 1},
+		Customers: 20000,
+		Seed:      7,
+		NewQueue:  func() queue.Queue { return queue.NewArrayQueue(4) },
+	}
+	first, err := simulation.Run(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, _ := simulation.Run(config)
+	if first.String() != second.String() {
+		t.Errorf("mesma semente gerou resultados diferentes:\n%v\n%v", first, second)
+	}
+	
+	// Lei de Little: L = λW e Lq = λWq com o λ observado
+	if !near(first.MeanInSystem, first.ArrivalRate*first.MeanSystemTime, 0.01) ||
+		!near(first.MeanQueueLength, first.ArrivalRate*first.MeanWait, 0.01) {
+		t.Errorf("lei de Little violada: %v", first)
+	}
+	if p50, p99 := first.WaitPercentile(50), first.WaitPercentile(99); p50 > p99 {
+		t.Errorf("percentis fora de ordem: p50 %g > p99 %g", p50, p99)
+	}
+}
+
+func TestConfigErrors(t *testing.T) {
+	cases := []struct {
+		config   simulation.Config
+		fragment string
+	}{
+		{simulation.Config{Service: simulation.Exponential{Rate: 1}}, "chegadas"},
+		{simulation.Config{Arrival: simulation.Exponential{Rate: 1}, Service: simulation.Exponential{Rate: -1}}, "taxa"},
+		{simulation.Config{Arrival: simulation.Exponential{Rate: 1}, Service: simulation.Uniform{Min: 2, Max: 1}}, "uniforme"},
+		{simulation.Config{Arrival: simulation.Exponential{Rate: 1}, Service: simulation.Exponential{Rate: 1},
+			Customers: 10, Warmup: 10}, "aquecimento"},
+	}
+	for _, tc := range cases {
+		if _, err := simulation.Run(tc.config); err == nil || !strings.Contains(err.Error(), tc.fragment) {
+			t.Errorf("Run(%+v) = %v, esperado erro com %q", tc.config, err, tc.fragment)
+		}
+	}
+}
+
+func TestErrorsFollowLanguage(t *testing.T) {
+	_, unstable := simulation.MM1(2, 1)
+	previous := errs.CurrentLanguage()
+	errs.SetLanguage(errs.English)
+	defer errs.SetLanguage(previous)
+	
+	if !errors.Is(unstable, simulation.ErrUnstable) || unstable.Error() != "unstable system: ρ >= 1, the queue grows without bound" {
+		t.Errorf("ErrUnstable: %v", unstable)
+	}
+	if _, err := simulation.MMc(1, 2, 0); err == nil || err.Error() != "invalid parameters: λ=1, μ=2, c=0" {
+		t.Errorf("MMc: %v", err)
+	}
+	cases := []struct {
+		config simulation.Config
+		want   string
+	}{
+		{simulation.Config{Service: simulation.Exponential{Rate: 1}}, "arrivals: distribution not given"},
+		{simulation.Config{Arrival: simulation.Exponential{Rate: 1}, Service: simulation.Uniform{Min: 2, Max: 1}},
+			"service: uniform requires 0 <= min < max, got [2, 1)"},
+		{simulation.Config{Arrival: simulation.Exponential{Rate: 1}, Service: simulation.Exponential{Rate: 1},
+			Customers: 10, Warmup: 10}, "invalid customers (10) and warmup (10): requires 0 <= warmup < customers"},
+	}
+	for _, tc := range cases {
+		if _, err := simulation.Run(tc.config); err == nil || err.Error() != tc.want {
+			t.Errorf("Run(%+v) = %v, esperado %q", tc.config, err, tc.want)
+		}
+	}
+}
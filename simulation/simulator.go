@@ -0,0 +1,332 @@
+package simulation
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"sort"
+	"strings"
+
+	"dca3503/errs"
+	"dca3503/queue"
+)
+
+// ============================================================================
+// CONFIGURAÇÃO
+// ============================================================================
+
+// Config descreve o sistema de atendimento simulado
+// Na notação de Kendall: Arrival/Service/Servers (ex.: M/M/2)
+type Config struct {
+	Servers   int                // c: número de atendentes (padrão 1)
+	Arrival   Distribution       // Intervalo entre chegadas consecutivas
+	Service   Distribution       // Duração de cada atendimento
+	Customers int                // Quantos clientes chegam (padrão 10000)
+	Warmup    int                // Clientes iniciais descartados das estatísticas
+	Seed      uint64             // Semente: mesma semente, mesma simulação
+	NewQueue  func() queue.Queue // Fila de espera (padrão LinkedQueue)
+}
+
+// DefaultCustomers é o número de clientes quando Config.Customers é zero
+const DefaultCustomers = 10000
+
+// withDefaults valida a configuração e preenche os campos opcionais
+func (c Config) withDefaults() (Config, error) {
+	if c.Servers == 0 {
+		c.Servers = 1
+	}
+	if c.Customers == 0 {
+		c.Customers = DefaultCustomers
+	}
+	if c.NewQueue == nil {
+		c.NewQueue = func() queue.Queue { return queue.NewLinkedQueue() }
+	}
+	
+	if c.Servers < 0 {
+		return c, errs.New(nil, "número de servidores inválido: %d", "invalid number of servers: %d", c.Servers)
+	}
+	if c.Customers < 0 || c.Warmup < 0 || c.Warmup >= c.Customers {
+		return c, errs.New(nil, "clientes (%d) e aquecimento (%d) inválidos: exige 0 <= aquecimento < clientes",
+			"invalid customers (%d) and warmup (%d): requires 0 <= warmup < customers", c.Customers, c.Warmup)
+	}
+	if err := validateDistribution("chegadas", "arrivals", c.Arrival); err != nil {
+		return c, err
+	}
+	if err := validateDistribution("atendimento", "service", c.Service); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// ============================================================================
+// EVENTOS
+// ============================================================================
+
+// eventKind distingue os dois tipos de evento do modelo
+type eventKind int
+
+const (
+	arrivalEvent   eventKind = iota // Um cliente chega
+	departureEvent                  // Um servidor termina um atendimento
+)
+
+// event é um acontecimento agendado na lista de eventos futuros
+type event struct {
+	time     float64
+	kind     eventKind
+	customer int // Índice do cliente
+	server   int // Servidor que termina o atendimento (departureEvent)
+}
+
+// customer guarda os instantes de um cliente para as estatísticas
+type customer struct {
+	arrival   float64
+	start     float64 // Início do atendimento
+	departure float64
+}
+
+// ============================================================================
+// SIMULADOR
+// ============================================================================
+
+// simulator mantém o estado durante a execução
+type simulator struct {
+	config     Config
+	arrivalRNG *rand.Rand
+	serviceRNG *rand.Rand
+	events     []event              // Todos os eventos agendados (índices estáveis)
+	future     *queue.PriorityQueue // Índices em events, o mais cedo primeiro
+	waiting    queue.Queue          // Clientes esperando (FIFO)
+	idle       *queue.ArrayQueue    // Servidores livres (FIFO: reveza os servidores)
+	customers  []customer
+	now        float64
+	
+	// Estatísticas ponderadas pelo tempo (só depois do aquecimento)
+	measuring  bool
+	start      float64 // Instante em que a medição começou
+	lastChange float64 // Último instante em que as áreas foram atualizadas
+	queueArea  float64 // ∫ tamanho da fila dt
+	busyArea   float64 // ∫ servidores ocupados dt
+	maxQueue   int
+}
+
+// Run executa a simulação de eventos discretos até o último cliente sair
+// Complexidade: O(n log n) para n clientes (heap de eventos)
+// Pseudocódigo:
+// 1. Agendar a primeira chegada
+// 2. Enquanto houver eventos: retirar o mais cedo e avançar o relógio até ele
+//   - Chegada: agendar a próxima; se há servidor livre, iniciar o atendimento
+//     (agendando a saída), senão entrar na fila de espera
+//   - Saída: se há alguém esperando, atendê-lo; senão o servidor fica livre
+//
+// 3. Calcular as médias a partir dos instantes de cada cliente e das áreas
+func Run(config Config) (*Result, error) {
+	config, err := config.withDefaults()
+	if err != nil {
+		return nil, err
+	}
+	
+	s := &simulator{
+		config: config,
+		// Fluxos independentes: mudar o serviço não muda as chegadas
+		arrivalRNG: rand.New(rand.NewPCG(config.Seed, 1)),
+		serviceRNG: rand.New(rand.NewPCG(config.Seed, 2)),
+		events:     make([]event, 0, 2*config.Customers),
+		waiting:    config.NewQueue(),
+		idle:       queue.NewArrayQueue(config.Servers),
+		customers:  make([]customer, 0, config.Customers),
+	}
+	if !s.waiting.IsEmpty() {
+		return nil, errs.New(nil, "NewQueue deve retornar uma fila vazia", "NewQueue must return an empty queue")
+	}
+	s.future = queue.NewPriorityQueue(2*config.Servers+2, func(a, b int) bool {
+		// Empate no tempo: quem foi agendado antes sai antes
+		if s.events[a].time != s.events[b].time {
+			return s.events[a].time < s.events[b].time
+		}
+		return a < b
+	})
+	for server := 0; server < config.Servers; server++ {
+		s.idle.Enqueue(server)
+	}
+	
+	s.schedule(event{time: config.Arrival.Sample(s.arrivalRNG), kind: arrivalEvent})
+	for !s.future.IsEmpty() {
+		index, _ := s.future.Dequeue()
+		e := s.events[index]
+		s.advance(e.time)
+		
+		switch e.kind {
+		case arrivalEvent:
+			s.arrive(e)
+		case departureEvent:
+			s.depart(e)
+		}
+	}
+	
+	return s.result(), nil
+}
+
+// schedule agenda um evento
+func (s *simulator) schedule(e event) {
+	s.events = append(s.events, e)
+	s.future.Enqueue(len(s.events) - 1)
+}
+
+// advance move o relógio acumulando as áreas sob as curvas de fila e ocupação
+func (s *simulator) advance(t float64) {
+	if s.measuring {
+		dt := t - s.lastChange
+		s.queueArea += float64(s.waiting.Size()) * dt
+		s.busyArea += float64(s.config.Servers-s.idle.Size()) * dt
+	}
+	s.lastChange = t
+	s.now = t
+}
+
+// arrive trata a chegada de um cliente
+func (s *simulator) arrive(e event) {
+	id := len(s.customers)
+	if id == s.config.Warmup {
+		s.measuring = true
+		s.start = s.now
+	}
+	s.customers = append(s.customers, customer{arrival: s.now})
+	
+	if len(s.customers) < s.config.Customers {
+		s.schedule(event{time: s.now + s.config.Arrival.Sample(s.arrivalRNG), kind: arrivalEvent})
+	}
+	
+	if s.idle.IsEmpty() {
+		s.waiting.Enqueue(id)
+		if s.measuring && s.waiting.Size() > s.maxQueue {
+			s.maxQueue = s.waiting.Size()
+		}
+		return
+	}
+	server, _ := s.idle.Dequeue()
+	s.serve(id, server)
+}
+
+// depart trata o fim de um atendimento
+func (s *simulator) depart(e event) {
+	s.customers[e.customer].departure = s.now
+	if next, err := s.waiting.Dequeue(); err == nil {
+		s.serve(next, e.server)
+		return
+	}
+	s.idle.Enqueue(e.server)
+}
+
+// serve inicia o atendimento de um cliente e agenda sua saída
+func (s *simulator) serve(id, server int) {
+	s.customers[id].start = s.now
+	duration := s.config.Service.Sample(s.serviceRNG)
+	s.schedule(event{time: s.now + duration, kind: departureEvent, customer: id, server: server})
+}
+
+// ============================================================================
+// RESULTADO
+// ============================================================================
+
+// Result reúne as estatísticas medidas depois do aquecimento
+type Result struct {
+	Servers         int
+	Arrival         Distribution
+	Service         Distribution
+	Served          int     // Clientes considerados nas estatísticas
+	Duration        float64 // Tempo simulado após o aquecimento
+	ArrivalRate     float64 // λ observado (clientes / tempo)
+	MeanWait        float64 // Wq: espera média na fila
+	MeanSystemTime  float64 // W: tempo médio no sistema (espera + atendimento)
+	MeanQueueLength float64 // Lq: tamanho médio da fila (média no tempo)
+	MeanInSystem    float64 // L: clientes no sistema (média no tempo)
+	MaxQueueLength  int     // Maior fila observada
+	Utilization     float64 // ρ: fração do tempo em que os servidores estão ocupados
+	ProbWait        float64 // Fração dos clientes que precisou esperar
+	waits           []float64
+}
+
+// result calcula as estatísticas finais
+func (s *simulator) result() *Result {
+	measured := s.customers[s.config.Warmup:]
+	r := &Result{
+		Servers:        s.config.Servers,
+		Arrival:        s.config.Arrival,
+		Service:        s.config.Service,
+		Served:         len(measured),
+		Duration:       s.now - s.start,
+		MaxQueueLength: s.maxQueue,
+		waits:          make([]float64, len(measured)),
+	}
+	
+	totalWait, totalSystem, waited := 0.0, 0.0, 0
+	for i, c := range measured {
+		wait := c.start - c.arrival
+		r.waits[i] = wait
+		totalWait += wait
+		totalSystem += c.departure - c.arrival
+		if wait > 0 {
+			waited++
+		}
+	}
+	sort.Float64s(r.waits)
+	
+	n := float64(len(measured))
+	r.MeanWait = totalWait / n
+	r.MeanSystemTime = totalSystem / n
+	r.ProbWait = float64(waited) / n
+	if r.Duration > 0 {
+		r.ArrivalRate = n / r.Duration
+		r.MeanQueueLength = s.queueArea / r.Duration
+		r.Utilization = s.busyArea / (r.Duration * float64(s.config.Servers))
+		r.MeanInSystem = r.MeanQueueLength + s.busyArea/r.Duration
+	}
+	return r
+}
+
+// WaitPercentile retorna o percentil p (0 a 100) da espera na fila
+// Usa o método do posto mais próximo; p=50 é a mediana
+func (r *Result) WaitPercentile(p float64) float64 {
+	if len(r.waits) == 0 {
+		return 0
+	}
+	p = math.Max(0, math.Min(100, p))
+	rank := int(math.Ceil(p/100*float64(len(r.waits)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return r.waits[rank]
+}
+
+// Model retorna a notação de Kendall do sistema simulado (ex.: "M/M/2")
+func (r *Result) Model() string {
+	return fmt.Sprintf("%s/%s/%d", kendall(r.Arrival), kendall(r.Service), r.Servers)
+}
+
+// kendall retorna a letra da distribuição na notação de Kendall
+func kendall(d Distribution) string {
+	switch d.(type) {
+	case Exponential:
+		return "M"
+	case Deterministic:
+		return "D"
+	default:
+		return "G"
+	}
+}
+
+// String resume o resultado em várias linhas
+func (r *Result) String() string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf("%s  chegadas %s, atendimento %s\n", r.Model(), r.Arrival, r.Service))
+	builder.WriteString(fmt.Sprintf("  clientes: %d em %.1f unidades de tempo (λ observado %.4f)\n",
+		r.Served, r.Duration, r.ArrivalRate))
+	builder.WriteString(fmt.Sprintf("  espera Wq: média %.4f  p50 %.4f  p90 %.4f  p95 %.4f  p99 %.4f\n",
+		r.MeanWait, r.WaitPercentile(50), r.WaitPercentile(90), r.WaitPercentile(95), r.WaitPercentile(99)))
+	builder.WriteString(fmt.Sprintf("  tempo no sistema W: %.4f\n", r.MeanSystemTime))
+	builder.WriteString(fmt.Sprintf("  fila Lq: média %.4f  máxima %d   no sistema L: %.4f\n",
+		r.MeanQueueLength, r.MaxQueueLength, r.MeanInSystem))
+	builder.WriteString(fmt.Sprintf("  utilização ρ: %.4f   P(esperar): %.4f\n", r.Utilization, r.ProbWait))
+	return builder.String()
+}
@@ -1,9 +1,13 @@
-package main
+package stack
 
 import (
 	"fmt"
-	"errors"
+	"iter"
 	"strings"
+
+	"dca3503/capacity"
+	"dca3503/errs"
+	"dca3503/instrument"
 )
 
 // ============================================================================
@@ -15,14 +19,22 @@ import (
 // - Push/Pop no topo são operações O(1)
 // - Acesso direto ao topo O(1)
 // - Uso eficiente de memória (elementos contíguos)
-// - Redimensionamento automático quando necessário
+// - Redimensionamento automático conforme a política de capacidade
 type ArrayStack struct {
-	data     []int // Array interno que armazena os elementos
-	top      int   // Índice do elemento no topo (-1 se vazia)
-	capacity int   // Capacidade atual do array
+	data     []int                // Array interno que armazena os elementos
+	top      int                  // Índice do elemento no topo (-1 se vazia)
+	capacity int                  // Capacidade atual do array
+	policy   capacity.Policy      // Crescimento, redução e limite
+	gate     *capacity.Gate       // Sincronização do OverflowBlock (nil nos outros modos)
+	grows    int                  // Redimensionamentos para cima
+	shrinks  int                  // Redimensionamentos para baixo
+	err      error                // Primeiro Push recusado por OverflowError (ver Err)
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewArrayStack cria uma nova instância de ArrayStack com capacidade inicial
+// A política começa como capacity.Shrinking: dobra ao encher e reduz à
+// metade quando só 1/4 do array está em uso
 func NewArrayStack(initialCapacity int) *ArrayStack {
 	if initialCapacity <= 0 {
 		initialCapacity = 10 // Capacidade padrão
@@ -31,39 +43,93 @@ func NewArrayStack(initialCapacity int) *ArrayStack {
 		data:     make([]int, initialCapacity),
 		top:      -1,
 		capacity: initialCapacity,
+		policy:   capacity.Shrinking,
 	}
 }
 
+// SetCounters associa contadores de operações à pilha (nil desliga a contagem)
+// Conta escritas de elementos, redimensionamentos e comparações de busca
+func (s *ArrayStack) SetCounters(counters *instrument.Counters) {
+	s.counters = counters
+}
+
 // ============================================================================
 // IMPLEMENTAÇÃO DA INTERFACE STACK
 // ============================================================================
 
 // Push adiciona um elemento no topo da pilha
-// Complexidade: O(1) amortizado (pode ser O(n) quando redimensiona)
+// Com MaxCapacity atingida segue o Overflow da política: OverflowError
+// descarta o elemento e guarda o erro em Err (use TryPush para recebê-lo
+// na hora), OverflowBlock
+// espera um Pop de outra goroutine e OverflowOverwrite descarta a base
+// Complexidade: O(1) amortizado (O(n) quando redimensiona ou sobrescreve)
 func (s *ArrayStack) Push(element int) {
+	if err := s.push(element, true); err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+// TryPush é o Push que nunca espera: com a pilha no limite retorna um erro
+// errs.ErrFull, exceto em OverflowOverwrite, que descarta a base
+func (s *ArrayStack) TryPush(element int) error {
+	return s.push(element, false)
+}
+
+// Err retorna o erro do primeiro Push descartado por OverflowError (nil se
+// nenhum foi), para quem usa a pilha pela interface Stack, sem TryPush
+func (s *ArrayStack) Err() error {
+	return s.err
+}
+
+// push implementa Push e TryPush; wait diz se OverflowBlock pode esperar
+func (s *ArrayStack) push(element int, wait bool) error {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	defer checkInvariants(s)
+	if s.policy.Full(s.Size()) {
+		switch {
+		case s.policy.Overflow == capacity.OverflowOverwrite:
+			copy(s.data, s.data[1:s.Size()]) // A base é o elemento mais antigo
+			s.top--
+			s.counters.AddMoves(s.Size())
+		case s.policy.Overflow == capacity.OverflowBlock && wait:
+			for s.policy.Full(s.Size()) {
+				s.gate.Wait()
+			}
+		default:
+			return errs.New(errs.ErrFull, "pilha cheia: capacidade máxima %d", "full stack: maximum capacity %d", s.policy.MaxCapacity)
+		}
+	}
+	
 	// Verifica se precisa redimensionar
 	if s.top+1 >= s.capacity {
-		s.resize(s.capacity * 2)
+		s.resize(s.policy.Grow(s.capacity, s.capacity+1))
 	}
 	
 	s.top++
 	s.data[s.top] = element
+	s.counters.AddMoves(1)
+	return nil
 }
 
 // Pop remove e retorna o elemento do topo da pilha
 // Complexidade: O(1)
 func (s *ArrayStack) Pop() (int, error) {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	defer checkInvariants(s)
 	if s.IsEmpty() {
-		return 0, errors.New("pilha vazia: não é possível fazer pop")
+		return 0, errPopEmpty
 	}
 	
 	element := s.data[s.top]
 	s.top--
 	
-	// Redimensiona para baixo se necessário (economiza memória)
-	if s.Size() > 0 && s.Size() == s.capacity/4 {
-		s.resize(s.capacity / 2)
+	// Redimensiona para baixo conforme a política (economiza memória)
+	if next := s.policy.Shrink(s.capacity, s.Size()); next < s.capacity {
+		s.resize(next)
 	}
+	s.gate.Signal()
 	
 	return element, nil
 }
@@ -72,7 +138,7 @@ func (s *ArrayStack) Pop() (int, error) {
 // Complexidade: O(1)
 func (s *ArrayStack) Peek() (int, error) {
 	if s.IsEmpty() {
-		return 0, errors.New("pilha vazia: não há elemento no topo")
+		return 0, errPeekEmpty
 	}
 	return s.data[s.top], nil
 }
@@ -90,20 +156,24 @@ func (s *ArrayStack) IsEmpty() bool {
 }
 
 // IsFull verifica se a pilha está cheia
-// Para ArrayStack, nunca está "cheia" pois redimensiona automaticamente
+// Só acontece com MaxCapacity na política: sem limite o array sempre cresce
 // Complexidade: O(1)
 func (s *ArrayStack) IsFull() bool {
-	return false // Array dinâmico nunca está "cheio"
+	return s.policy.Full(s.Size())
 }
 
 // Clear remove todos os elementos da pilha
 // Complexidade: O(1)
 func (s *ArrayStack) Clear() {
+	s.gate.Lock()
+	defer s.gate.Unlock()
+	defer checkInvariants(s)
 	s.top = -1
 	// Opcionalmente, pode redimensionar para capacidade inicial
 	if s.capacity > 10 {
 		s.resize(10)
 	}
+	s.gate.Signal()
 }
 
 // ToSlice converte a pilha para um slice (do topo para a base)
@@ -159,12 +229,43 @@ func (s *ArrayStack) resize(newCapacity int) {
 	
 	newData := make([]int, newCapacity)
 	copy(newData, s.data[:s.Size()])
+	s.counters.Resize(s.Size())
+	if newCapacity > s.capacity {
+		s.grows++
+	} else {
+		s.shrinks++
+	}
 	s.data = newData
 	s.capacity = newCapacity
 }
 
+// SetGrowthPolicy troca a política de capacidade da pilha
+// Retorna erro se a política for inválida ou se os elementos atuais não
+// couberem em MaxCapacity; um array maior que MaxCapacity é reduzido
+// Com OverflowBlock, Push, Pop e Clear passam a ser sincronizados para que
+// outra goroutine possa abrir espaço; os demais métodos continuam sem
+// sincronização. Chame antes de compartilhar a pilha
+func (s *ArrayStack) SetGrowthPolicy(policy capacity.Policy) error {
+	defer checkInvariants(s)
+	if err := policy.Validate(s.Size()); err != nil {
+		return err
+	}
+	s.policy = policy
+	s.gate = policy.NewGate()
+	if limit := policy.Clamp(s.capacity); limit < s.capacity {
+		s.resize(limit)
+	}
+	return nil
+}
+
+// GrowthPolicy retorna a política de capacidade atual
+func (s *ArrayStack) GrowthPolicy() capacity.Policy {
+	return s.policy
+}
+
 // TrimToSize reduz a capacidade para o tamanho atual (economiza memória)
 func (s *ArrayStack) TrimToSize() {
+	defer checkInvariants(s)
 	if s.capacity > s.Size() {
 		newCapacity := s.Size()
 		if newCapacity == 0 {
@@ -175,7 +276,10 @@ func (s *ArrayStack) TrimToSize() {
 }
 
 // EnsureCapacity garante que a pilha tenha pelo menos a capacidade especificada
+// Não passa de MaxCapacity
 func (s *ArrayStack) EnsureCapacity(minCapacity int) {
+	defer checkInvariants(s)
+	minCapacity = s.policy.Clamp(minCapacity)
 	if s.capacity < minCapacity {
 		s.resize(minCapacity)
 	}
@@ -183,6 +287,7 @@ func (s *ArrayStack) EnsureCapacity(minCapacity int) {
 
 // PushAll adiciona múltiplos elementos de uma vez
 func (s *ArrayStack) PushAll(elements []int) {
+	defer checkInvariants(s)
 	// Garante capacidade suficiente
 	s.EnsureCapacity(s.Size() + len(elements))
 	
@@ -193,12 +298,13 @@ func (s *ArrayStack) PushAll(elements []int) {
 
 // PopMultiple remove e retorna múltiplos elementos do topo
 func (s *ArrayStack) PopMultiple(count int) ([]int, error) {
+	defer checkInvariants(s)
 	if count <= 0 {
 		return []int{}, nil
 	}
 	
 	if count > s.Size() {
-		return nil, fmt.Errorf("não há elementos suficientes: solicitado %d, disponível %d", count, s.Size())
+		return nil, errs.NotEnough(count, s.Size())
 	}
 	
 	result := make([]int, count)
@@ -214,6 +320,7 @@ func (s *ArrayStack) PopMultiple(count int) ([]int, error) {
 // Complexidade: O(n)
 func (s *ArrayStack) Contains(element int) bool {
 	for i := 0; i <= s.top; i++ {
+		s.counters.AddComparisons(1)
 		if s.data[i] == element {
 			return true
 		}
@@ -226,6 +333,7 @@ func (s *ArrayStack) Contains(element int) bool {
 // Complexidade: O(n)
 func (s *ArrayStack) Search(element int) int {
 	for i := s.top; i >= 0; i-- {
+		s.counters.AddComparisons(1)
 		if s.data[i] == element {
 			return s.top - i + 1 // Posição a partir do topo (1-indexado)
 		}
@@ -236,6 +344,8 @@ func (s *ArrayStack) Search(element int) int {
 // Clone cria uma cópia independente da pilha
 func (s *ArrayStack) Clone() *ArrayStack {
 	newStack := NewArrayStack(s.capacity)
+	newStack.policy = s.policy
+	newStack.gate = s.policy.NewGate()
 	newStack.top = s.top
 	copy(newStack.data, s.data[:s.Size()])
 	return newStack
@@ -269,6 +379,7 @@ func (s *ArrayStack) GetMemoryUsage() (int, int, float64) {
 }
 
 // GetStatistics retorna estatísticas da pilha
+// grows e shrinks contam os redimensionamentos para cima e para baixo
 func (s *ArrayStack) GetStatistics() map[string]interface{} {
 	if s.IsEmpty() {
 		return map[string]interface{}{
@@ -276,6 +387,8 @@ func (s *ArrayStack) GetStatistics() map[string]interface{} {
 			"capacity":     s.capacity,
 			"utilization": 0.0,
 			"isEmpty":      true,
+			"grows":        s.grows,
+			"shrinks":      s.shrinks,
 		}
 	}
 	
@@ -307,5 +420,46 @@ func (s *ArrayStack) GetStatistics() map[string]interface{} {
 		"average":      average,
 		"min":          min,
 		"max":          max,
+		"grows":        s.grows,
+		"shrinks":      s.shrinks,
+	}
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) do topo para a base
+// A posição 0 é o topo, seguindo a mesma ordem de ToSlice
+func (s *ArrayStack) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := 0; i <= s.top; i++ {
+			if !yield(i, s.data[s.top-i]) {
+				return
+			}
+		}
 	}
-}
\ No newline at end of file
+}
+
+// Values retorna um iterador sobre os elementos do topo para a base
+func (s *ArrayStack) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := s.top; i >= 0; i-- {
+			if !yield(s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward retorna um iterador sobre pares (posição, elemento) da base para o topo
+// As posições continuam contadas a partir do topo
+func (s *ArrayStack) Backward() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for i := 0; i <= s.top; i++ {
+			if !yield(s.top-i, s.data[i]) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,567 @@
+package stack
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// ANALISADOR DE BALANCEAMENTO DE COLCHETES
+// ============================================================================
+
+// Versão completa do exercício de parênteses balanceados:
+// - reporta cada problema com linha e coluna (não apenas true/false)
+// - ignora colchetes dentro de strings e comentários conforme a linguagem
+// - sugere o menor conjunto de inserções/remoções que balanceia o texto
+//
+// Colchetes reconhecidos: (), [] e {}
+
+// Language descreve como reconhecer strings e comentários
+// Campos vazios desligam o recurso correspondente
+type Language struct {
+	Name        string // Nome usado pela CLI ("go", "c", "json", "texto")
+	LineComment string // Início de comentário de linha ("//")
+	BlockStart  string // Início de comentário de bloco ("/*")
+	BlockEnd    string // Fim de comentário de bloco ("*/")
+	Quotes      string // Delimitadores de string com escape por '\' e que terminam na linha
+	RawQuotes   string // Delimitadores de string sem escape, podendo ocupar várias linhas
+}
+
+// Linguagens pré-configuradas
+var (
+	LanguageText = Language{Name: "texto"}
+	LanguageGo   = Language{Name: "go", LineComment: "//", BlockStart: "/*", BlockEnd: "*/", Quotes: "\"'", RawQuotes: "`"}
+	LanguageC    = Language{Name: "c", LineComment: "//", BlockStart: "/*", BlockEnd: "*/", Quotes: "\"'"}
+	LanguageJSON = Language{Name: "json", Quotes: "\""}
+)
+
+// Languages lista as linguagens pré-configuradas
+var Languages = []Language{LanguageText, LanguageGo, LanguageC, LanguageJSON}
+
+// LanguageByName procura uma linguagem pré-configurada pelo nome
+func LanguageByName(name string) (Language, bool) {
+	for _, language := range Languages {
+		if strings.EqualFold(language.Name, name) {
+			return language, true
+		}
+	}
+	return Language{}, false
+}
+
+// LanguageForFile escolhe a linguagem pela extensão do arquivo (texto se desconhecida)
+func LanguageForFile(path string) Language {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".go":
+		return LanguageGo
+	case ".c", ".h", ".cc", ".cpp", ".hpp":
+		return LanguageC
+	case ".json":
+		return LanguageJSON
+	default:
+		return LanguageText
+	}
+}
+
+// ============================================================================
+// RESULTADOS
+// ============================================================================
+
+// Position é uma posição no texto analisado
+type Position struct {
+	Offset int // Deslocamento em bytes (0 = início)
+	Line   int // Linha (1 = primeira)
+	Column int // Coluna em caracteres (1 = primeira)
+}
+
+// String retorna a posição no formato linha:coluna
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// BracketIssueKind classifica um problema encontrado
+type BracketIssueKind int
+
+const (
+	UnclosedBracket     BracketIssueKind = iota // Abertura sem fechamento
+	UnexpectedClosing                           // Fechamento sem nenhuma abertura pendente
+	MismatchedBracket                           // Fechamento de tipo diferente da abertura pendente
+	UnterminatedString                          // String aberta até o fim da linha/arquivo
+	UnterminatedComment                         // Comentário de bloco sem fim
+)
+
+// BracketIssue é um problema de balanceamento com sua posição
+type BracketIssue struct {
+	Kind    BracketIssueKind
+	Char    rune     // Colchete (ou delimitador) envolvido
+	Pos     Position // Onde o problema foi detectado
+	Opening Position // Abertura relacionada (UnclosedBracket e MismatchedBracket)
+	Message error    // Descrição legível, nos dois idiomas (errs.New)
+}
+
+// String retorna "linha:coluna: mensagem"
+func (issue BracketIssue) String() string {
+	return fmt.Sprintf("%s: %v", issue.Pos, issue.Message)
+}
+
+// BracketEdit é uma edição sugerida: inserir ou remover um colchete
+type BracketEdit struct {
+	Insert bool     // true = inserir Char em Pos; false = remover o Char que está em Pos
+	Char   rune     // Colchete inserido ou removido
+	Pos    Position // Ponto de inserção ou posição do colchete removido
+	depth  int      // Aninhamento da inserção (inserções internas vêm antes no mesmo ponto)
+}
+
+// String descreve a edição no idioma de errs.SetLanguage
+func (edit BracketEdit) String() string {
+	if edit.Insert {
+		return fmt.Sprintf(errs.Message("inserir '%c' em %s", "insert '%c' at %s"), edit.Char, edit.Pos)
+	}
+	return fmt.Sprintf(errs.Message("remover '%c' em %s", "remove '%c' at %s"), edit.Char, edit.Pos)
+}
+
+// BracketReport é o resultado da análise
+type BracketReport struct {
+	Issues     []BracketIssue // Problemas na ordem do texto
+	Fix        []BracketEdit  // Edições que balanceiam o texto, ordenadas por posição
+	MinimalFix bool           // true se Fix tem o menor número possível de edições
+}
+
+// Balanced indica se o texto não tem nenhum problema
+func (r *BracketReport) Balanced() bool {
+	return len(r.Issues) == 0
+}
+
+// ============================================================================
+// VARREDURA
+// ============================================================================
+
+// bracket é uma ocorrência de colchete fora de strings e comentários
+type bracket struct {
+	char    rune
+	offset  int
+	codeEnd int  // Fim do último caractere de código antes deste colchete
+	opening bool // true para (, [ e {
+}
+
+// partner retorna o colchete correspondente: '(' <-> ')' etc.
+func partner(char rune) rune {
+	switch char {
+	case '(':
+		return ')'
+	case ')':
+		return '('
+	case '[':
+		return ']'
+	case ']':
+		return '['
+	case '{':
+		return '}'
+	case '}':
+		return '{'
+	}
+	return 0
+}
+
+// scanner percorre o texto separando código, strings e comentários
+type scanner struct {
+	source     string
+	language   Language
+	lineStarts []int          // Offset do início de cada linha
+	brackets   []bracket      // Colchetes encontrados no código
+	issues     []BracketIssue // Strings/comentários não terminados
+	codeEnd    int            // Fim do último caractere de código visto
+}
+
+// scan preenche brackets e issues
+// Complexidade: O(n)
+func (s *scanner) scan() {
+	s.lineStarts = []int{0}
+	source := s.source
+	language := s.language
+	
+	for i := 0; i < len(source); {
+		r, width := utf8.DecodeRuneInString(source[i:])
+		
+		switch {
+		case r == '\n':
+			s.lineStarts = append(s.lineStarts, i+1)
+			i += width
+		case language.LineComment != "" && strings.HasPrefix(source[i:], language.LineComment):
+			end := strings.IndexByte(source[i:], '\n')
+			if end < 0 {
+				end = len(source) - i
+			}
+			i += end // O '\n' é tratado no próximo passo
+		case language.BlockStart != "" && strings.HasPrefix(source[i:], language.BlockStart):
+			start := i
+			end := strings.Index(source[i+len(language.BlockStart):], language.BlockEnd)
+			if end < 0 {
+				s.issues = append(s.issues, BracketIssue{
+					Kind:    UnterminatedComment,
+					Char:    rune(language.BlockStart[0]),
+					Pos:     s.position(start),
+					Message: errs.New(nil, "comentário %q sem %q; colchetes até o fim foram ignorados", "comment %q without %q; brackets up to the end were ignored", language.BlockStart, language.BlockEnd),
+				})
+				i = s.skipTo(i, len(source))
+				break
+			}
+			i = s.skipTo(i, i+len(language.BlockStart)+end+len(language.BlockEnd))
+		case strings.ContainsRune(language.RawQuotes, r):
+			start := i
+			end := strings.IndexRune(source[i+width:], r)
+			if end < 0 {
+				s.issues = append(s.issues, BracketIssue{
+					Kind:    UnterminatedString,
+					Char:    r,
+					Pos:     s.position(start),
+					Message: errs.New(nil, "string %c sem fechamento; colchetes até o fim foram ignorados", "unterminated string %c; brackets up to the end were ignored", r),
+				})
+				i = s.skipTo(i, len(source))
+				break
+			}
+			i = s.skipTo(i, i+width+end+width)
+			s.codeEnd = i
+		case strings.ContainsRune(language.Quotes, r):
+			i = s.scanQuoted(i, r, width)
+		default:
+			if partner(r) != 0 {
+				s.brackets = append(s.brackets, bracket{
+					char:    r,
+					offset:  i,
+					codeEnd: s.codeEnd,
+					opening: strings.ContainsRune("([{", r),
+				})
+			}
+			i += width
+			if r != ' ' && r != '\t' && r != '\r' {
+				s.codeEnd = i
+			}
+		}
+	}
+}
+
+// scanQuoted pula uma string com escape que termina na mesma linha
+func (s *scanner) scanQuoted(start int, quote rune, width int) int {
+	source := s.source
+	i := start + width
+	for i < len(source) {
+		r, w := utf8.DecodeRuneInString(source[i:])
+		switch {
+		case r == '\\':
+			i += w
+			if i < len(source) && source[i] != '\n' {
+				_, w = utf8.DecodeRuneInString(source[i:])
+				i += w
+			}
+			continue
+		case r == quote:
+			s.codeEnd = i + w
+			return i + w
+		case r == '\n':
+			s.issues = append(s.issues, BracketIssue{
+				Kind:    UnterminatedString,
+				Char:    quote,
+				Pos:     s.position(start),
+				Message: errs.New(nil, "string %c sem fechamento nesta linha", "unterminated string %c on this line", quote),
+			})
+			return i // O '\n' é tratado pelo laço principal
+		}
+		i += w
+	}
+	s.issues = append(s.issues, BracketIssue{
+		Kind:    UnterminatedString,
+		Char:    quote,
+		Pos:     s.position(start),
+		Message: errs.New(nil, "string %c sem fechamento", "unterminated string %c", quote),
+	})
+	return len(source)
+}
+
+// skipTo avança de from até to registrando as quebras de linha no caminho
+func (s *scanner) skipTo(from, to int) int {
+	for i := from; i < to; i++ {
+		if s.source[i] == '\n' {
+			s.lineStarts = append(s.lineStarts, i+1)
+		}
+	}
+	return to
+}
+
+// position converte um offset em linha e coluna
+// Só é válida para offsets já percorridos (ou para o texto todo após scan)
+func (s *scanner) position(offset int) Position {
+	line := sort.Search(len(s.lineStarts), func(i int) bool { return s.lineStarts[i] > offset }) - 1
+	column := utf8.RuneCountInString(s.source[s.lineStarts[line]:offset]) + 1
+	return Position{Offset: offset, Line: line + 1, Column: column}
+}
+
+// ============================================================================
+// DIAGNÓSTICO COM PILHA
+// ============================================================================
+
+// AnalyzeBrackets verifica o balanceamento de (), [] e {} no texto
+// Pseudocódigo do diagnóstico:
+//  1. Abertura: empilhar
+//  2. Fechamento que corresponde ao topo: desempilhar
+//  3. Fechamento de um tipo aberto mais abaixo: as aberturas acima dele
+//     nunca foram fechadas; desempilhar até ele
+//  4. Fechamento de um tipo sem abertura pendente: sem abertura (pilha vazia)
+//     ou incompatível com o topo; é ignorado
+//  5. No fim: o que sobrou na pilha não foi fechado
+//
+// Complexidade: O(n) para o diagnóstico; a correção mínima é O(r³), onde r é
+// o número de colchetes que sobram depois de cancelar os pares adjacentes
+func AnalyzeBrackets(source string, language Language) *BracketReport {
+	s := &scanner{source: source, language: language}
+	s.scan()
+	
+	report := &BracketReport{Issues: s.issues}
+	brackets := s.brackets
+	pending := NewArrayStack(len(brackets) + 1) // Índices em brackets
+	openCount := map[rune]int{}                 // Aberturas pendentes de cada tipo
+	
+	for i, b := range brackets {
+		if b.opening {
+			pending.Push(i)
+			openCount[b.char]++
+			continue
+		}
+		
+		want := partner(b.char)
+		top, err := pending.Peek()
+		switch {
+		case err != nil:
+			report.Issues = append(report.Issues, BracketIssue{
+				Kind:    UnexpectedClosing,
+				Char:    b.char,
+				Pos:     s.position(b.offset),
+				Message: errs.New(nil, "'%c' fecha sem nenhuma abertura pendente", "'%c' closes with no pending opening", b.char),
+			})
+		case brackets[top].char == want:
+			pending.Pop()
+			openCount[want]--
+		case openCount[want] > 0:
+			// Quem está acima da abertura correspondente nunca foi fechado
+			for brackets[top].char != want {
+				pending.Pop()
+				openCount[brackets[top].char]--
+				opening := s.position(brackets[top].offset)
+				report.Issues = append(report.Issues, BracketIssue{
+					Kind:    UnclosedBracket,
+					Char:    brackets[top].char,
+					Pos:     s.position(b.offset),
+					Opening: opening,
+					Message: errs.New(nil, "'%c' aberto em %s não foi fechado antes de '%c'", "'%c' opened at %s was not closed before '%c'", brackets[top].char, opening, b.char),
+				})
+				top, _ = pending.Peek()
+			}
+			pending.Pop()
+			openCount[want]--
+		default:
+			opening := s.position(brackets[top].offset)
+			report.Issues = append(report.Issues, BracketIssue{
+				Kind:    MismatchedBracket,
+				Char:    b.char,
+				Pos:     s.position(b.offset),
+				Opening: opening,
+				Message: errs.New(nil, "'%c' não corresponde a '%c' aberto em %s (esperado '%c')",
+					"'%c' does not match '%c' opened at %s (expected '%c')",
+					b.char, brackets[top].char, opening, partner(brackets[top].char)),
+			})
+		}
+	}
+	
+	// Aberturas que sobraram (a ordenação abaixo as coloca na ordem do texto)
+	for !pending.IsEmpty() {
+		index, _ := pending.Pop()
+		opening := s.position(brackets[index].offset)
+		report.Issues = append(report.Issues, BracketIssue{
+			Kind:    UnclosedBracket,
+			Char:    brackets[index].char,
+			Pos:     opening,
+			Opening: opening,
+			Message: errs.New(nil, "'%c' aberto aqui não foi fechado até o fim do texto", "'%c' opened here was not closed by the end of the text", brackets[index].char),
+		})
+	}
+	sort.SliceStable(report.Issues, func(a, b int) bool {
+		return report.Issues[a].Pos.Offset < report.Issues[b].Pos.Offset
+	})
+	
+	report.Fix, report.MinimalFix = s.suggestFix()
+	return report
+}
+
+// ============================================================================
+// CORREÇÃO MÍNIMA
+// ============================================================================
+
+// MaxMinimalFixBrackets limita o tamanho do resíduo para a correção mínima
+// Acima dele (O(r³) ficaria caro) usa-se uma correção gulosa válida
+var MaxMinimalFixBrackets = 1000
+
+// suggestFix calcula as edições que balanceiam o texto
+// Pseudocódigo:
+//  1. Cancelar pares adjacentes correspondentes com uma pilha (como no
+//     exercício clássico); isso nunca piora a solução ótima
+//  2. No resíduo r[0..m-1], custo(i, j) = menor número de edições para r[i..j]:
+//     - r[i] sozinho: 1 + custo(i+1, j)  (remover fechamento / inserir o par da abertura)
+//     - r[i] abertura casada com r[k]: custo(i+1, k-1) + custo(k+1, j)
+//  3. Reconstruir as escolhas: aberturas sem par recebem o fechamento no fim
+//     do seu trecho; fechamentos sem par são removidos
+func (s *scanner) suggestFix() ([]BracketEdit, bool) {
+	brackets := s.brackets
+	stack := NewArrayStack(len(brackets) + 1) // Índices em brackets
+	for i, b := range brackets {
+		if top, err := stack.Peek(); err == nil && !b.opening &&
+			brackets[top].opening && partner(brackets[top].char) == b.char {
+			stack.Pop()
+			continue
+		}
+		stack.Push(i)
+	}
+	
+	// O resíduo sai da pilha do topo para a base: inverte para a ordem do texto
+	residue := make([]int, stack.Size())
+	for i := len(residue) - 1; i >= 0; i-- {
+		residue[i], _ = stack.Pop()
+	}
+	if len(residue) == 0 {
+		return nil, true
+	}
+	
+	f := &fixer{scanner: s, residue: residue}
+	if len(residue) > MaxMinimalFixBrackets {
+		f.greedy()
+		return f.sorted(), false
+	}
+	f.solve()
+	f.build(0, len(residue)-1, 0)
+	return f.sorted(), true
+}
+
+// fixer guarda a tabela da programação dinâmica sobre o resíduo
+type fixer struct {
+	*scanner
+	residue []int   // Índices em brackets dos colchetes que sobraram
+	cost    []int32 // cost[i*m+j] = custo mínimo de residue[i..j]
+	choice  []int32 // -1 = residue[i] fica sem par; k = casado com residue[k]
+	edits   []BracketEdit
+}
+
+func (f *fixer) at(i, j int) int32 {
+	if i > j {
+		return 0
+	}
+	return f.cost[i*len(f.residue)+j]
+}
+
+// solve preenche a tabela de baixo para cima
+// Complexidade: O(m³) tempo, O(m²) memória
+func (f *fixer) solve() {
+	m := len(f.residue)
+	f.cost = make([]int32, m*m)
+	f.choice = make([]int32, m*m)
+	for i := m - 1; i >= 0; i-- {
+		first := f.brackets[f.residue[i]]
+		for j := i; j < m; j++ {
+			best, choice := 1+f.at(i+1, j), int32(-1)
+			if first.opening {
+				for k := i + 1; k <= j; k++ {
+					if f.brackets[f.residue[k]].char != partner(first.char) {
+						continue
+					}
+					// Empate favorece casar: preserva mais do texto original
+					if c := f.at(i+1, k-1) + f.at(k+1, j); c < best || (c == best && choice < 0) {
+						best, choice = c, int32(k)
+					}
+				}
+			}
+			f.cost[i*m+j] = best
+			f.choice[i*m+j] = choice
+		}
+	}
+}
+
+// build reconstrói as edições de residue[i..j]
+// depth cresce a cada trecho aninhado para ordenar inserções no mesmo ponto
+func (f *fixer) build(i, j, depth int) {
+	for i <= j {
+		b := f.brackets[f.residue[i]]
+		k := int(f.choice[i*len(f.residue)+j])
+		switch {
+		case k >= 0:
+			f.build(i+1, k-1, depth+1)
+			i = k + 1
+			continue
+		case b.opening:
+			f.build(i+1, j, depth+1)
+			f.insertBefore(j+1, partner(b.char), depth)
+		default:
+			f.edits = append(f.edits, BracketEdit{Char: b.char, Pos: f.position(b.offset)})
+			i++
+			continue
+		}
+		return
+	}
+}
+
+// insertBefore adiciona a inserção logo após o último código antes de residue[next]
+// (ou do fim do texto), para não quebrar linhas nem cair dentro de comentários
+func (f *fixer) insertBefore(next int, char rune, depth int) {
+	offset := f.codeEnd
+	if next < len(f.residue) {
+		offset = f.brackets[f.residue[next]].codeEnd
+	}
+	f.edits = append(f.edits, BracketEdit{Insert: true, Char: char, Pos: f.position(offset), depth: depth})
+}
+
+// greedy remove fechamentos e fecha aberturas no fim (válido, mas não mínimo)
+func (f *fixer) greedy() {
+	for i, index := range f.residue {
+		b := f.brackets[index]
+		if b.opening {
+			f.insertBefore(len(f.residue), partner(b.char), i)
+		} else {
+			f.edits = append(f.edits, BracketEdit{Char: b.char, Pos: f.position(b.offset)})
+		}
+	}
+}
+
+// sorted ordena as edições por posição; no mesmo ponto, inserções internas
+// vêm antes das externas e inserções antes da remoção do caractere ali
+func (f *fixer) sorted() []BracketEdit {
+	sort.SliceStable(f.edits, func(a, b int) bool {
+		ea, eb := f.edits[a], f.edits[b]
+		if ea.Pos.Offset != eb.Pos.Offset {
+			return ea.Pos.Offset < eb.Pos.Offset
+		}
+		if ea.Insert != eb.Insert {
+			return ea.Insert
+		}
+		return ea.depth > eb.depth
+	})
+	return f.edits
+}
+
+// ApplyBracketFix aplica as edições de BracketReport.Fix ao texto original
+// Complexidade: O(n + e)
+func ApplyBracketFix(source string, edits []BracketEdit) string {
+	var builder strings.Builder
+	last := 0
+	for _, edit := range edits {
+		builder.WriteString(source[last:edit.Pos.Offset])
+		last = edit.Pos.Offset
+		if edit.Insert {
+			builder.WriteRune(edit.Char)
+		} else {
+			last += utf8.RuneLen(edit.Char)
+		}
+	}
+	builder.WriteString(source[last:])
+	return builder.String()
+}
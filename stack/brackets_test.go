@@ -0,0 +1,209 @@
+package stack_test
+
+import (
+	"math/rand/v2"
+	"strings"
+	"testing"
+	
+	"dca3503/stack"
+)
+
+func TestAnalyzeBracketsBalanced(t *testing.T) {
+	cases := []struct {
+		source   string
+		language stack.Language
+	}{
+		{"", stack.LanguageText},
+		{"f(a[1], {b})", stack.LanguageText},
+		{"s := \"(\" // )\nr := '('\n/* [ */", stack.LanguageGo},
+		{"q := `\n(\n[` + \"\\\")\"", stack.LanguageGo},
+		{"printf(\"%d)\\n\", v[0]); /* { */ c = ']';", stack.LanguageC},
+		{`{"a": "[", "b": [1, {"c": "}"}]}`, stack.LanguageJSON},
+	}
+	for _, tc := range cases {
+		report := stack.AnalyzeBrackets(tc.source, tc.language)
+		if !report.Balanced() || len(report.Fix) != 0 {
+			t.Errorf("%s %q: esperado balanceado, problemas %v, correção %v",
+				tc.language.Name, tc.source, report.Issues, report.Fix)
+		}
+	}
+}
+
+func TestAnalyzeBracketsIssues(t *testing.T) {
+	cases := []struct {
+		source   string
+		language stack.Language
+		want     []string // "linha:coluna tipo"
+	}{
+		{"(()", stack.LanguageText, []string{"1:1 0"}},
+		{"())", stack.LanguageText, []string{"1:3 1"}},
+		{"( ]", stack.LanguageText, []string{"1:1 0", "1:3 2"}},
+		{"([)]", stack.LanguageText, []string{"1:3 0", "1:4 1"}},
+		{"ação(\n  x", stack.LanguageText, []string{"1:5 0"}},
+		{"x = \"abc(\n)", stack.LanguageC, []string{"1:5 3", "2:1 1"}},
+		{"/* (\n", stack.LanguageGo, []string{"1:1 4"}},
+		{"{\"a\": [1, 2}", stack.LanguageJSON, []string{"1:12 0"}},
+		// Em texto puro as aspas não protegem nada
+		{"\"(\"", stack.LanguageText, []string{"1:2 0"}},
+	}
+	for _, tc := range cases {
+		report := stack.AnalyzeBrackets(tc.source, tc.language)
+		got := []string{}
+		for _, issue := range report.Issues {
+			got = append(got, issue.Pos.String()+" "+string(rune('0'+issue.Kind)))
+		}
+		if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("%s %q: problemas %v, esperado %v (%v)", tc.language.Name, tc.source, got, tc.want, report.Issues)
+		}
+	}
+}
+
+func TestBracketFix(t *testing.T) {
+	cases := []struct {
+		source   string
+		language stack.Language
+		fixed    string
+	}{
+		{"(()", stack.LanguageText, "(())"},
+		{"())", stack.LanguageText, "()"},
+		{"{[(", stack.LanguageText, "{[()]}"},
+		{"a + b) * c", stack.LanguageText, "a + b * c"},
+		{"f(a,\n  b\n", stack.LanguageText, "f(a,\n  b)\n"},
+		{"f(a // comentário (\n", stack.LanguageGo, "f(a) // comentário (\n"},
+		{"x = (a[0] + 1;\n", stack.LanguageC, "x = (a[0] + 1;)\n"},
+		{"[1, 2, {\"a\": 3]", stack.LanguageJSON, "[1, 2, {\"a\": 3}]"},
+	}
+	for _, tc := range cases {
+		report := stack.AnalyzeBrackets(tc.source, tc.language)
+		if got := stack.ApplyBracketFix(tc.source, report.Fix); got != tc.fixed {
+			t.Errorf("%q corrigido para %q com %v, esperado %q", tc.source, got, report.Fix, tc.fixed)
+		}
+		if !report.MinimalFix {
+			t.Errorf("%q: correção deveria ser mínima", tc.source)
+		}
+	}
+}
+
+// minimalEdits resolve o problema direto sobre a sequência inteira, sem o
+// cancelamento de pares adjacentes, para conferir que ele não perde o ótimo
+func minimalEdits(s string) int {
+	n := len(s)
+	cost := make([][]int, n+1)
+	for i := range cost {
+		cost[i] = make([]int, n+1)
+	}
+	at := func(i, j int) int {
+		if i > j {
+			return 0
+		}
+		return cost[i][j]
+	}
+	pairs := map[byte]byte{'(': ')', '[': ']', '{': '}'}
+	for i := n - 1; i >= 0; i-- {
+		for j := i; j < n; j++ {
+			best := 1 + at(i+1, j)
+			if closer, ok := pairs[s[i]]; ok {
+				for k := i + 1; k <= j; k++ {
+					if s[k] == closer {
+						best = min(best, at(i+1, k-1)+at(k+1, j))
+					}
+				}
+			}
+			cost[i][j] = best
+		}
+	}
+	return at(0, n-1)
+}
+
+func TestBracketFixIsMinimal(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 7))
+	alphabet := "()[]{}"
+	for trial := 0; trial < 500; trial++ {
+		var builder strings.Builder
+		for i := rng.IntN(14); i > 0; i-- {
+			builder.WriteByte(alphabet[rng.IntN(len(alphabet))])
+		}
+		source := builder.String()
+		
+		report := stack.AnalyzeBrackets(source, stack.LanguageText)
+		fixed := stack.ApplyBracketFix(source, report.Fix)
+		if after := stack.AnalyzeBrackets(fixed, stack.LanguageText); !after.Balanced() {
+			t.Fatalf("%q corrigido para %q ainda tem problemas: %v", source, fixed, after.Issues)
+		}
+		if want := minimalEdits(source); len(report.Fix) != want {
+			t.Fatalf("%q: %d edições %v, o mínimo é %d", source, len(report.Fix), report.Fix, want)
+		}
+		if report.Balanced() != (len(report.Fix) == 0) {
+			t.Fatalf("%q: Balanced()=%v com %d edições", source, report.Balanced(), len(report.Fix))
+		}
+	}
+}
+
+func TestBracketFixGreedyFallback(t *testing.T) {
+	defer func(limit int) { stack.MaxMinimalFixBrackets = limit }(stack.MaxMinimalFixBrackets)
+	stack.MaxMinimalFixBrackets = 2
+	
+	source := "(a] [b) {c"
+	report := stack.AnalyzeBrackets(source, stack.LanguageText)
+	if report.MinimalFix {
+		t.Errorf("resíduo acima do limite deveria usar a correção gulosa")
+	}
+	fixed := stack.ApplyBracketFix(source, report.Fix)
+	if after := stack.AnalyzeBrackets(fixed, stack.LanguageText); !after.Balanced() {
+		t.Errorf("correção gulosa %q não balanceou: %v", fixed, after.Issues)
+	}
+}
+
+func TestBracketMessagesInEnglish(t *testing.T) {
+	english(t)
+	cases := []struct {
+		source   string
+		language stack.Language
+		want     []string
+	}{
+		{"())", stack.LanguageText, []string{"1:3: ')' closes with no pending opening"}},
+		{"( ]", stack.LanguageText, []string{
+			"1:1: '(' opened here was not closed by the end of the text",
+			"1:3: ']' does not match '(' opened at 1:1 (expected ')')",
+		}},
+		{"([)]", stack.LanguageText, []string{
+			"1:3: '[' opened at 1:2 was not closed before ')'",
+			"1:4: ']' closes with no pending opening",
+		}},
+		{"x = \"a\n", stack.LanguageC, []string{"1:5: unterminated string \" on this line"}},
+		{"/* (", stack.LanguageGo, []string{`1:1: comment "/*" without "*/"; brackets up to the end were ignored`}},
+	}
+	for _, tc := range cases {
+		report := stack.AnalyzeBrackets(tc.source, tc.language)
+		got := []string{}
+		for _, issue := range report.Issues {
+			got = append(got, issue.String())
+		}
+		if strings.Join(got, "|") != strings.Join(tc.want, "|") {
+			t.Errorf("%q: %q, esperado %q", tc.source, got, tc.want)
+		}
+	}
+	
+	report := stack.AnalyzeBrackets("(a", stack.LanguageText)
+	if len(report.Fix) != 1 || report.Fix[0].String() != "insert ')' at 1:3" {
+		t.Errorf("inserção: %v", report.Fix)
+	}
+	report = stack.AnalyzeBrackets("a)", stack.LanguageText)
+	if len(report.Fix) != 1 || report.Fix[0].String() != "remove ')' at 1:2" {
+		t.Errorf("remoção: %v", report.Fix)
+	}
+}
+
+func TestLanguageForFile(t *testing.T) {
+	cases := map[string]string{
+		"main.go": "go", "lib.H": "c", "x.cpp": "c", "dados.json": "json", "notas.txt": "texto",
+	}
+	for path, want := range cases {
+		if got := stack.LanguageForFile(path).Name; got != want {
+			t.Errorf("LanguageForFile(%q) = %s, esperado %s", path, got, want)
+		}
+	}
+	if language, ok := stack.LanguageByName("JSON"); !ok || language.Name != "json" {
+		t.Errorf("LanguageByName(JSON) = %v, %v", language, ok)
+	}
+}
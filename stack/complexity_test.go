@@ -0,0 +1,68 @@
+package stack_test
+
+import (
+	"testing"
+
+	"dca3503/instrument"
+	"dca3503/stack"
+)
+
+// Cada caso confere, contando operações elementares, uma complexidade
+// anotada nos comentários das implementações
+func TestStackComplexity(t *testing.T) {
+	cases := []struct {
+		name     string
+		metric   instrument.Metric
+		expected instrument.Growth
+		run      instrument.Run
+	}{
+		{"ArrayStack.Push amortizado", instrument.Moves, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				s := stack.NewArrayStack(1)
+				s.SetCounters(c)
+				for i := 0; i < n; i++ {
+					s.Push(i)
+				}
+				return n
+			}},
+		{"ArrayStack.Push redimensionamentos", instrument.Resizes, instrument.Logarithmic,
+			func(n int, c *instrument.Counters) int {
+				s := stack.NewArrayStack(1)
+				s.SetCounters(c)
+				for i := 0; i < n; i++ {
+					s.Push(i)
+				}
+				return 1
+			}},
+		{"LinkedStack.Push", instrument.Total, instrument.Constant,
+			func(n int, c *instrument.Counters) int {
+				s := stack.NewLinkedStack()
+				s.SetCounters(c)
+				for i := 0; i < n; i++ {
+					s.Push(i)
+				}
+				return n
+			}},
+		{"LinkedStack.Contains", instrument.Traversals, instrument.Linear,
+			func(n int, c *instrument.Counters) int {
+				s := stack.NewLinkedStack()
+				for i := 0; i < n; i++ {
+					s.Push(i)
+				}
+				s.SetCounters(c)
+				s.Contains(-1)
+				return 1
+			}},
+	}
+	
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			samples := instrument.Measure(nil, tc.run)
+			fit := instrument.FitGrowth(samples, tc.metric)
+			if fit.Growth != tc.expected {
+				t.Errorf("esperado O(%s), medido %s\n%s", tc.expected, fit,
+					instrument.Report(tc.name, samples, tc.metric))
+			}
+		})
+	}
+}
@@ -0,0 +1,626 @@
+package stack
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"dca3503/errs"
+)
+
+// ============================================================================
+// EXPRESSÕES INFIXAS - TOKENIZAÇÃO, SHUNTING-YARD E AVALIAÇÃO
+// ============================================================================
+
+// Pipeline completo de uma expressão como "2 * max(x, 3) ^ 2 - -1":
+// 1. Tokenize: texto -> tokens com posição (números, variáveis, operadores...)
+// 2. ToPostfix: tokens infixos -> pós-fixos com o algoritmo shunting-yard
+// 3. EvaluateTokens: avalia os tokens pós-fixos com um ambiente de variáveis
+//
+// Precedência (da menor para a maior):
+//   + -        binários, associativos à esquerda
+//   * / %      binários, associativos à esquerda
+//   - +        unários (prefixos)
+//   ^          binário, associativo à direita (2^3^2 = 2^9)
+// Assim -2^2 = -(2^2) = -4, como na matemática
+//
+// As duas fases usam as pilhas do pacote. Como Stack guarda int, a pilha de
+// operadores guarda índices no slice de tokens e a pilha de operandos guarda
+// índices no slice de valores float64
+
+// TokenKind identifica a categoria de um token
+type TokenKind int
+
+const (
+	TokenNumber     TokenKind = iota // 3, 2.5, .5, 1e-3
+	TokenVariable                    // x, taxa_juros
+	TokenFunction                    // min, max, abs (identificador seguido de '(')
+	TokenOperator                    // + - * / % ^ binários
+	TokenUnary                       // - ou + prefixado
+	TokenLeftParen                   // (
+	TokenRightParen                  // )
+	TokenComma                       // , separador de argumentos
+)
+
+// Token é um trecho da expressão com sua posição no texto original
+type Token struct {
+	Kind  TokenKind
+	Text  string  // Trecho original ("3.5", "max", "-")
+	Value float64 // Valor numérico (apenas TokenNumber)
+	Pos   int     // Posição do primeiro caractere (1 = início da expressão)
+	Arity int     // Número de argumentos (TokenFunction, preenchido por ToPostfix)
+}
+
+// String retorna o token como aparece na notação pós-fixa
+// Operadores unários viram "neg"/"pos" e funções mostram a aridade ("max/3")
+func (t Token) String() string {
+	switch t.Kind {
+	case TokenUnary:
+		if t.Text == "-" {
+			return "neg"
+		}
+		return "pos"
+	case TokenFunction:
+		return fmt.Sprintf("%s/%d", t.Text, t.Arity)
+	default:
+		return t.Text
+	}
+}
+
+// ExpressionError descreve um erro de sintaxe ou de avaliação com a posição
+// exata do token responsável
+// Message tem o texto nos dois idiomas (errs.New): a posição e a descrição
+// seguem errs.SetLanguage mesmo depois de o erro ser criado
+type ExpressionError struct {
+	Pos     int    // Posição do token (1 = primeiro caractere)
+	Token   string // Trecho do token ("" quando o erro é no fim da expressão)
+	Message error  // Descrição do problema
+}
+
+// Error formata o erro com a posição e o token
+func (e *ExpressionError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf(errs.Message("posição %d (fim da expressão): %v", "position %d (end of expression): %v"), e.Pos, e.Message)
+	}
+	return fmt.Sprintf(errs.Message("posição %d (%q): %v", "position %d (%q): %v"), e.Pos, e.Token, e.Message)
+}
+
+// errorAt cria um ExpressionError apontando para o token
+// pt e en são formatos de fmt com os mesmos argumentos, como em errs.New
+func errorAt(token Token, pt, en string, args ...any) *ExpressionError {
+	return &ExpressionError{Pos: token.Pos, Token: token.Text, Message: errs.New(nil, pt, en, args...)}
+}
+
+// errorAtEnd cria um ExpressionError apontando para depois do último token
+func errorAtEnd(tokens []Token, pt, en string, args ...any) *ExpressionError {
+	pos := 1
+	if len(tokens) > 0 {
+		last := tokens[len(tokens)-1]
+		pos = last.Pos + len([]rune(last.Text))
+	}
+	return &ExpressionError{Pos: pos, Message: errs.New(nil, pt, en, args...)}
+}
+
+// ============================================================================
+// FUNÇÕES DISPONÍVEIS
+// ============================================================================
+
+// function descreve uma função nomeada e quantos argumentos ela aceita
+type function struct {
+	minArgs int                     // Mínimo de argumentos
+	maxArgs int                     // Máximo de argumentos (-1 = sem limite)
+	apply   func([]float64) float64 // Recebe os argumentos na ordem escrita
+}
+
+// functions são as funções reconhecidas pelo conversor e pelo avaliador
+var functions = map[string]function{
+	"abs": {1, 1, func(args []float64) float64 { return math.Abs(args[0]) }},
+	"min": {1, -1, func(args []float64) float64 {
+		result := args[0]
+		for _, value := range args[1:] {
+			result = math.Min(result, value)
+		}
+		return result
+	}},
+	"max": {1, -1, func(args []float64) float64 {
+		result := args[0]
+		for _, value := range args[1:] {
+			result = math.Max(result, value)
+		}
+		return result
+	}},
+}
+
+// ============================================================================
+// TOKENIZAÇÃO
+// ============================================================================
+
+// Tokenize divide a expressão em tokens
+// Decide aqui o que depende só do contexto imediato:
+// - '-'/'+' é unário quando aparece onde se espera um operando
+// - identificador seguido de '(' é função; caso contrário, variável
+// Complexidade: O(n)
+func Tokenize(expression string) ([]Token, error) {
+	runes := []rune(expression)
+	tokens := []Token{}
+	
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := i
+		
+		switch {
+		case unicode.IsSpace(r):
+			i++
+			continue
+		case unicode.IsDigit(r) || r == '.':
+			i = scanNumber(runes, i)
+			text := string(runes[start:i])
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, &ExpressionError{Pos: start + 1, Token: text, Message: errs.New(nil, "número inválido", "invalid number")}
+			}
+			tokens = append(tokens, Token{Kind: TokenNumber, Text: text, Value: value, Pos: start + 1})
+		case unicode.IsLetter(r) || r == '_':
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			kind := TokenVariable
+			if next := skipSpaces(runes, i); next < len(runes) && runes[next] == '(' {
+				kind = TokenFunction
+			}
+			tokens = append(tokens, Token{Kind: kind, Text: string(runes[start:i]), Pos: start + 1})
+		case strings.ContainsRune("+-*/%^", r):
+			kind := TokenOperator
+			if (r == '-' || r == '+') && expectsOperand(tokens) {
+				kind = TokenUnary
+			}
+			i++
+			tokens = append(tokens, Token{Kind: kind, Text: string(r), Pos: start + 1})
+		case r == '(':
+			i++
+			tokens = append(tokens, Token{Kind: TokenLeftParen, Text: "(", Pos: start + 1})
+		case r == ')':
+			i++
+			tokens = append(tokens, Token{Kind: TokenRightParen, Text: ")", Pos: start + 1})
+		case r == ',':
+			i++
+			tokens = append(tokens, Token{Kind: TokenComma, Text: ",", Pos: start + 1})
+		default:
+			return nil, &ExpressionError{Pos: start + 1, Token: string(r), Message: errs.New(nil, "caractere inesperado", "unexpected character")}
+		}
+	}
+	
+	return tokens, nil
+}
+
+// scanNumber avança sobre dígitos, ponto decimal e expoente (1.5e-3)
+func scanNumber(runes []rune, i int) int {
+	for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+		i++
+	}
+	// Expoente só conta se vier seguido de dígito: "2e" é 2 seguido da variável e
+	if i < len(runes) && (runes[i] == 'e' || runes[i] == 'E') {
+		j := i + 1
+		if j < len(runes) && (runes[j] == '+' || runes[j] == '-') {
+			j++
+		}
+		if j < len(runes) && unicode.IsDigit(runes[j]) {
+			i = j
+			for i < len(runes) && unicode.IsDigit(runes[i]) {
+				i++
+			}
+		}
+	}
+	return i
+}
+
+// skipSpaces retorna o índice do próximo caractere que não é espaço
+func skipSpaces(runes []rune, i int) int {
+	for i < len(runes) && unicode.IsSpace(runes[i]) {
+		i++
+	}
+	return i
+}
+
+// expectsOperand verifica se o próximo token deve ser um operando
+// (início da expressão ou logo após operador, '(' ou ',')
+func expectsOperand(tokens []Token) bool {
+	if len(tokens) == 0 {
+		return true
+	}
+	switch tokens[len(tokens)-1].Kind {
+	case TokenOperator, TokenUnary, TokenLeftParen, TokenComma:
+		return true
+	default:
+		return false
+	}
+}
+
+// ============================================================================
+// SHUNTING-YARD (INFIXA -> PÓS-FIXA)
+// ============================================================================
+
+// precedence retorna a prioridade de um operador (maior = liga mais forte)
+func precedence(token Token) int {
+	if token.Kind == TokenUnary {
+		return 3
+	}
+	switch token.Text {
+	case "+", "-":
+		return 1
+	case "*", "/", "%":
+		return 2
+	case "^":
+		return 4
+	default:
+		return 0
+	}
+}
+
+// rightAssociative indica operadores agrupados da direita para a esquerda
+func rightAssociative(token Token) bool {
+	return token.Kind == TokenOperator && token.Text == "^"
+}
+
+// ToPostfix converte tokens infixos em pós-fixos (algoritmo shunting-yard)
+// Além da conversão, valida a sintaxe e aponta o token exato de cada erro
+// Complexidade: O(n) - cada token entra e sai da pilha no máximo uma vez
+// Pseudocódigo:
+// 1. Operando: vai direto para a saída
+// 2. Função, '(' e operador unário: empilha
+// 3. Operador binário: desempilha para a saída enquanto o topo tiver
+//    precedência maior (ou igual, se o novo for associativo à esquerda); empilha
+// 4. ',': desempilha até o '(' da chamada e conta mais um argumento
+// 5. ')': desempilha até o '('; se embaixo houver função, ela vai para a saída
+// 6. No fim: desempilha tudo; um '(' restante não foi fechado
+func ToPostfix(tokens []Token) ([]Token, error) {
+	output := make([]Token, 0, len(tokens))
+	operators := NewArrayStack(len(tokens) + 1) // Índices em tokens
+	arguments := NewArrayStack(len(tokens) + 1) // Para cada '(' aberto: argumentos (0 = agrupamento)
+	expectOperand := true
+	
+	// popUntilParen move operadores para a saída até encontrar '(' (que fica na pilha)
+	popUntilParen := func() {
+		for !operators.IsEmpty() {
+			index, _ := operators.Peek()
+			if tokens[index].Kind == TokenLeftParen {
+				return
+			}
+			operators.Pop()
+			output = append(output, tokens[index])
+		}
+	}
+	
+	for i, token := range tokens {
+		switch token.Kind {
+		case TokenNumber, TokenVariable:
+			if !expectOperand {
+				return nil, errorAt(token, "operador esperado antes de %q", "operator expected before %q", token.Text)
+			}
+			output = append(output, token)
+			expectOperand = false
+		
+		case TokenFunction:
+			if !expectOperand {
+				return nil, errorAt(token, "operador esperado antes de %q", "operator expected before %q", token.Text)
+			}
+			if _, ok := functions[token.Text]; !ok {
+				return nil, errorAt(token, "função desconhecida", "unknown function")
+			}
+			operators.Push(i)
+		
+		case TokenUnary:
+			operators.Push(i) // Prefixo: nada a desempilhar ainda
+		
+		case TokenOperator:
+			if expectOperand {
+				return nil, errorAt(token, "operando esperado antes do operador", "operand expected before the operator")
+			}
+			for !operators.IsEmpty() {
+				index, _ := operators.Peek()
+				top := tokens[index]
+				if top.Kind == TokenLeftParen {
+					break
+				}
+				if precedence(top) < precedence(token) ||
+					(precedence(top) == precedence(token) && rightAssociative(token)) {
+					break
+				}
+				operators.Pop()
+				output = append(output, top)
+			}
+			operators.Push(i)
+			expectOperand = true
+		
+		case TokenLeftParen:
+			if !expectOperand {
+				return nil, errorAt(token, "operador esperado antes de '('", "operator expected before '('")
+			}
+			if i > 0 && tokens[i-1].Kind == TokenFunction {
+				arguments.Push(1)
+			} else {
+				arguments.Push(0)
+			}
+			operators.Push(i)
+		
+		case TokenRightParen:
+			count, err := arguments.Pop()
+			if err != nil {
+				return nil, errorAt(token, "parêntese fechado sem abertura correspondente", "closing parenthesis without a matching opening")
+			}
+			emptyCall := count == 1 && tokens[i-1].Kind == TokenLeftParen
+			if expectOperand && !emptyCall {
+				return nil, errorAt(token, "operando esperado antes de ')'", "operand expected before ')'")
+			}
+			
+			popUntilParen()
+			operators.Pop() // Descarta o '('
+			
+			if count > 0 {
+				index, _ := operators.Pop()
+				call := tokens[index]
+				call.Arity = count
+				if emptyCall {
+					call.Arity = 0
+				}
+				if err := checkArity(call); err != nil {
+					return nil, err
+				}
+				output = append(output, call)
+			}
+			expectOperand = false
+		
+		case TokenComma:
+			count, err := arguments.Peek()
+			if err != nil || count == 0 {
+				return nil, errorAt(token, "vírgula fora de chamada de função", "comma outside a function call")
+			}
+			if expectOperand {
+				return nil, errorAt(token, "operando esperado antes de ','", "operand expected before ','")
+			}
+			popUntilParen()
+			arguments.Pop()
+			arguments.Push(count + 1)
+			expectOperand = true
+		}
+	}
+	
+	if expectOperand {
+		if len(tokens) == 0 {
+			return nil, errorAtEnd(tokens, "expressão vazia", "empty expression")
+		}
+		return nil, errorAtEnd(tokens, "operando esperado", "operand expected")
+	}
+	
+	for !operators.IsEmpty() {
+		index, _ := operators.Pop()
+		if tokens[index].Kind == TokenLeftParen {
+			return nil, errorAt(tokens[index], "parêntese não fechado", "unclosed parenthesis")
+		}
+		output = append(output, tokens[index])
+	}
+	
+	return output, nil
+}
+
+// checkArity verifica se a função recebeu um número aceitável de argumentos
+func checkArity(call Token) error {
+	fn := functions[call.Text]
+	if call.Arity < fn.minArgs {
+		return errorAt(call, "%s exige pelo menos %d argumento(s), recebeu %d", "%s needs at least %d argument(s), got %d", call.Text, fn.minArgs, call.Arity)
+	}
+	if fn.maxArgs >= 0 && call.Arity > fn.maxArgs {
+		return errorAt(call, "%s aceita no máximo %d argumento(s), recebeu %d", "%s takes at most %d argument(s), got %d", call.Text, fn.maxArgs, call.Arity)
+	}
+	return nil
+}
+
+// InfixToPostfix tokeniza e converte uma expressão infixa
+// Exemplo: "3 + 4 * 2" -> [3 4 2 * +]
+func InfixToPostfix(expression string) ([]Token, error) {
+	tokens, err := Tokenize(expression)
+	if err != nil {
+		return nil, err
+	}
+	return ToPostfix(tokens)
+}
+
+// ============================================================================
+// AVALIAÇÃO
+// ============================================================================
+
+// EvaluateTokens avalia tokens em notação pós-fixa
+// variables fornece o valor de cada TokenVariable (pode ser nil sem variáveis)
+// Complexidade: O(n)
+// Pseudocódigo:
+// 1. Número/variável: empilha o valor
+// 2. Operador: desempilha os operandos, aplica e empilha o resultado
+// 3. Função com aridade k: desempilha k valores (em ordem inversa) e aplica
+// 4. No fim deve restar exatamente um valor
+func EvaluateTokens(postfix []Token, variables map[string]float64) (float64, error) {
+	values := make([]float64, 0, len(postfix))
+	operands := NewArrayStack(len(postfix) + 1) // Índices em values
+	
+	push := func(value float64) {
+		values = append(values, value)
+		operands.Push(len(values) - 1)
+	}
+	pop := func(token Token) (float64, error) {
+		index, err := operands.Pop()
+		if err != nil {
+			return 0, errorAt(token, "operandos insuficientes", "not enough operands")
+		}
+		return values[index], nil
+	}
+	
+	for _, token := range postfix {
+		switch token.Kind {
+		case TokenNumber:
+			push(token.Value)
+		
+		case TokenVariable:
+			value, ok := variables[token.Text]
+			if !ok {
+				return 0, errorAt(token, "variável não definida", "undefined variable")
+			}
+			push(value)
+		
+		case TokenUnary:
+			value, err := pop(token)
+			if err != nil {
+				return 0, err
+			}
+			if token.Text == "-" {
+				value = -value
+			}
+			push(value)
+		
+		case TokenOperator:
+			b, err := pop(token)
+			if err != nil {
+				return 0, err
+			}
+			a, err := pop(token)
+			if err != nil {
+				return 0, err
+			}
+			result, err := applyOperator(token, a, b)
+			if err != nil {
+				return 0, err
+			}
+			push(result)
+		
+		case TokenFunction:
+			fn, ok := functions[token.Text]
+			if !ok {
+				return 0, errorAt(token, "função desconhecida", "unknown function")
+			}
+			if err := checkArity(token); err != nil {
+				return 0, err
+			}
+			args := make([]float64, token.Arity)
+			for k := token.Arity - 1; k >= 0; k-- {
+				value, err := pop(token)
+				if err != nil {
+					return 0, err
+				}
+				args[k] = value
+			}
+			push(fn.apply(args))
+		
+		default:
+			return 0, errorAt(token, "token inesperado em expressão pós-fixa", "unexpected token in postfix expression")
+		}
+	}
+	
+	if operands.Size() != 1 {
+		return 0, errorAtEnd(postfix, "expressão incompleta: %d valores na pilha", "incomplete expression: %d values on the stack", operands.Size())
+	}
+	index, _ := operands.Pop()
+	return values[index], nil
+}
+
+// applyOperator aplica um operador binário
+// Divisão e resto por zero, e potências sem resultado real, viram erro no operador
+func applyOperator(token Token, a, b float64) (float64, error) {
+	switch token.Text {
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	case "/":
+		if b == 0 {
+			return 0, errorAt(token, "divisão por zero", "division by zero")
+		}
+		return a / b, nil
+	case "%":
+		if b == 0 {
+			return 0, errorAt(token, "resto de divisão por zero", "remainder of division by zero")
+		}
+		return math.Mod(a, b), nil
+	case "^":
+		result := math.Pow(a, b)
+		if math.IsNaN(result) {
+			return 0, errorAt(token, "%g ^ %g não tem resultado real", "%g ^ %g has no real result", a, b)
+		}
+		return result, nil
+	default:
+		return 0, errorAt(token, "operador desconhecido", "unknown operator")
+	}
+}
+
+// ============================================================================
+// EXPRESSÃO COMPILADA
+// ============================================================================
+
+// Expression guarda uma expressão já convertida para pós-fixa, para ser
+// avaliada várias vezes com ambientes diferentes sem converter de novo
+type Expression struct {
+	source  string  // Texto original
+	postfix []Token // Tokens em notação pós-fixa
+}
+
+// Compile tokeniza e converte a expressão, reportando erros de sintaxe
+// Complexidade: O(n)
+func Compile(expression string) (*Expression, error) {
+	postfix, err := InfixToPostfix(expression)
+	if err != nil {
+		return nil, err
+	}
+	return &Expression{source: expression, postfix: postfix}, nil
+}
+
+// Evaluate avalia a expressão com os valores das variáveis
+// Complexidade: O(n)
+func (e *Expression) Evaluate(variables map[string]float64) (float64, error) {
+	return EvaluateTokens(e.postfix, variables)
+}
+
+// Postfix retorna uma cópia dos tokens em notação pós-fixa
+func (e *Expression) Postfix() []Token {
+	result := make([]Token, len(e.postfix))
+	copy(result, e.postfix)
+	return result
+}
+
+// Variables retorna os nomes das variáveis usadas, sem repetição, na ordem em que aparecem
+func (e *Expression) Variables() []string {
+	seen := make(map[string]bool)
+	names := []string{}
+	for _, token := range e.postfix {
+		if token.Kind == TokenVariable && !seen[token.Text] {
+			seen[token.Text] = true
+			names = append(names, token.Text)
+		}
+	}
+	return names
+}
+
+// Source retorna o texto original da expressão
+func (e *Expression) Source() string {
+	return e.source
+}
+
+// String retorna a forma pós-fixa, com tokens separados por espaço
+// Exemplo: "-x ^ 2 + max(1, y)" -> "x 2 ^ neg 1 y max/2 +"
+func (e *Expression) String() string {
+	parts := make([]string, len(e.postfix))
+	for i, token := range e.postfix {
+		parts[i] = token.String()
+	}
+	return strings.Join(parts, " ")
+}
+
+// Evaluate compila e avalia uma expressão infixa em um único passo
+// Exemplo: Evaluate("2 * (x + 1)", map[string]float64{"x": 3}) = 8
+func Evaluate(expression string, variables map[string]float64) (float64, error) {
+	compiled, err := Compile(expression)
+	if err != nil {
+		return 0, err
+	}
+	return compiled.Evaluate(variables)
+}
@@ -0,0 +1,190 @@
+package stack_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	
+	"dca3503/errs"
+	"dca3503/stack"
+)
+
+func TestEvaluate(t *testing.T) {
+	variables := map[string]float64{"x": 3, "y": -2, "taxa": 0.5}
+	cases := []struct {
+		expression string
+		want       float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 - 4 - 3", 3},
+		{"16 / 4 / 2", 2},
+		{"2 ^ 3 ^ 2", 512},
+		{"(2 ^ 3) ^ 2", 64},
+		{"-2 ^ 2", -4},
+		{"(-2) ^ 2", 4},
+		{"2 ^ -1", 0.5},
+		{"--3", 3},
+		{"-x * -y", -6},
+		{"+x - -1", 4},
+		{"2 * -(x + 1)", -8},
+		{"7 % 3", 1},
+		{"7.5 % 2", 1.5},
+		{"-7 % 3", -1},
+		{"1.5e2 + .5", 150.5},
+		{"x * taxa", 1.5},
+		{"abs(y)", 2},
+		{"min(4, x, 7)", 3},
+		{"max(1)", 1},
+		{"max(x, y * -10, abs(-4)) - min(1, 2)", 19},
+		{"2 * max(x, 3) ^ 2 - -1", 19},
+		{"abs(-(1 + 2) * 2)", 6},
+	}
+	for _, tc := range cases {
+		got, err := stack.Evaluate(tc.expression, variables)
+		if err != nil {
+			t.Errorf("Evaluate(%q): erro inesperado %v", tc.expression, err)
+			continue
+		}
+		if math.Abs(got-tc.want) > 1e-9 {
+			t.Errorf("Evaluate(%q) = %g, esperado %g", tc.expression, got, tc.want)
+		}
+	}
+}
+
+func TestInfixToPostfix(t *testing.T) {
+	cases := []struct {
+		expression string
+		want       string
+	}{
+		{"3 + 4 * 2", "3 4 2 * +"},
+		{"(3 + 4) * 2", "3 4 + 2 *"},
+		{"a - b + c", "a b - c +"},
+		{"2 ^ 3 ^ 2", "2 3 2 ^ ^"},
+		{"-x ^ 2 + max(1, y)", "x 2 ^ neg 1 y max/2 +"},
+		{"min(a, b, c) % 2", "a b c min/3 2 %"},
+	}
+	for _, tc := range cases {
+		compiled, err := stack.Compile(tc.expression)
+		if err != nil {
+			t.Errorf("Compile(%q): %v", tc.expression, err)
+			continue
+		}
+		if got := compiled.String(); got != tc.want {
+			t.Errorf("pós-fixa de %q = %q, esperado %q", tc.expression, got, tc.want)
+		}
+	}
+}
+
+func TestExpressionErrorPositions(t *testing.T) {
+	cases := []struct {
+		expression string
+		variables  map[string]float64
+		pos        int
+		fragment   string
+	}{
+		{"", nil, 1, "expressão vazia"},
+		{"1 +", nil, 4, "operando esperado"},
+		{"1 + * 2", nil, 5, "operando esperado"},
+		{"2 3", nil, 3, "operador esperado"},
+		{"(1 + 2", nil, 1, "parêntese não fechado"},
+		{"1 + 2)", nil, 6, "sem abertura"},
+		{"()", nil, 2, "operando esperado"},
+		{"1 # 2", nil, 3, "caractere inesperado"},
+		{"1..2 + 1", nil, 1, "número inválido"},
+		{"foo(1)", nil, 1, "função desconhecida"},
+		{"abs(1, 2)", nil, 1, "no máximo 1"},
+		{"max()", nil, 1, "pelo menos 1"},
+		{"1, 2", nil, 2, "vírgula fora"},
+		{"max(1,)", nil, 7, "operando esperado"},
+		{"x + 1", nil, 1, "variável não definida"},
+		{"4 / (x - 2)", map[string]float64{"x": 2}, 3, "divisão por zero"},
+		{"4 % 0", nil, 3, "resto de divisão por zero"},
+		{"(-8) ^ 0.5", nil, 6, "resultado real"},
+	}
+	for _, tc := range cases {
+		_, err := stack.Evaluate(tc.expression, tc.variables)
+		var exprErr *stack.ExpressionError
+		if !errors.As(err, &exprErr) {
+			t.Errorf("Evaluate(%q) = %v, esperado *ExpressionError", tc.expression, err)
+			continue
+		}
+		if exprErr.Pos != tc.pos || !strings.Contains(exprErr.Message.Error(), tc.fragment) {
+			t.Errorf("Evaluate(%q): %v, esperado posição %d e mensagem com %q",
+				tc.expression, err, tc.pos, tc.fragment)
+		}
+	}
+}
+
+// english troca o idioma dos erros durante o teste e restaura o anterior no fim
+func english(t *testing.T) {
+	previous := errs.CurrentLanguage()
+	errs.SetLanguage(errs.English)
+	t.Cleanup(func() { errs.SetLanguage(previous) })
+}
+
+func TestExpressionErrorsInEnglish(t *testing.T) {
+	// O erro é criado em português; a troca de idioma vale para ele também
+	_, err := stack.Evaluate("max(1, 2) / (x - 2)", map[string]float64{"x": 2})
+	english(t)
+	cases := []struct {
+		expression string
+		variables  map[string]float64
+		want       string
+	}{
+		{"1+", nil, "position 3 (end of expression): operand expected"},
+		{"", nil, "position 1 (end of expression): empty expression"},
+		{"1 # 2", nil, `position 3 ("#"): unexpected character`},
+		{"2 3", nil, `position 3 ("3"): operator expected before "3"`},
+		{"(1 + 2", nil, `position 1 ("("): unclosed parenthesis`},
+		{"abs(1, 2)", nil, `position 1 ("abs"): abs takes at most 1 argument(s), got 2`},
+		{"x + 1", nil, `position 1 ("x"): undefined variable`},
+		{"(-8) ^ 0.5", nil, `position 6 ("^"): -8 ^ 0.5 has no real result`},
+	}
+	for _, tc := range cases {
+		if _, err := stack.Evaluate(tc.expression, tc.variables); err == nil || err.Error() != tc.want {
+			t.Errorf("Evaluate(%q) = %v, esperado %q", tc.expression, err, tc.want)
+		}
+	}
+	if err == nil || err.Error() != `position 11 ("/"): division by zero` {
+		t.Errorf("erro criado antes da troca de idioma: %v", err)
+	}
+}
+
+func TestCompiledExpressionReuse(t *testing.T) {
+	compiled, err := stack.Compile("a * x ^ 2 + b * x + a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(compiled.Variables(), ","); got != "a,x,b" {
+		t.Errorf("Variables() = %s, esperado a,x,b", got)
+	}
+	for x := -2.0; x <= 2; x++ {
+		got, err := compiled.Evaluate(map[string]float64{"a": 1, "b": 2, "x": x})
+		if want := x*x + 2*x + 1; err != nil || got != want {
+			t.Errorf("x=%g: (%g, %v), esperado %g", x, got, err, want)
+		}
+	}
+}
+
+func TestEvaluatePostfixErrors(t *testing.T) {
+	cases := []struct {
+		expression []string
+		fragment   string
+	}{
+		{[]string{"1", "+"}, `token 2 ("+")`},
+		{[]string{"1", "0", "/"}, `token 3 ("/"): divisão por zero`},
+		{[]string{"1", "x", "+"}, `token 2 ("x")`},
+		{[]string{"1", "2"}, "sobraram 2"},
+	}
+	for _, tc := range cases {
+		_, err := stack.EvaluatePostfix(tc.expression)
+		if err == nil || !strings.Contains(err.Error(), tc.fragment) {
+			t.Errorf("EvaluatePostfix(%v) = %v, esperado erro com %q", tc.expression, err, tc.fragment)
+		}
+	}
+	if got, err := stack.EvaluatePostfix([]string{"3", "4", "+", "2", "*"}); err != nil || got != 14 {
+		t.Errorf("EvaluatePostfix(3 4 + 2 *) = (%d, %v), esperado 14", got, err)
+	}
+}
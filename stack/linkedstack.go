@@ -1,9 +1,13 @@
-package main
+package stack
 
 import (
 	"fmt"
-	"errors"
+	"iter"
 	"strings"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/links"
 )
 
 // ============================================================================
@@ -23,8 +27,9 @@ type StackNode struct {
 // - Uso dinâmico de memória (aloca conforme necessário)
 // - Cada elemento tem overhead de ponteiro
 type LinkedStack struct {
-	top  *StackNode // Ponteiro para o nó do topo
-	size int        // Contador de elementos
+	top      *StackNode           // Ponteiro para o nó do topo
+	size     int                  // Contador de elementos
+	counters *instrument.Counters // Contadores de operações (nil = desligado)
 }
 
 // NewLinkedStack cria uma nova instância de LinkedStack
@@ -35,6 +40,12 @@ func NewLinkedStack() *LinkedStack {
 	}
 }
 
+// SetCounters associa contadores de operações à pilha (nil desliga a contagem)
+// Conta nós alocados, saltos de ponteiro e comparações de busca
+func (s *LinkedStack) SetCounters(counters *instrument.Counters) {
+	s.counters = counters
+}
+
 // ============================================================================
 // IMPLEMENTAÇÃO DA INTERFACE STACK
 // ============================================================================
@@ -42,10 +53,12 @@ func NewLinkedStack() *LinkedStack {
 // Push adiciona um elemento no topo da pilha
 // Complexidade: O(1)
 func (s *LinkedStack) Push(element int) {
+	defer checkInvariants(s)
 	newNode := &StackNode{
 		data: element,
 		next: s.top,
 	}
+	s.counters.AddAllocations(1)
 	s.top = newNode
 	s.size++
 }
@@ -53,8 +66,9 @@ func (s *LinkedStack) Push(element int) {
 // Pop remove e retorna o elemento do topo da pilha
 // Complexidade: O(1)
 func (s *LinkedStack) Pop() (int, error) {
+	defer checkInvariants(s)
 	if s.IsEmpty() {
-		return 0, errors.New("pilha vazia: não é possível fazer pop")
+		return 0, errPopEmpty
 	}
 	
 	value := s.top.data
@@ -68,7 +82,7 @@ func (s *LinkedStack) Pop() (int, error) {
 // Complexidade: O(1)
 func (s *LinkedStack) Peek() (int, error) {
 	if s.IsEmpty() {
-		return 0, errors.New("pilha vazia: não há elemento no topo")
+		return 0, errPeekEmpty
 	}
 	return s.top.data, nil
 }
@@ -95,6 +109,7 @@ func (s *LinkedStack) IsFull() bool {
 // Clear remove todos os elementos da pilha
 // Complexidade: O(1) - apenas redefine ponteiros, GC limpa os nós
 func (s *LinkedStack) Clear() {
+	defer checkInvariants(s)
 	s.top = nil
 	s.size = 0
 	// O Garbage Collector do Go automaticamente limpa os nós órfãos
@@ -120,6 +135,16 @@ func (s *LinkedStack) ToSlice() []int {
 	return result
 }
 
+// Links fotografa os nós como estão na memória, a partir do topo, com os
+// ponteiros next reais (ver links.Snapshot)
+// Complexidade: O(n)
+func (s *LinkedStack) Links() links.Snapshot[int] {
+	return links.Walk([]*StackNode{s.top},
+		func(node *StackNode) int { return node.data },
+		func(node *StackNode) *StackNode { return node.next },
+		nil)
+}
+
 // String retorna uma representação em string da pilha
 // Complexidade: O(n)
 func (s *LinkedStack) String() string {
@@ -153,6 +178,7 @@ func (s *LinkedStack) String() string {
 // PushAll adiciona múltiplos elementos de uma vez
 // Complexidade: O(n) onde n é o número de elementos
 func (s *LinkedStack) PushAll(elements []int) {
+	defer checkInvariants(s)
 	for _, element := range elements {
 		s.Push(element)
 	}
@@ -161,12 +187,13 @@ func (s *LinkedStack) PushAll(elements []int) {
 // PopMultiple remove e retorna múltiplos elementos do topo
 // Complexidade: O(n) onde n é o número de elementos a remover
 func (s *LinkedStack) PopMultiple(count int) ([]int, error) {
+	defer checkInvariants(s)
 	if count <= 0 {
 		return []int{}, nil
 	}
 	
 	if count > s.size {
-		return nil, fmt.Errorf("não há elementos suficientes: solicitado %d, disponível %d", count, s.size)
+		return nil, errs.NotEnough(count, s.size)
 	}
 	
 	result := make([]int, count)
@@ -183,10 +210,12 @@ func (s *LinkedStack) PopMultiple(count int) ([]int, error) {
 func (s *LinkedStack) Contains(element int) bool {
 	current := s.top
 	for current != nil {
+		s.counters.AddComparisons(1)
 		if current.data == element {
 			return true
 		}
 		current = current.next
+		s.counters.AddTraversals(1)
 	}
 	return false
 }
@@ -199,10 +228,12 @@ func (s *LinkedStack) Search(element int) int {
 	position := 1
 	
 	for current != nil {
+		s.counters.AddComparisons(1)
 		if current.data == element {
 			return position
 		}
 		current = current.next
+		s.counters.AddTraversals(1)
 		position++
 	}
 	
@@ -261,6 +292,7 @@ func (s *LinkedStack) Equals(other *LinkedStack) bool {
 // Reverse inverte a ordem dos elementos na pilha
 // Complexidade: O(n)
 func (s *LinkedStack) Reverse() {
+	defer checkInvariants(s)
 	if s.size <= 1 {
 		return
 	}
@@ -274,6 +306,7 @@ func (s *LinkedStack) Reverse() {
 		prev = current
 		current = next
 	}
+	s.counters.AddTraversals(s.size)
 	
 	s.top = prev
 }
@@ -426,4 +459,33 @@ func (s *LinkedStack) ToReversedSlice() []int {
 	}
 	
 	return elements
-}
\ No newline at end of file
+}
+
+// ============================================================================
+// ITERADORES (RANGE-OVER-FUNC)
+// ============================================================================
+
+// All retorna um iterador sobre pares (posição, elemento) do topo para a base
+// Não há Backward, pois os nós só apontam para baixo
+func (s *LinkedStack) All() iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		index := 0
+		for current := s.top; current != nil; current = current.next {
+			if !yield(index, current.data) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Values retorna um iterador sobre os elementos do topo para a base
+func (s *LinkedStack) Values() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for current := s.top; current != nil; current = current.next {
+			if !yield(current.data) {
+				return
+			}
+		}
+	}
+}
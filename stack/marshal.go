@@ -0,0 +1,110 @@
+package stack
+
+import (
+	"fmt"
+
+	"dca3503/serial"
+)
+
+// ============================================================================
+// SERIALIZAÇÃO (JSON, BINÁRIO E GOB)
+// ============================================================================
+
+// As pilhas são gravadas do topo para a base (a ordem de ToSlice, em que os
+// elementos sairiam), em JSON como um array e em binário no formato do
+// pacote serial. ArrayStack e LinkedStack leem os dados uma da outra.
+// Unmarshal substitui todo o conteúdo e funciona também com o valor zero
+// (var s ArrayStack); a capacidade não é gravada. A ArrayStack mantém a
+// política de capacidade e rejeita com errs.ErrFull dados que passem de
+// MaxCapacity
+// Complexidade: Θ(n) em todas as operações
+
+// MarshalJSON implementa json.Marshaler
+func (s *ArrayStack) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(s.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (s *ArrayStack) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("ArrayStack: %w", err)
+	}
+	return s.load(values)
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (s *ArrayStack) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Stack, s.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (s *ArrayStack) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Stack, data)
+	if err != nil {
+		return fmt.Errorf("ArrayStack: %w", err)
+	}
+	return s.load(values)
+}
+
+// load troca o conteúdo pelos valores (values[0] é o topo), reaproveitando
+// o array se couber
+func (s *ArrayStack) load(values []int) error {
+	defer checkInvariants(s)
+	if err := s.policy.Validate(len(values)); err != nil {
+		return fmt.Errorf("ArrayStack: %w", err)
+	}
+	if len(values) > s.capacity || s.capacity <= 0 {
+		s.capacity = s.policy.Clamp(max(len(values), 10)) // Capacidade padrão do construtor
+		s.data = make([]int, s.capacity)
+	}
+	n := len(values)
+	for i, value := range values {
+		s.data[n-1-i] = value // O topo fica no fim do array
+	}
+	s.top = n - 1
+	s.counters.AddMoves(n)
+	return nil
+}
+
+// MarshalJSON implementa json.Marshaler
+func (s *LinkedStack) MarshalJSON() ([]byte, error) {
+	return serial.MarshalJSON(s.ToSlice())
+}
+
+// UnmarshalJSON implementa json.Unmarshaler
+func (s *LinkedStack) UnmarshalJSON(data []byte) error {
+	values, err := serial.UnmarshalJSON[int](data)
+	if err != nil {
+		return fmt.Errorf("LinkedStack: %w", err)
+	}
+	s.load(values)
+	return nil
+}
+
+// MarshalBinary implementa encoding.BinaryMarshaler
+func (s *LinkedStack) MarshalBinary() ([]byte, error) {
+	return serial.Marshal(serial.Stack, s.ToSlice())
+}
+
+// UnmarshalBinary implementa encoding.BinaryUnmarshaler
+func (s *LinkedStack) UnmarshalBinary(data []byte) error {
+	values, err := serial.Unmarshal[int](serial.Stack, data)
+	if err != nil {
+		return fmt.Errorf("LinkedStack: %w", err)
+	}
+	s.load(values)
+	return nil
+}
+
+// load troca o conteúdo por uma nova cadeia de nós (values[0] é o topo)
+// Monta a cadeia da base para o topo, como uma sequência de Push
+func (s *LinkedStack) load(values []int) {
+	defer checkInvariants(s)
+	s.top = nil
+	for i := len(values) - 1; i >= 0; i-- {
+		s.top = &StackNode{data: values[i], next: s.top}
+	}
+	s.size = len(values)
+	s.counters.AddAllocations(len(values))
+}
@@ -0,0 +1,28 @@
+package stack_test
+
+import (
+	"testing"
+
+	"dca3503/capacity"
+	"dca3503/stack"
+	"dca3503/stack/stacktest"
+)
+
+func TestArrayStackConformance(t *testing.T) {
+	stacktest.Run(t, func() stack.Stack { return stack.NewArrayStack(2) })
+}
+
+func TestArrayStackGrowthPolicyConformance(t *testing.T) {
+	policy := capacity.Policy{Growth: capacity.Geometric{Factor: 1.5}, ShrinkAt: 0.3, ShrinkTo: 0.6}
+	stacktest.Run(t, func() stack.Stack {
+		s := stack.NewArrayStack(1)
+		if err := s.SetGrowthPolicy(policy); err != nil {
+			t.Fatal(err)
+		}
+		return s
+	})
+}
+
+func TestLinkedStackConformance(t *testing.T) {
+	stacktest.Run(t, func() stack.Stack { return stack.NewLinkedStack() })
+}
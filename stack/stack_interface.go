@@ -1,6 +1,11 @@
-package main
+package stack
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+
+	"dca3503/errs"
+)
 
 // ============================================================================
 // INTERFACE STACK - TIPO ABSTRATO DE DADOS
@@ -26,6 +31,17 @@ type Stack interface {
 	String() string            // Representação em string
 }
 
+// ============================================================================
+// ERROS
+// ============================================================================
+
+// Erros das pilhas; todos satisfazem errors.Is(err, errs.ErrEmpty)
+var (
+	errEmptyStack = errs.New(errs.ErrEmpty, "pilha vazia", "empty stack")
+	errPopEmpty   = errs.New(errs.ErrEmpty, "pilha vazia: não é possível fazer pop", "empty stack: cannot pop")
+	errPeekEmpty  = errs.New(errs.ErrEmpty, "pilha vazia: não há elemento no topo", "empty stack: no element on top")
+)
+
 // ============================================================================
 // FUNÇÕES UTILITÁRIAS QUE TRABALHAM COM A INTERFACE
 // ============================================================================
@@ -135,7 +151,7 @@ func StackSum(stack Stack) int {
 // StackMax encontra o maior elemento na pilha
 func StackMax(stack Stack) (int, error) {
 	if stack.IsEmpty() {
-		return 0, fmt.Errorf("pilha vazia")
+		return 0, errEmptyStack
 	}
 	
 	// Caso base: apenas um elemento
@@ -190,55 +206,46 @@ func isMatchingPair(open, close rune) bool {
 
 // EvaluatePostfix avalia uma expressão em notação pós-fixa
 // Exemplo: "3 4 + 2 *" = (3 + 4) * 2 = 14
+// Os erros indicam a posição (1 = primeiro token) do token problemático
+// Para expressões infixas, floats e variáveis veja Evaluate e InfixToPostfix
 func EvaluatePostfix(expression []string) (int, error) {
 	stack := NewArrayStack(len(expression))
 	
-	for _, token := range expression {
+	for i, token := range expression {
 		switch token {
-		case "+":
-			if stack.Size() < 2 {
-				return 0, fmt.Errorf("expressão inválida")
-			}
-			b, _ := stack.Pop()
-			a, _ := stack.Pop()
-			stack.Push(a + b)
-		case "-":
-			if stack.Size() < 2 {
-				return 0, fmt.Errorf("expressão inválida")
-			}
-			b, _ := stack.Pop()
-			a, _ := stack.Pop()
-			stack.Push(a - b)
-		case "*":
-			if stack.Size() < 2 {
-				return 0, fmt.Errorf("expressão inválida")
-			}
-			b, _ := stack.Pop()
-			a, _ := stack.Pop()
-			stack.Push(a * b)
-		case "/":
+		case "+", "-", "*", "/":
 			if stack.Size() < 2 {
-				return 0, fmt.Errorf("expressão inválida")
+				return 0, errs.New(nil, "token %d (%q): operador precisa de dois operandos, há %d na pilha",
+					"token %d (%q): operator needs two operands, the stack has %d", i+1, token, stack.Size())
 			}
 			b, _ := stack.Pop()
 			a, _ := stack.Pop()
-			if b == 0 {
-				return 0, fmt.Errorf("divisão por zero")
+			switch token {
+			case "+":
+				stack.Push(a + b)
+			case "-":
+				stack.Push(a - b)
+			case "*":
+				stack.Push(a * b)
+			case "/":
+				if b == 0 {
+					return 0, errs.New(nil, "token %d (%q): divisão por zero", "token %d (%q): division by zero", i+1, token)
+				}
+				stack.Push(a / b)
 			}
-			stack.Push(a / b)
 		default:
 			// Assume que é um número
-			var num int
-			_, err := fmt.Sscanf(token, "%d", &num)
+			num, err := strconv.Atoi(token)
 			if err != nil {
-				return 0, fmt.Errorf("token inválido: %s", token)
+				return 0, errs.New(nil, "token %d (%q): token inválido", "token %d (%q): invalid token", i+1, token)
 			}
 			stack.Push(num)
 		}
 	}
 	
 	if stack.Size() != 1 {
-		return 0, fmt.Errorf("expressão inválida")
+		return 0, errs.New(nil, "expressão incompleta: sobraram %d valores na pilha, esperado 1",
+			"incomplete expression: %d values left on the stack, expected 1", stack.Size())
 	}
 	
 	result, _ := stack.Pop()
@@ -0,0 +1,330 @@
+// Package stacktest implementa uma suíte de conformidade para stack.Stack
+//
+// Qualquer implementação da interface pode ser validada com uma chamada:
+//
+//	func TestMinhaPilha(t *testing.T) {
+//		stacktest.Run(t, func() stack.Stack { return NovaMinhaPilha() })
+//	}
+//
+// A suíte verifica os contratos da interface (erros em pilha vazia, ordem
+// LIFO, Peek sem remoção, ToSlice do topo para a base, concordância entre
+// ToSlice e String) e executa sequências aleatórias de operações comparando
+// a pilha com um modelo simples baseado em slice. Se a implementação tiver
+// um método Validate() error, ele é chamado depois de cada passo, e os
+// iteradores All, Values e Backward que ela tiver são conferidos contra ToSlice.
+package stacktest
+
+import (
+	"errors"
+	"iter"
+	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strconv"
+	"testing"
+
+	"dca3503/errs"
+	"dca3503/stack"
+)
+
+// Factory cria uma pilha nova e vazia a cada chamada
+type Factory func() stack.Stack
+
+// RandomSeeds são as sementes usadas nas sequências aleatórias
+// Fixas para que uma falha seja sempre reproduzível
+var RandomSeeds = []uint64{1, 2, 3, 42, 2024}
+
+// RandomOperations é o número de operações por sequência aleatória
+var RandomOperations = 2000
+
+// Run executa toda a suíte de conformidade sobre pilhas criadas por newStack
+func Run(t *testing.T, newStack Factory) {
+	t.Helper()
+	t.Run("Empty", func(t *testing.T) { testEmpty(t, newStack()) })
+	t.Run("LIFO", func(t *testing.T) { testLIFO(t, newStack()) })
+	t.Run("PeekDoesNotRemove", func(t *testing.T) { testPeek(t, newStack()) })
+	t.Run("GrowAndShrink", func(t *testing.T) { testGrowAndShrink(t, newStack()) })
+	t.Run("Clear", func(t *testing.T) { testClear(t, newStack()) })
+	t.Run("ToSliceIsCopy", func(t *testing.T) { testToSliceIsCopy(t, newStack()) })
+	t.Run("Randomized", func(t *testing.T) {
+		for _, seed := range RandomSeeds {
+			t.Run("seed="+strconv.FormatUint(seed, 10), func(t *testing.T) {
+				testRandomized(t, newStack(), seed)
+			})
+		}
+	})
+}
+
+// ============================================================================
+// CONTRATOS
+// ============================================================================
+
+func testEmpty(t *testing.T, s stack.Stack) {
+	if s.Size() != 0 || !s.IsEmpty() {
+		t.Fatalf("pilha nova: Size()=%d IsEmpty()=%v, esperado 0 e true", s.Size(), s.IsEmpty())
+	}
+	if s.IsFull() {
+		t.Errorf("pilha nova não pode estar cheia")
+	}
+	if _, err := s.Pop(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Pop() em pilha vazia deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	if _, err := s.Peek(); !errors.Is(err, errs.ErrEmpty) {
+		t.Errorf("Peek() em pilha vazia deveria retornar errs.ErrEmpty, retornou %v", err)
+	}
+	// Erros não podem alterar o estado
+	checkState(t, s, []int{})
+}
+
+func testLIFO(t *testing.T, s stack.Stack) {
+	pushed := []int{1, 2, 3, -4, 5}
+	for _, v := range pushed {
+		s.Push(v)
+	}
+	checkState(t, s, []int{5, -4, 3, 2, 1})
+	
+	for i := len(pushed) - 1; i >= 0; i-- {
+		got, err := s.Pop()
+		if err != nil || got != pushed[i] {
+			t.Fatalf("Pop() = (%d, %v), esperado (%d, nil)", got, err, pushed[i])
+		}
+	}
+	checkState(t, s, []int{})
+}
+
+func testPeek(t *testing.T, s stack.Stack) {
+	s.Push(10)
+	s.Push(20)
+	for i := 0; i < 3; i++ {
+		got, err := s.Peek()
+		if err != nil || got != 20 {
+			t.Fatalf("Peek() = (%d, %v), esperado (20, nil)", got, err)
+		}
+	}
+	checkState(t, s, []int{20, 10})
+}
+
+func testGrowAndShrink(t *testing.T, s stack.Stack) {
+	// Passa por vários redimensionamentos nas implementações com array
+	const n = 1000
+	for i := 0; i < n; i++ {
+		s.Push(i)
+	}
+	if s.Size() != n {
+		t.Fatalf("Size() = %d após %d Push, esperado %d", s.Size(), n, n)
+	}
+	for i := n - 1; i >= 0; i-- {
+		got, err := s.Pop()
+		if err != nil || got != i {
+			t.Fatalf("Pop() = (%d, %v), esperado (%d, nil)", got, err, i)
+		}
+	}
+	checkState(t, s, []int{})
+}
+
+func testClear(t *testing.T, s stack.Stack) {
+	for i := 0; i < 50; i++ {
+		s.Push(i)
+	}
+	s.Clear()
+	checkState(t, s, []int{})
+	
+	// A pilha continua utilizável depois de Clear
+	s.Push(1)
+	s.Push(2)
+	checkState(t, s, []int{2, 1})
+}
+
+func testToSliceIsCopy(t *testing.T, s stack.Stack) {
+	s.Push(1)
+	s.Push(2)
+	slice := s.ToSlice()
+	slice[0] = 100
+	if got, _ := s.Peek(); got != 2 {
+		t.Errorf("alterar o resultado de ToSlice modificou a pilha: Peek() = %d", got)
+	}
+}
+
+// ============================================================================
+// SEQUÊNCIAS ALEATÓRIAS CONTRA UM MODELO
+// ============================================================================
+
+// testRandomized aplica operações aleatórias na pilha e num slice (modelo,
+// com o topo no final) e compara os dois depois de cada passo
+func testRandomized(t *testing.T, s stack.Stack, seed uint64) {
+	rng := rand.New(rand.NewPCG(seed, seed))
+	model := []int{}
+	
+	for step := 0; step < RandomOperations; step++ {
+		var op string
+		switch rng.IntN(10) {
+		case 0, 1, 2, 3, 4:
+			value := rng.IntN(100) - 50
+			op = "Push(" + strconv.Itoa(value) + ")"
+			s.Push(value)
+			model = append(model, value)
+		case 5, 6, 7:
+			op = "Pop()"
+			got, err := s.Pop()
+			if len(model) == 0 {
+				if !errors.Is(err, errs.ErrEmpty) {
+					t.Fatalf("passo %d: Pop() em pilha vazia deveria retornar errs.ErrEmpty, retornou %v", step, err)
+				}
+				break
+			}
+			want := model[len(model)-1]
+			model = model[:len(model)-1]
+			if err != nil || got != want {
+				t.Fatalf("passo %d: Pop() = (%d, %v), modelo tem %d", step, got, err, want)
+			}
+		case 8:
+			op = "Peek()"
+			got, err := s.Peek()
+			if len(model) == 0 {
+				if !errors.Is(err, errs.ErrEmpty) {
+					t.Fatalf("passo %d: Peek() em pilha vazia deveria retornar errs.ErrEmpty, retornou %v", step, err)
+				}
+				break
+			}
+			if want := model[len(model)-1]; err != nil || got != want {
+				t.Fatalf("passo %d: Peek() = (%d, %v), modelo tem %d", step, got, err, want)
+			}
+		case 9:
+			// Clear raro para deixar a pilha crescer
+			if rng.IntN(20) == 0 {
+				op = "Clear()"
+				s.Clear()
+				model = model[:0]
+			}
+		}
+		
+		if err := validate(s); err != nil {
+			t.Fatalf("passo %d (%s): %v", step, op, err)
+		}
+		
+		want := reversed(model)
+		if s.Size() != len(model) || !slices.Equal(s.ToSlice(), want) {
+			t.Fatalf("passo %d (%s): pilha %v (Size()=%d), modelo %v",
+				step, op, s.ToSlice(), s.Size(), want)
+		}
+		if s.IsEmpty() != (len(model) == 0) {
+			t.Fatalf("passo %d (%s): IsEmpty()=%v com %d elementos", step, op, s.IsEmpty(), len(model))
+		}
+	}
+	checkString(t, s)
+}
+
+// ============================================================================
+// AUXILIARES
+// ============================================================================
+
+// reversed retorna uma cópia invertida do modelo (do topo para a base)
+func reversed(model []int) []int {
+	result := slices.Clone(model)
+	slices.Reverse(result)
+	return result
+}
+
+// checkState compara tamanho, ToSlice e String com o conteúdo esperado
+// want está do topo para a base, como em ToSlice
+func checkState(t *testing.T, s stack.Stack, want []int) {
+	t.Helper()
+	if err := validate(s); err != nil {
+		t.Fatalf("%v", err)
+	}
+	if s.Size() != len(want) {
+		t.Fatalf("Size() = %d, esperado %d", s.Size(), len(want))
+	}
+	if s.IsEmpty() != (len(want) == 0) {
+		t.Fatalf("IsEmpty() = %v com %d elementos", s.IsEmpty(), len(want))
+	}
+	if got := s.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("ToSlice() = %v, esperado %v", got, want)
+	}
+	checkIterators(t, s)
+	checkString(t, s)
+}
+
+// validate roda Validate() quando a implementação oferece o verificador de
+// invariantes estruturais; implementações sem ele passam direto
+func validate(structure stack.Stack) error {
+	if validator, ok := structure.(interface{ Validate() error }); ok {
+		return validator.Validate()
+	}
+	return nil
+}
+
+// checkIterators confere os iteradores All, Values e Backward que a
+// implementação oferecer: percorrem os elementos de ToSlice na mesma ordem
+// (Backward na inversa, com as mesmas posições) e param assim que yield
+// retorna false
+func checkIterators(t *testing.T, s stack.Stack) {
+	t.Helper()
+	want := s.ToSlice()
+	positions := make([]int, len(want))
+	for i := range positions {
+		positions[i] = i
+	}
+	if it, ok := s.(interface{ All() iter.Seq2[int, int] }); ok {
+		checkSeq(t, "All", it.All(), positions, want)
+	}
+	if it, ok := s.(interface{ Values() iter.Seq[int] }); ok {
+		// Numera os valores para conferir como os pares de All
+		numbered := func(yield func(int, int) bool) {
+			i := 0
+			for value := range it.Values() {
+				if !yield(i, value) {
+					return
+				}
+				i++
+			}
+		}
+		checkSeq(t, "Values", numbered, positions, want)
+	}
+	if it, ok := s.(interface{ Backward() iter.Seq2[int, int] }); ok {
+		slices.Reverse(positions)
+		backward := slices.Clone(want)
+		slices.Reverse(backward)
+		checkSeq(t, "Backward", it.Backward(), positions, backward)
+	}
+}
+
+// checkSeq percorre seq inteiro comparando com os pares esperados e depois
+// de novo, parando no meio: seq não pode chamar yield depois de um false
+func checkSeq(t *testing.T, name string, seq iter.Seq2[int, int], positions, want []int) {
+	t.Helper()
+	gotPositions, gotValues := []int{}, []int{}
+	for position, value := range seq {
+		gotPositions = append(gotPositions, position)
+		gotValues = append(gotValues, value)
+	}
+	if !slices.Equal(gotPositions, positions) || !slices.Equal(gotValues, want) {
+		t.Fatalf("%s() = posições %v, valores %v; esperado %v e %v", name, gotPositions, gotValues, positions, want)
+	}
+	
+	stop := len(want)/2 + 1
+	calls := 0
+	seq(func(int, int) bool {
+		calls++
+		return calls < stop
+	})
+	if len(want) > 0 && calls != stop {
+		t.Fatalf("%s(): yield chamado %d vezes, mas retornou false na chamada %d", name, calls, stop)
+	}
+}
+
+var numberPattern = regexp.MustCompile(`-?\d+`)
+
+// checkString verifica se String mostra os mesmos números, na mesma ordem, que ToSlice
+// O formato (colchetes, separadores, rótulos) fica livre para cada implementação
+func checkString(t *testing.T, s stack.Stack) {
+	t.Helper()
+	got := []int{}
+	for _, match := range numberPattern.FindAllString(s.String(), -1) {
+		number, _ := strconv.Atoi(match)
+		got = append(got, number)
+	}
+	if want := s.ToSlice(); !slices.Equal(got, want) {
+		t.Fatalf("String() = %q não concorda com ToSlice() = %v", s.String(), want)
+	}
+}
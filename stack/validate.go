@@ -0,0 +1,62 @@
+package stack
+
+import "fmt"
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// Validate verifica as invariantes internas do ArrayStack
+// - capacity == len(data) e capacity > 0
+// - -1 <= top < capacity (top == -1 significa pilha vazia)
+// - capacity <= MaxCapacity da política, quando houver limite
+// Complexidade: Θ(1)
+func (s *ArrayStack) Validate() error {
+	if s.capacity != len(s.data) {
+		return fmt.Errorf("ArrayStack: capacity %d diferente do array interno (%d)", s.capacity, len(s.data))
+	}
+	if s.capacity <= 0 {
+		return fmt.Errorf("ArrayStack: capacity deveria ser positiva (%d)", s.capacity)
+	}
+	if s.top < -1 || s.top >= s.capacity {
+		return fmt.Errorf("ArrayStack: top %d fora de [-1, %d)", s.top, s.capacity)
+	}
+	if limit := s.policy.Clamp(s.capacity); limit < s.capacity {
+		return fmt.Errorf("ArrayStack: capacity %d acima do máximo da política (%d)", s.capacity, limit)
+	}
+	return nil
+}
+
+// Validate verifica as invariantes internas da LinkedStack
+// - top == nil se e somente se size == 0
+// - a cadeia de nós termina em nil (sem ciclo) e tem exatamente size nós
+// Complexidade: O(n)
+func (s *LinkedStack) Validate() error {
+	if (s.top == nil) != (s.size == 0) {
+		return fmt.Errorf("LinkedStack: top nil = %v com size %d", s.top == nil, s.size)
+	}
+	
+	count := 0
+	for current := s.top; current != nil; current = current.next {
+		count++
+		if count > s.size {
+			return fmt.Errorf("LinkedStack: mais nós que size (%d) ou ciclo", s.size)
+		}
+	}
+	if count != s.size {
+		return fmt.Errorf("LinkedStack: size %d, mas a cadeia tem %d nós", s.size, count)
+	}
+	return nil
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug);
+// uma invariante quebrada vira panic, apontando a operação culpada no stack trace
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
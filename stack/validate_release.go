@@ -0,0 +1,6 @@
+//go:build !debug
+
+package stack
+
+// debugValidate desliga checkInvariants (compilação normal)
+const debugValidate = false
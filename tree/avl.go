@@ -0,0 +1,220 @@
+package tree
+
+import (
+	"fmt"
+
+	"dca3503/list"
+)
+
+// ============================================================================
+// AVLTREE - BALANCEAMENTO POR ALTURA
+// ============================================================================
+
+// AVLTree implementa OrderedMap com uma árvore AVL
+// Características:
+// - em todo nó, as alturas das subárvores diferem de no máximo 1
+// - altura <= 1,44·log₂(n+2): a árvore mais baixa das duas implementações
+// - inserção faz no máximo uma rotação simples ou dupla
+// - remoção pode fazer O(log n) rotações subindo até a raiz
+type AVLTree[K list.Ordered, V any] struct {
+	tree[K, V]
+}
+
+// NewAVLTree cria uma árvore AVL vazia
+func NewAVLTree[K list.Ordered, V any]() *AVLTree[K, V] {
+	return &AVLTree[K, V]{}
+}
+
+// height retorna a altura guardada no nó (0 para nil)
+func height[K list.Ordered, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// Height retorna a altura guardada na raiz
+// Complexidade: Θ(1)
+func (t *AVLTree[K, V]) Height() int {
+	return height(t.root)
+}
+
+// ============================================================================
+// ROTAÇÕES E REBALANCEAMENTO
+// ============================================================================
+
+// update recalcula altura e tamanho a partir dos filhos
+func (t *AVLTree[K, V]) update(n *node[K, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.size = 1 + size(n.left) + size(n.right)
+}
+
+// balanceFactor retorna altura(esquerda) - altura(direita)
+func balanceFactor[K list.Ordered, V any](n *node[K, V]) int {
+	return height(n.left) - height(n.right)
+}
+
+// rotateRight sobe o filho esquerdo para o lugar de n
+//
+//	    n            l
+//	   / \          / \
+//	  l   c  →     a   n
+//	 / \              / \
+//	a   b            b   c
+func (t *AVLTree[K, V]) rotateRight(n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	t.update(n)
+	t.update(l)
+	t.rotations++
+	return l
+}
+
+// rotateLeft sobe o filho direito para o lugar de n (espelho de rotateRight)
+func (t *AVLTree[K, V]) rotateLeft(n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	t.update(n)
+	t.update(r)
+	t.rotations++
+	return r
+}
+
+// rebalance atualiza n e aplica a rotação necessária se |fator| > 1
+// Pseudocódigo:
+// 1. Fator > 1 (pesado à esquerda): girar n à direita (caso LL)
+// 2. Se antes o filho esquerdo pender para a direita, girá-lo à esquerda (caso LR)
+//
+// 3. Fator < -1: espelho (casos RR e RL)
+func (t *AVLTree[K, V]) rebalance(n *node[K, V]) *node[K, V] {
+	t.update(n)
+	switch factor := balanceFactor(n); {
+	case factor > 1:
+		if balanceFactor(n.left) < 0 {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	case factor < -1:
+		if balanceFactor(n.right) > 0 {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+// ============================================================================
+// INSERÇÃO E REMOÇÃO
+// ============================================================================
+
+// Insert insere ou atualiza a chave; retorna true se a chave era nova
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Descer como numa árvore de busca comum e criar a folha
+// 2. Na volta da recursão, atualizar alturas e rebalancear cada ancestral
+func (t *AVLTree[K, V]) Insert(key K, value V) bool {
+	defer checkInvariants(t)
+	added := false
+	var insert func(n *node[K, V]) *node[K, V]
+	insert = func(n *node[K, V]) *node[K, V] {
+		if n == nil {
+			added = true
+			return t.newNode(key, value)
+		}
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			n.left = insert(n.left)
+		case c > 0:
+			n.right = insert(n.right)
+		default:
+			n.value = value
+			return n
+		}
+		t.counters.AddTraversals(1)
+		return t.rebalance(n)
+	}
+	t.root = insert(t.root)
+	return added
+}
+
+// Delete remove a chave e retorna o valor que ela tinha
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Achar o nó; com zero ou um filho, o filho toma o lugar dele
+// 2. Com dois filhos, o sucessor (menor da subárvore direita) sai de lá
+// e toma o lugar do nó
+// 3. Na volta da recursão, rebalancear cada ancestral
+func (t *AVLTree[K, V]) Delete(key K) (V, bool) {
+	defer checkInvariants(t)
+	var removed V
+	found := false
+	var remove func(n *node[K, V]) *node[K, V]
+	remove = func(n *node[K, V]) *node[K, V] {
+		if n == nil {
+			return nil
+		}
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			n.left = remove(n.left)
+		case c > 0:
+			n.right = remove(n.right)
+		default:
+			removed, found = n.value, true
+			if n.left == nil {
+				return n.right
+			}
+			if n.right == nil {
+				return n.left
+			}
+			var successor *node[K, V]
+			n.right, successor = t.removeMin(n.right)
+			successor.left, successor.right = n.left, n.right
+			n = successor
+		}
+		t.counters.AddTraversals(1)
+		return t.rebalance(n)
+	}
+	t.root = remove(t.root)
+	return removed, found
+}
+
+// removeMin retira o menor nó da subárvore e o retorna junto com a nova raiz
+func (t *AVLTree[K, V]) removeMin(n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return n.right, n
+	}
+	var minimum *node[K, V]
+	n.left, minimum = t.removeMin(n.left)
+	return t.rebalance(n), minimum
+}
+
+// ============================================================================
+// CONSULTA
+// ============================================================================
+
+// GetStatistics retorna tamanho, altura, altura mínima possível, profundidade
+// média e rotações feitas
+// Complexidade: O(n)
+func (t *AVLTree[K, V]) GetStatistics() map[string]interface{} {
+	stats := t.statistics()
+	stats["kind"] = "AVL"
+	return stats
+}
+
+// Layout desenha a árvore deitada com o fator de balanceamento de cada nó
+//
+//	    ┌── 3 (+0)
+//	2 (+0)
+//	    └── 1 (+0)
+func (t *AVLTree[K, V]) Layout() string {
+	return t.layout(func(n *node[K, V]) string {
+		return fmt.Sprintf("%v (%+d)", n.key, balanceFactor(n))
+	})
+}
+
+// String retorna os pares em ordem crescente
+func (t *AVLTree[K, V]) String() string {
+	return "AVL" + t.format()
+}
@@ -0,0 +1,273 @@
+package tree
+
+import (
+	"fmt"
+
+	"dca3503/list"
+)
+
+// ============================================================================
+// REDBLACKTREE - RUBRO-NEGRA INCLINADA À ESQUERDA
+// ============================================================================
+
+// RedBlackTree implementa OrderedMap com uma árvore rubro-negra inclinada à
+// esquerda (LLRB, de Sedgewick)
+// Características:
+// - cada link é preto ou vermelho; um link vermelho cola dois nós num
+// nó 3 de uma árvore 2-3
+// - links vermelhos só à esquerda e nunca dois seguidos
+// - todo caminho da raiz até nil tem o mesmo número de links pretos
+// - altura <= 2·log₂(n+1): mais alta que a AVL, com menos rotações
+type RedBlackTree[K list.Ordered, V any] struct {
+	tree[K, V]
+}
+
+// NewRedBlackTree cria uma árvore rubro-negra vazia
+func NewRedBlackTree[K list.Ordered, V any]() *RedBlackTree[K, V] {
+	return &RedBlackTree[K, V]{}
+}
+
+// isRed verifica se o link que chega ao nó é vermelho (nil é preto)
+func isRed[K list.Ordered, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+// ============================================================================
+// ROTAÇÕES E AJUSTE DE CORES
+// ============================================================================
+
+// rotateLeft transforma um link vermelho à direita em um à esquerda
+func (t *RedBlackTree[K, V]) rotateLeft(n *node[K, V]) *node[K, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	r.red = n.red
+	n.red = true
+	r.size = n.size
+	n.size = 1 + size(n.left) + size(n.right)
+	t.rotations++
+	return r
+}
+
+// rotateRight transforma um link vermelho à esquerda em um à direita
+func (t *RedBlackTree[K, V]) rotateRight(n *node[K, V]) *node[K, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	l.red = n.red
+	n.red = true
+	l.size = n.size
+	n.size = 1 + size(n.left) + size(n.right)
+	t.rotations++
+	return l
+}
+
+// flipColors inverte as cores do nó e dos dois filhos
+// Na inserção divide um nó 4 temporário; na remoção forma um nó 4
+func flipColors[K list.Ordered, V any](n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// fixUp restaura as invariantes na volta da recursão
+// 1. Vermelho à direita (e não à esquerda): girar à esquerda
+// 2. Dois vermelhos seguidos à esquerda: girar à direita
+// 3. Os dois filhos vermelhos: inverter as cores
+func (t *RedBlackTree[K, V]) fixUp(n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = t.rotateLeft(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = t.rotateRight(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		flipColors(n)
+	}
+	n.size = 1 + size(n.left) + size(n.right)
+	return n
+}
+
+// moveRedLeft garante que n.left ou um filho dele seja vermelho, para que a
+// remoção nunca desça até um nó 2
+func (t *RedBlackTree[K, V]) moveRedLeft(n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.right.left) {
+		n.right = t.rotateRight(n.right)
+		n = t.rotateLeft(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight é o espelho de moveRedLeft para descer à direita
+func (t *RedBlackTree[K, V]) moveRedRight(n *node[K, V]) *node[K, V] {
+	flipColors(n)
+	if isRed(n.left.left) {
+		n = t.rotateRight(n)
+		flipColors(n)
+	}
+	return n
+}
+
+// ============================================================================
+// INSERÇÃO E REMOÇÃO
+// ============================================================================
+
+// Insert insere ou atualiza a chave; retorna true se a chave era nova
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Descer como numa árvore de busca e criar a folha com link vermelho
+// 2. Na volta, fixUp em cada ancestral
+// 3. Pintar a raiz de preto
+func (t *RedBlackTree[K, V]) Insert(key K, value V) bool {
+	defer checkInvariants(t)
+	added := false
+	var insert func(n *node[K, V]) *node[K, V]
+	insert = func(n *node[K, V]) *node[K, V] {
+		if n == nil {
+			added = true
+			return t.newNode(key, value)
+		}
+		switch c := t.compare(key, n.key); {
+		case c < 0:
+			n.left = insert(n.left)
+		case c > 0:
+			n.right = insert(n.right)
+		default:
+			n.value = value
+			return n
+		}
+		t.counters.AddTraversals(1)
+		return t.fixUp(n)
+	}
+	t.root = insert(t.root)
+	t.root.red = false
+	return added
+}
+
+// Delete remove a chave e retorna o valor que ela tinha
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Se a chave não existe, nada a fazer (a descida alteraria cores à toa)
+// 2. Descer garantindo que o nó atual ou um filho seja vermelho
+// (moveRedLeft/Right): a remoção acontece num nó 3 ou 4 e não quebra o equilíbrio
+// 3. Com dois filhos, trocar pelo sucessor e removê-lo da subárvore direita
+// 4. Na volta, fixUp em cada ancestral e pintar a raiz de preto
+func (t *RedBlackTree[K, V]) Delete(key K) (V, bool) {
+	defer checkInvariants(t)
+	target := t.find(key)
+	if target == nil {
+		var zero V
+		return zero, false
+	}
+	removed := target.value
+	
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	var remove func(n *node[K, V]) *node[K, V]
+	remove = func(n *node[K, V]) *node[K, V] {
+		t.counters.AddTraversals(1)
+		if t.compare(key, n.key) < 0 {
+			if !isRed(n.left) && !isRed(n.left.left) {
+				n = t.moveRedLeft(n)
+			}
+			n.left = remove(n.left)
+			return t.fixUp(n)
+		}
+		if isRed(n.left) {
+			n = t.rotateRight(n)
+		}
+		if t.compare(key, n.key) == 0 && n.right == nil {
+			return nil
+		}
+		if !isRed(n.right) && !isRed(n.right.left) {
+			n = t.moveRedRight(n)
+		}
+		if t.compare(key, n.key) == 0 {
+			var successor *node[K, V]
+			n.right, successor = t.removeMin(n.right)
+			n.key, n.value = successor.key, successor.value
+		} else {
+			n.right = remove(n.right)
+		}
+		return t.fixUp(n)
+	}
+	t.root = remove(t.root)
+	if t.root != nil {
+		t.root.red = false
+	}
+	return removed, true
+}
+
+// removeMin retira o menor nó da subárvore e o retorna junto com a nova raiz
+func (t *RedBlackTree[K, V]) removeMin(n *node[K, V]) (*node[K, V], *node[K, V]) {
+	if n.left == nil {
+		return nil, n
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = t.moveRedLeft(n)
+	}
+	var minimum *node[K, V]
+	n.left, minimum = t.removeMin(n.left)
+	return t.fixUp(n), minimum
+}
+
+// ============================================================================
+// CONSULTA
+// ============================================================================
+
+// BlackHeight retorna o número de links pretos da raiz até nil
+// Complexidade: O(log n)
+func (t *RedBlackTree[K, V]) BlackHeight() int {
+	blacks := 0
+	for current := t.root; current != nil; current = current.left {
+		if !current.red {
+			blacks++
+		}
+	}
+	return blacks
+}
+
+// GetStatistics retorna tamanho, altura, altura mínima possível, profundidade
+// média, altura preta, nós vermelhos e rotações feitas
+// Complexidade: O(n)
+func (t *RedBlackTree[K, V]) GetStatistics() map[string]interface{} {
+	stats := t.statistics()
+	stats["kind"] = "rubro-negra"
+	stats["blackHeight"] = t.BlackHeight()
+	reds := 0
+	var walk func(n *node[K, V])
+	walk = func(n *node[K, V]) {
+		if n != nil {
+			if n.red {
+				reds++
+			}
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(t.root)
+	stats["redNodes"] = reds
+	return stats
+}
+
+// Layout desenha a árvore deitada; nós com link vermelho aparecem como [k]
+//
+//	    ┌── 3
+//	2
+//	    └── [1]
+func (t *RedBlackTree[K, V]) Layout() string {
+	return t.layout(func(n *node[K, V]) string {
+		if n.red {
+			return fmt.Sprintf("[%v]", n.key)
+		}
+		return fmt.Sprint(n.key)
+	})
+}
+
+// String retorna os pares em ordem crescente
+func (t *RedBlackTree[K, V]) String() string {
+	return "RedBlack" + t.format()
+}
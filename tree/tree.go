@@ -0,0 +1,461 @@
+// Package tree implementa mapas ordenados sobre árvores binárias de busca
+// balanceadas: AVLTree (balanceamento por altura) e RedBlackTree (rubro-negra
+// inclinada à esquerda, equivalente a uma árvore 2-3).
+//
+// Com a árvore balanceada, inserção, remoção e busca custam O(log n), em vez
+// do O(n) de manter uma fatia ordenada e usar BuscaBinaria. Cada nó guarda o
+// tamanho da sua subárvore, o que dá Rank e Select também em O(log n).
+package tree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math"
+	"strings"
+
+	"dca3503/errs"
+	"dca3503/instrument"
+	"dca3503/list"
+)
+
+// ============================================================================
+// INTERFACE ORDEREDMAP
+// ============================================================================
+
+// OrderedMap define o contrato comum de AVLTree e RedBlackTree
+// As chaves ficam em ordem crescente; todas as consultas respeitam essa ordem
+type OrderedMap[K list.Ordered, V any] interface {
+	Insert(key K, value V) bool // Insere ou atualiza; true se a chave era nova
+	Delete(key K) (V, bool)     // Remove a chave e retorna o valor que tinha
+	Get(key K) (V, bool)        // Busca o valor da chave
+	Contains(key K) bool        // Verifica se a chave existe
+	Size() int                  // Número de chaves
+	IsEmpty() bool              // Size() == 0
+	Height() int                // Altura da árvore (0 = vazia)
+	Clear()                     // Remove todas as chaves
+	
+	Min() (K, V, bool)             // Menor chave
+	Max() (K, V, bool)             // Maior chave
+	Floor(key K) (K, V, bool)      // Maior chave <= key
+	Ceiling(key K) (K, V, bool)    // Menor chave >= key
+	Rank(key K) int                // Quantas chaves são menores que key
+	Select(rank int) (K, V, error) // Chave com exatamente rank chaves menores
+	
+	All() iter.Seq2[K, V]             // Pares em ordem crescente
+	Backward() iter.Seq2[K, V]        // Pares em ordem decrescente
+	Range(from, to K) iter.Seq2[K, V] // Pares com from <= chave <= to
+	InOrder(destination list.List[K]) // Acrescenta as chaves em ordem à lista
+	Keys() []K                        // Chaves em ordem crescente
+	Validate() error                  // Confere as invariantes da árvore
+	GetStatistics() map[string]interface{}
+}
+
+// ============================================================================
+// NÓ E ESTADO COMPARTILHADO
+// ============================================================================
+
+// node é um nó de árvore binária de busca
+// Os campos height e red são usados só pela árvore correspondente
+type node[K list.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V] // Subárvore com chaves menores
+	right  *node[K, V] // Subárvore com chaves maiores
+	size   int         // Número de nós na subárvore (inclui o próprio nó)
+	height int         // AVL: altura da subárvore (folha = 1)
+	red    bool        // Rubro-negra: cor do link que vem do pai
+}
+
+// size retorna o tamanho da subárvore (0 para nil)
+func size[K list.Ordered, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// tree guarda a raiz e implementa as consultas, que não dependem do
+// balanceamento; AVLTree e RedBlackTree só implementam Insert e Delete
+type tree[K list.Ordered, V any] struct {
+	root      *node[K, V]
+	rotations int // Rotações feitas desde a criação (ou ResetStatistics)
+	counters  *instrument.Counters
+}
+
+// SetCounters associa contadores de operações à árvore (nil desliga a contagem)
+// Conta comparações de chave, descidas de nível e nós alocados
+func (t *tree[K, V]) SetCounters(counters *instrument.Counters) {
+	t.counters = counters
+}
+
+// compare compara duas chaves contabilizando a comparação
+// NaN é tratado como menor que qualquer outro float, como em cmp.Compare
+func (t *tree[K, V]) compare(a, b K) int {
+	t.counters.AddComparisons(1)
+	return cmp.Compare(a, b)
+}
+
+// newNode cria um nó folha contabilizando a alocação
+func (t *tree[K, V]) newNode(key K, value V) *node[K, V] {
+	t.counters.AddAllocations(1)
+	return &node[K, V]{key: key, value: value, size: 1, height: 1, red: true}
+}
+
+// ============================================================================
+// BUSCA
+// ============================================================================
+
+// find desce da raiz até a chave (nil se ausente)
+// Complexidade: O(h), h = altura = O(log n) nas árvores balanceadas
+func (t *tree[K, V]) find(key K) *node[K, V] {
+	current := t.root
+	for current != nil {
+		switch c := t.compare(key, current.key); {
+		case c < 0:
+			current = current.left
+		case c > 0:
+			current = current.right
+		default:
+			return current
+		}
+		t.counters.AddTraversals(1)
+	}
+	return nil
+}
+
+// Get retorna o valor da chave
+// Complexidade: O(log n)
+func (t *tree[K, V]) Get(key K) (V, bool) {
+	if n := t.find(key); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains verifica se a chave existe
+// Complexidade: O(log n)
+func (t *tree[K, V]) Contains(key K) bool {
+	return t.find(key) != nil
+}
+
+// Size retorna o número de chaves
+// Complexidade: Θ(1) - a raiz guarda o tamanho da árvore inteira
+func (t *tree[K, V]) Size() int {
+	return size(t.root)
+}
+
+// IsEmpty verifica se a árvore está vazia
+// Complexidade: Θ(1)
+func (t *tree[K, V]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Height calcula a altura percorrendo a árvore (0 = vazia, 1 = só a raiz)
+// Complexidade: O(n)
+func (t *tree[K, V]) Height() int {
+	var height func(n *node[K, V]) int
+	height = func(n *node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		return 1 + max(height(n.left), height(n.right))
+	}
+	return height(t.root)
+}
+
+// Clear remove todas as chaves
+// Complexidade: Θ(1) - o coletor de lixo libera os nós
+func (t *tree[K, V]) Clear() {
+	t.root = nil
+}
+
+// ============================================================================
+// CONSULTAS ORDENADAS
+// ============================================================================
+
+// Min retorna a menor chave (o nó mais à esquerda)
+// Complexidade: O(log n)
+func (t *tree[K, V]) Min() (K, V, bool) {
+	if t.root == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	current := t.root
+	for current.left != nil {
+		current = current.left
+		t.counters.AddTraversals(1)
+	}
+	return current.key, current.value, true
+}
+
+// Max retorna a maior chave (o nó mais à direita)
+// Complexidade: O(log n)
+func (t *tree[K, V]) Max() (K, V, bool) {
+	if t.root == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	current := t.root
+	for current.right != nil {
+		current = current.right
+		t.counters.AddTraversals(1)
+	}
+	return current.key, current.value, true
+}
+
+// Floor retorna a maior chave menor ou igual a key
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Descer a partir da raiz guardando o último candidato
+// 2. Chave igual: é a resposta
+// 3. key menor que o nó: a resposta está à esquerda
+// 4. key maior que o nó: o nó é candidato; procurar um maior à direita
+func (t *tree[K, V]) Floor(key K) (K, V, bool) {
+	var candidate *node[K, V]
+	current := t.root
+	for current != nil {
+		c := t.compare(key, current.key)
+		if c == 0 {
+			return current.key, current.value, true
+		}
+		if c < 0 {
+			current = current.left
+		} else {
+			candidate, current = current, current.right
+		}
+		t.counters.AddTraversals(1)
+	}
+	return unpack(candidate)
+}
+
+// Ceiling retorna a menor chave maior ou igual a key
+// Complexidade: O(log n)
+func (t *tree[K, V]) Ceiling(key K) (K, V, bool) {
+	var candidate *node[K, V]
+	current := t.root
+	for current != nil {
+		c := t.compare(key, current.key)
+		if c == 0 {
+			return current.key, current.value, true
+		}
+		if c > 0 {
+			current = current.right
+		} else {
+			candidate, current = current, current.left
+		}
+		t.counters.AddTraversals(1)
+	}
+	return unpack(candidate)
+}
+
+// unpack devolve chave e valor do nó, ou false se ele for nil
+func unpack[K list.Ordered, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return n.key, n.value, true
+}
+
+// Rank retorna quantas chaves são estritamente menores que key
+// A chave não precisa existir: Rank dá a posição em que ela entraria
+// Complexidade: O(log n)
+// Pseudocódigo:
+// 1. Descer a partir da raiz com rank = 0
+// 2. Indo para a direita, somar o nó e toda a subárvore esquerda dele
+// 3. Achando a chave, somar a subárvore esquerda e parar
+func (t *tree[K, V]) Rank(key K) int {
+	rank := 0
+	current := t.root
+	for current != nil {
+		c := t.compare(key, current.key)
+		if c == 0 {
+			return rank + size(current.left)
+		}
+		if c < 0 {
+			current = current.left
+		} else {
+			rank += 1 + size(current.left)
+			current = current.right
+		}
+		t.counters.AddTraversals(1)
+	}
+	return rank
+}
+
+// Select retorna a chave de posição rank na ordem crescente (0 = menor)
+// Select(Rank(k)) == k para toda chave k da árvore
+// Complexidade: O(log n)
+func (t *tree[K, V]) Select(rank int) (K, V, error) {
+	if rank < 0 || rank >= size(t.root) {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, errs.Index(rank, size(t.root))
+	}
+	current := t.root
+	for {
+		leftSize := size(current.left)
+		switch {
+		case rank < leftSize:
+			current = current.left
+		case rank > leftSize:
+			rank -= leftSize + 1
+			current = current.right
+		default:
+			return current.key, current.value, nil
+		}
+		t.counters.AddTraversals(1)
+	}
+}
+
+// ============================================================================
+// PERCURSOS
+// ============================================================================
+
+// All percorre os pares em ordem crescente (percurso em ordem)
+// Não altere a árvore durante a iteração
+// Complexidade: O(n) para o percurso completo, O(h) de pilha
+func (t *tree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var walk func(n *node[K, V]) bool
+		walk = func(n *node[K, V]) bool {
+			if n == nil {
+				return true
+			}
+			return walk(n.left) && yield(n.key, n.value) && walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+// Backward percorre os pares em ordem decrescente
+// Complexidade: O(n)
+func (t *tree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var walk func(n *node[K, V]) bool
+		walk = func(n *node[K, V]) bool {
+			if n == nil {
+				return true
+			}
+			return walk(n.right) && yield(n.key, n.value) && walk(n.left)
+		}
+		walk(t.root)
+	}
+}
+
+// Range percorre em ordem crescente os pares com from <= chave <= to
+// Só desce nas subárvores que podem ter chaves no intervalo
+// Complexidade: O(log n + k) para k chaves no intervalo
+func (t *tree[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var walk func(n *node[K, V]) bool
+		walk = func(n *node[K, V]) bool {
+			if n == nil {
+				return true
+			}
+			afterFrom := t.compare(from, n.key) <= 0
+			beforeTo := t.compare(n.key, to) <= 0
+			if afterFrom && !walk(n.left) {
+				return false
+			}
+			if afterFrom && beforeTo && !yield(n.key, n.value) {
+				return false
+			}
+			return !beforeTo || walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+// InOrder acrescenta as chaves em ordem crescente ao final da lista
+// Complexidade: O(n) mais o custo de n chamadas a Add da lista
+func (t *tree[K, V]) InOrder(destination list.List[K]) {
+	for key := range t.All() {
+		destination.Add(key)
+	}
+}
+
+// Keys retorna as chaves em ordem crescente
+// Complexidade: O(n)
+func (t *tree[K, V]) Keys() []K {
+	keys := make([]K, 0, size(t.root))
+	for key := range t.All() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// ============================================================================
+// ESTATÍSTICAS E VISUALIZAÇÃO
+// ============================================================================
+
+// statistics monta o mapa no formato de GetStatistics das outras estruturas
+// minHeight é a altura da árvore perfeitamente balanceada com n nós,
+// ⌈log₂(n+1)⌉; averageDepth é o custo médio de uma busca com sucesso
+func (t *tree[K, V]) statistics() map[string]interface{} {
+	n := size(t.root)
+	totalDepth := 0
+	var walk func(current *node[K, V], depth int)
+	walk = func(current *node[K, V], depth int) {
+		if current != nil {
+			totalDepth += depth
+			walk(current.left, depth+1)
+			walk(current.right, depth+1)
+		}
+	}
+	walk(t.root, 1)
+	
+	averageDepth := 0.0
+	if n > 0 {
+		averageDepth = float64(totalDepth) / float64(n)
+	}
+	return map[string]interface{}{
+		"size":         n,
+		"isEmpty":      n == 0,
+		"height":       t.Height(),
+		"minHeight":    int(math.Ceil(math.Log2(float64(n + 1)))),
+		"averageDepth": averageDepth,
+		"rotations":    t.rotations,
+	}
+}
+
+// ResetStatistics zera o contador de rotações
+func (t *tree[K, V]) ResetStatistics() {
+	t.rotations = 0
+}
+
+// layout desenha a árvore deitada: a raiz à esquerda, a subárvore direita
+// acima e a esquerda abaixo; label escreve cada nó
+func (t *tree[K, V]) layout(label func(n *node[K, V]) string) string {
+	var builder strings.Builder
+	var walk func(n *node[K, V], prefix string, edge string)
+	walk = func(n *node[K, V], prefix string, edge string) {
+		if n == nil {
+			return
+		}
+		walk(n.right, prefix+"    ", "┌── ")
+		builder.WriteString(prefix + edge + label(n) + "\n")
+		walk(n.left, prefix+"    ", "└── ")
+	}
+	walk(t.root, "", "")
+	return builder.String()
+}
+
+// format escreve os pares na forma {k: v, ...} em ordem crescente
+func (t *tree[K, V]) format() string {
+	var builder strings.Builder
+	builder.WriteString("{")
+	for key, value := range t.All() {
+		if builder.Len() > 1 {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprintf("%v: %v", key, value))
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
@@ -0,0 +1,211 @@
+package tree_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+
+	"dca3503/instrument"
+	"dca3503/list"
+	"dca3503/tree"
+)
+
+var (
+	_ tree.OrderedMap[int, string] = (*tree.AVLTree[int, string])(nil)
+	_ tree.OrderedMap[int, string] = (*tree.RedBlackTree[int, string])(nil)
+	_ instrument.Instrumented      = (*tree.AVLTree[int, string])(nil)
+	_ instrument.Instrumented      = (*tree.RedBlackTree[int, string])(nil)
+)
+
+// implementations cria uma árvore de cada tipo
+func implementations() map[string]tree.OrderedMap[int, int] {
+	return map[string]tree.OrderedMap[int, int]{
+		"AVL":         tree.NewAVLTree[int, int](),
+		"rubro-negra": tree.NewRedBlackTree[int, int](),
+	}
+}
+
+func TestTreesAgainstSortedSlice(t *testing.T) {
+	for name, m := range implementations() {
+		rng := rand.New(rand.NewPCG(5, 8))
+		model := map[int]int{}
+		for step := 0; step < 5000; step++ {
+			key := rng.IntN(300)
+			if rng.IntN(3) == 0 {
+				want, wantOK := model[key]
+				delete(model, key)
+				if got, ok := m.Delete(key); got != want || ok != wantOK {
+					t.Fatalf("%s passo %d: Delete(%d) = %d, %v; esperado %d, %v", name, step, key, got, ok, want, wantOK)
+				}
+			} else {
+				_, existed := model[key]
+				model[key] = step
+				if added := m.Insert(key, step); added == existed {
+					t.Fatalf("%s passo %d: Insert(%d) = %v", name, step, key, added)
+				}
+			}
+			if step%250 == 0 {
+				if err := m.Validate(); err != nil {
+					t.Fatalf("%s passo %d: %v", name, step, err)
+				}
+			}
+		}
+		
+		keys := make([]int, 0, len(model))
+		for key := range model {
+			keys = append(keys, key)
+		}
+		sort.Ints(keys)
+		if !slices.Equal(m.Keys(), keys) || m.Size() != len(keys) {
+			t.Fatalf("%s: Keys() difere da fatia ordenada", name)
+		}
+		for i, key := range keys {
+			if m.Rank(key) != i {
+				t.Errorf("%s: Rank(%d) = %d, esperado %d", name, key, m.Rank(key), i)
+			}
+			if got, value, err := m.Select(i); err != nil || got != key || value != model[key] {
+				t.Errorf("%s: Select(%d) = %d, %d, %v", name, i, got, value, err)
+			}
+		}
+		// Floor, Ceiling e Rank de chaves que podem não existir
+		for probe := -1; probe <= 301; probe++ {
+			i := sort.SearchInts(keys, probe)
+			if m.Rank(probe) != i {
+				t.Errorf("%s: Rank(%d) = %d, esperado %d", name, probe, m.Rank(probe), i)
+			}
+			ceiling, _, ok := m.Ceiling(probe)
+			if ok != (i < len(keys)) || (ok && ceiling != keys[i]) {
+				t.Errorf("%s: Ceiling(%d) = %d, %v", name, probe, ceiling, ok)
+			}
+			j := sort.SearchInts(keys, probe+1) - 1
+			floor, _, ok := m.Floor(probe)
+			if ok != (j >= 0) || (ok && floor != keys[j]) {
+				t.Errorf("%s: Floor(%d) = %d, %v", name, probe, floor, ok)
+			}
+		}
+	}
+}
+
+func TestOrderedQueries(t *testing.T) {
+	for name, m := range implementations() {
+		if _, _, ok := m.Min(); ok {
+			t.Errorf("%s: Min de árvore vazia", name)
+		}
+		if _, _, err := m.Select(0); err == nil {
+			t.Errorf("%s: Select(0) em árvore vazia deveria falhar", name)
+		}
+		for _, key := range []int{50, 20, 80, 10, 30, 70, 90, 60} {
+			m.Insert(key, key*10)
+		}
+		if key, value, _ := m.Min(); key != 10 || value != 100 {
+			t.Errorf("%s: Min() = %d, %d", name, key, value)
+		}
+		if key, _, _ := m.Max(); key != 90 {
+			t.Errorf("%s: Max() = %d", name, key)
+		}
+		
+		var inRange []int
+		for key := range m.Range(25, 70) {
+			inRange = append(inRange, key)
+		}
+		if !slices.Equal(inRange, []int{30, 50, 60, 70}) {
+			t.Errorf("%s: Range(25, 70) = %v", name, inRange)
+		}
+		var backward []int
+		for key := range m.Backward() {
+			if key < 50 {
+				break
+			}
+			backward = append(backward, key)
+		}
+		if !slices.Equal(backward, []int{90, 80, 70, 60, 50}) {
+			t.Errorf("%s: Backward() até 50 = %v", name, backward)
+		}
+		
+		linked := list.NewLinkedList[int]()
+		linked.Add(0)
+		m.InOrder(linked)
+		if got := fmt.Sprint(linked.ToSlice()); got != "[0 10 20 30 50 60 70 80 90]" {
+			t.Errorf("%s: InOrder = %s", name, got)
+		}
+		
+		if _, ok := m.Delete(42); ok {
+			t.Errorf("%s: Delete de chave ausente", name)
+		}
+		m.Clear()
+		if !m.IsEmpty() || m.Height() != 0 || m.Validate() != nil {
+			t.Errorf("%s: Clear deixou %d chaves", name, m.Size())
+		}
+	}
+}
+
+func TestSequentialInsertStaysBalanced(t *testing.T) {
+	const n = 1 << 12
+	avl := tree.NewAVLTree[int, int]()
+	redBlack := tree.NewRedBlackTree[int, int]()
+	for i := 0; i < n; i++ {
+		avl.Insert(i, i)
+		redBlack.Insert(i, i)
+	}
+	// Inserção em ordem crescente degeneraria uma árvore de busca comum em
+	// lista (altura n); AVL fica perto de log₂ n e a LLRB abaixo de 2·log₂ n
+	if avl.Height() > 13 {
+		t.Errorf("AVL: altura %d com %d chaves", avl.Height(), n)
+	}
+	if redBlack.Height() > 24 || redBlack.Validate() != nil {
+		t.Errorf("rubro-negra: altura %d com %d chaves", redBlack.Height(), n)
+	}
+	stats := redBlack.GetStatistics()
+	if stats["minHeight"] != 13 || stats["blackHeight"] != redBlack.BlackHeight() {
+		t.Errorf("estatísticas %v", stats)
+	}
+	
+	for i := 0; i < n; i += 2 {
+		avl.Delete(i)
+		redBlack.Delete(i)
+	}
+	if avl.Validate() != nil || redBlack.Validate() != nil || avl.Size() != n/2 || redBlack.Size() != n/2 {
+		t.Errorf("após remover as chaves pares: tamanhos %d e %d", avl.Size(), redBlack.Size())
+	}
+}
+
+func TestRotationsAndLayout(t *testing.T) {
+	avl := tree.NewAVLTree[int, string]()
+	avl.Insert(3, "c")
+	avl.Insert(1, "a")
+	avl.Insert(2, "b") // caso LR: rotação dupla
+	if got := avl.GetStatistics()["rotations"]; got != 2 {
+		t.Errorf("AVL: %v rotações, esperado 2", got)
+	}
+	if got := avl.Layout(); got != "    ┌── 3 (+0)\n2 (+0)\n    └── 1 (+0)\n" {
+		t.Errorf("AVL Layout():\n%s", got)
+	}
+	if got := avl.String(); got != "AVL{1: a, 2: b, 3: c}" {
+		t.Errorf("AVL String() = %q", got)
+	}
+	
+	redBlack := tree.NewRedBlackTree[int, string]()
+	for _, key := range []int{1, 2, 3, 0} {
+		redBlack.Insert(key, fmt.Sprint(key))
+	}
+	if got := redBlack.Layout(); got != "    ┌── 3\n2\n    └── 1\n        └── [0]\n" {
+		t.Errorf("rubro-negra Layout():\n%s", got)
+	}
+}
+
+func TestCounters(t *testing.T) {
+	var counters instrument.Counters
+	avl := tree.NewAVLTree[int, int]()
+	avl.SetCounters(&counters)
+	for i := 0; i < 1023; i++ {
+		avl.Insert(i, i)
+	}
+	counters.Reset()
+	avl.Get(1022)
+	// 1023 chaves inseridas em ordem formam uma árvore perfeita de altura 10
+	if avl.Height() != 10 || counters.Comparisons != 10 || counters.Traversals != 9 {
+		t.Errorf("altura %d, contadores %v", avl.Height(), counters)
+	}
+}
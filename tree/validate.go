@@ -0,0 +1,115 @@
+package tree
+
+import (
+	"cmp"
+	"fmt"
+
+	"dca3503/list"
+)
+
+// ============================================================================
+// VALIDAÇÃO DE INVARIANTES ESTRUTURAIS
+// ============================================================================
+
+// validateOrder confere as invariantes comuns a qualquer árvore de busca
+// - as chaves em ordem estão estritamente crescentes
+// - o tamanho de cada nó é 1 + tamanho dos filhos
+func validateOrder[K list.Ordered, V any](root *node[K, V]) error {
+	var previous *node[K, V]
+	var walk func(n *node[K, V]) error
+	walk = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if err := walk(n.left); err != nil {
+			return err
+		}
+		if previous != nil && cmp.Compare(previous.key, n.key) >= 0 {
+			return fmt.Errorf("chave %v depois de %v no percurso em ordem", n.key, previous.key)
+		}
+		previous = n
+		if n.size != 1+size(n.left)+size(n.right) {
+			return fmt.Errorf("nó %v com size %d, filhos somam %d", n.key, n.size, size(n.left)+size(n.right))
+		}
+		return walk(n.right)
+	}
+	return walk(root)
+}
+
+// Validate verifica as invariantes internas da AVLTree
+// - é uma árvore de busca com tamanhos corretos (validateOrder)
+// - a altura guardada em cada nó é 1 + a maior altura dos filhos
+// - o fator de balanceamento de cada nó está entre -1 e 1
+// Complexidade: O(n)
+func (t *AVLTree[K, V]) Validate() error {
+	if err := validateOrder(t.root); err != nil {
+		return fmt.Errorf("AVLTree: %v", err)
+	}
+	var walk func(n *node[K, V]) error
+	walk = func(n *node[K, V]) error {
+		if n == nil {
+			return nil
+		}
+		if n.height != 1+max(height(n.left), height(n.right)) {
+			return fmt.Errorf("AVLTree: nó %v com altura %d, esperado %d", n.key, n.height, 1+max(height(n.left), height(n.right)))
+		}
+		if factor := balanceFactor(n); factor < -1 || factor > 1 {
+			return fmt.Errorf("AVLTree: nó %v com fator de balanceamento %d", n.key, factor)
+		}
+		if err := walk(n.left); err != nil {
+			return err
+		}
+		return walk(n.right)
+	}
+	return walk(t.root)
+}
+
+// Validate verifica as invariantes internas da RedBlackTree
+// - é uma árvore de busca com tamanhos corretos (validateOrder)
+// - a raiz é preta e nenhum link vermelho aponta para a direita
+// - não há dois links vermelhos seguidos
+// - todo caminho da raiz até nil tem o mesmo número de links pretos
+// Complexidade: O(n)
+func (t *RedBlackTree[K, V]) Validate() error {
+	if err := validateOrder(t.root); err != nil {
+		return fmt.Errorf("RedBlackTree: %v", err)
+	}
+	if isRed(t.root) {
+		return fmt.Errorf("RedBlackTree: raiz vermelha")
+	}
+	expected := t.BlackHeight()
+	var walk func(n *node[K, V], blacks int) error
+	walk = func(n *node[K, V], blacks int) error {
+		if n == nil {
+			if blacks != expected {
+				return fmt.Errorf("RedBlackTree: caminho com %d links pretos, esperado %d", blacks, expected)
+			}
+			return nil
+		}
+		if isRed(n.right) {
+			return fmt.Errorf("RedBlackTree: link vermelho à direita de %v", n.key)
+		}
+		if n.red && isRed(n.left) {
+			return fmt.Errorf("RedBlackTree: dois links vermelhos seguidos em %v", n.key)
+		}
+		if !n.red {
+			blacks++
+		}
+		if err := walk(n.left, blacks); err != nil {
+			return err
+		}
+		return walk(n.right, blacks)
+	}
+	return walk(t.root, 0)
+}
+
+// checkInvariants roda Validate ao final de cada operação que altera a estrutura
+// Só tem efeito quando o pacote é compilado com a tag debug (go test -tags debug)
+func checkInvariants(structure interface{ Validate() error }) {
+	if !debugValidate {
+		return
+	}
+	if err := structure.Validate(); err != nil {
+		panic(fmt.Sprintf("invariante violada: %v", err))
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !debug
+
+package tree
+
+// debugValidate desliga checkInvariants (compilação normal)
+const debugValidate = false